@@ -19,20 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateApplicant_FullMethodName     = "/user_service.v1.UserService/CreateApplicant"
-	UserService_ActivateApplicant_FullMethodName   = "/user_service.v1.UserService/ActivateApplicant"
-	UserService_UpdateApplicant_FullMethodName     = "/user_service.v1.UserService/UpdateApplicant"
-	UserService_DeleteApplicant_FullMethodName     = "/user_service.v1.UserService/DeleteApplicant"
-	UserService_QueryApplicants_FullMethodName     = "/user_service.v1.UserService/QueryApplicants"
-	UserService_GetApplicant_FullMethodName        = "/user_service.v1.UserService/GetApplicant"
-	UserService_GetApplicantByEmail_FullMethodName = "/user_service.v1.UserService/GetApplicantByEmail"
-	UserService_CreateEmployer_FullMethodName      = "/user_service.v1.UserService/CreateEmployer"
-	UserService_ActivateEmployer_FullMethodName    = "/user_service.v1.UserService/ActivateEmployer"
-	UserService_UpdateEmployer_FullMethodName      = "/user_service.v1.UserService/UpdateEmployer"
-	UserService_DeleteEmployer_FullMethodName      = "/user_service.v1.UserService/DeleteEmployer"
-	UserService_QueryEmployers_FullMethodName      = "/user_service.v1.UserService/QueryEmployers"
-	UserService_GetEmployer_FullMethodName         = "/user_service.v1.UserService/GetEmployer"
-	UserService_GetEmployerByEmail_FullMethodName  = "/user_service.v1.UserService/GetEmployerByEmail"
+	UserService_CreateApplicant_FullMethodName                = "/user_service.v1.UserService/CreateApplicant"
+	UserService_ActivateApplicant_FullMethodName              = "/user_service.v1.UserService/ActivateApplicant"
+	UserService_UpdateApplicant_FullMethodName                = "/user_service.v1.UserService/UpdateApplicant"
+	UserService_DeleteApplicant_FullMethodName                = "/user_service.v1.UserService/DeleteApplicant"
+	UserService_QueryApplicants_FullMethodName                = "/user_service.v1.UserService/QueryApplicants"
+	UserService_GetApplicant_FullMethodName                   = "/user_service.v1.UserService/GetApplicant"
+	UserService_GetApplicantByEmail_FullMethodName            = "/user_service.v1.UserService/GetApplicantByEmail"
+	UserService_ChangeApplicantEmail_FullMethodName           = "/user_service.v1.UserService/ChangeApplicantEmail"
+	UserService_RestoreApplicant_FullMethodName               = "/user_service.v1.UserService/RestoreApplicant"
+	UserService_GetRestorableApplicantsByEmail_FullMethodName = "/user_service.v1.UserService/GetRestorableApplicantsByEmail"
+	UserService_CreateEmployer_FullMethodName                 = "/user_service.v1.UserService/CreateEmployer"
+	UserService_ActivateEmployer_FullMethodName               = "/user_service.v1.UserService/ActivateEmployer"
+	UserService_UpdateEmployer_FullMethodName                 = "/user_service.v1.UserService/UpdateEmployer"
+	UserService_DeleteEmployer_FullMethodName                 = "/user_service.v1.UserService/DeleteEmployer"
+	UserService_QueryEmployers_FullMethodName                 = "/user_service.v1.UserService/QueryEmployers"
+	UserService_GetEmployer_FullMethodName                    = "/user_service.v1.UserService/GetEmployer"
+	UserService_GetEmployerByEmail_FullMethodName             = "/user_service.v1.UserService/GetEmployerByEmail"
+	UserService_ChangeEmployerEmail_FullMethodName            = "/user_service.v1.UserService/ChangeEmployerEmail"
+	UserService_RestoreEmployer_FullMethodName                = "/user_service.v1.UserService/RestoreEmployer"
+	UserService_GetRestorableEmployersByEmail_FullMethodName  = "/user_service.v1.UserService/GetRestorableEmployersByEmail"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -42,17 +48,49 @@ type UserServiceClient interface {
 	CreateApplicant(ctx context.Context, in *CreateApplicantRequest, opts ...grpc.CallOption) (*CreateApplicantResponse, error)
 	ActivateApplicant(ctx context.Context, in *ActivateApplicantRequest, opts ...grpc.CallOption) (*ActivateApplicantResponse, error)
 	UpdateApplicant(ctx context.Context, in *UpdateApplicantRequest, opts ...grpc.CallOption) (*UpdateApplicantResponse, error)
+	// Internal: soft deletes the applicant while the authorization microservice closes the
+	// account, end users call DeleteApplicantAccount there. The profile can be restored during
+	// the grace period, then its personal data is erased. Not exposed through the http gateway.
 	DeleteApplicant(ctx context.Context, in *DeleteApplicantRequest, opts ...grpc.CallOption) (*DeleteApplicantResponse, error)
 	QueryApplicants(ctx context.Context, in *QueryApplicantsRequest, opts ...grpc.CallOption) (*QueryApplicantsResponse, error)
 	GetApplicant(ctx context.Context, in *GetApplicantRequest, opts ...grpc.CallOption) (*GetApplicantResponse, error)
 	GetApplicantByEmail(ctx context.Context, in *GetApplicantByEmailRequest, opts ...grpc.CallOption) (*GetApplicantByEmailResponse, error)
+	// Internal: changes the login email of the applicant after the authorization
+	// microservice has confirmed the new address. Fails with ALREADY_EXISTS when
+	// the email belongs to another not deleted applicant. Not exposed through the http gateway.
+	ChangeApplicantEmail(ctx context.Context, in *ChangeApplicantEmailRequest, opts ...grpc.CallOption) (*ChangeApplicantEmailResponse, error)
+	// Internal: undoes the soft delete of the applicant when the authorization
+	// microservice fails to close the account on its side or the applicant restores it.
+	// Fails with FAILED_PRECONDITION once the grace period is over and with ALREADY_EXISTS
+	// when the email was taken in the meantime. Not exposed through the http gateway.
+	RestoreApplicant(ctx context.Context, in *RestoreApplicantRequest, opts ...grpc.CallOption) (*RestoreApplicantResponse, error)
+	// Internal: returns the deleted applicants with the email that can still be restored,
+	// the most recently deleted first. The email may have been reused, so there can
+	// be several of them. Not exposed through the http gateway.
+	GetRestorableApplicantsByEmail(ctx context.Context, in *GetRestorableApplicantsByEmailRequest, opts ...grpc.CallOption) (*GetRestorableApplicantsByEmailResponse, error)
 	CreateEmployer(ctx context.Context, in *CreateEmployerRequest, opts ...grpc.CallOption) (*CreateEmployerResponse, error)
 	ActivateEmployer(ctx context.Context, in *ActivateEmployerRequest, opts ...grpc.CallOption) (*ActivateEmployerResponse, error)
 	UpdateEmployer(ctx context.Context, in *UpdateEmployerRequest, opts ...grpc.CallOption) (*UpdateEmployerResponse, error)
+	// Internal: soft deletes the employer while the authorization microservice closes the
+	// account, end users call DeleteEmployerAccount there. The profile can be restored during
+	// the grace period, then its personal data is erased. Not exposed through the http gateway.
 	DeleteEmployer(ctx context.Context, in *DeleteEmployerRequest, opts ...grpc.CallOption) (*DeleteEmployerResponse, error)
 	QueryEmployers(ctx context.Context, in *QueryEmployersRequest, opts ...grpc.CallOption) (*QueryEmployersResponse, error)
 	GetEmployer(ctx context.Context, in *GetEmployerRequest, opts ...grpc.CallOption) (*GetEmployerResponse, error)
 	GetEmployerByEmail(ctx context.Context, in *GetEmployerByEmailRequest, opts ...grpc.CallOption) (*GetEmployerByEmailResponse, error)
+	// Internal: changes the login email of the employer after the authorization
+	// microservice has confirmed the new address. Fails with ALREADY_EXISTS when
+	// the email belongs to another not deleted employer. Not exposed through the http gateway.
+	ChangeEmployerEmail(ctx context.Context, in *ChangeEmployerEmailRequest, opts ...grpc.CallOption) (*ChangeEmployerEmailResponse, error)
+	// Internal: undoes the soft delete of the employer when the authorization
+	// microservice fails to close the account on its side or the employer restores it.
+	// Fails with FAILED_PRECONDITION once the grace period is over and with ALREADY_EXISTS
+	// when the email was taken in the meantime. Not exposed through the http gateway.
+	RestoreEmployer(ctx context.Context, in *RestoreEmployerRequest, opts ...grpc.CallOption) (*RestoreEmployerResponse, error)
+	// Internal: returns the deleted employers with the email that can still be restored,
+	// the most recently deleted first. The email may have been reused, so there can
+	// be several of them. Not exposed through the http gateway.
+	GetRestorableEmployersByEmail(ctx context.Context, in *GetRestorableEmployersByEmailRequest, opts ...grpc.CallOption) (*GetRestorableEmployersByEmailResponse, error)
 }
 
 type userServiceClient struct {
@@ -133,6 +171,36 @@ func (c *userServiceClient) GetApplicantByEmail(ctx context.Context, in *GetAppl
 	return out, nil
 }
 
+func (c *userServiceClient) ChangeApplicantEmail(ctx context.Context, in *ChangeApplicantEmailRequest, opts ...grpc.CallOption) (*ChangeApplicantEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeApplicantEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangeApplicantEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RestoreApplicant(ctx context.Context, in *RestoreApplicantRequest, opts ...grpc.CallOption) (*RestoreApplicantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreApplicantResponse)
+	err := c.cc.Invoke(ctx, UserService_RestoreApplicant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetRestorableApplicantsByEmail(ctx context.Context, in *GetRestorableApplicantsByEmailRequest, opts ...grpc.CallOption) (*GetRestorableApplicantsByEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRestorableApplicantsByEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_GetRestorableApplicantsByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateEmployer(ctx context.Context, in *CreateEmployerRequest, opts ...grpc.CallOption) (*CreateEmployerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateEmployerResponse)
@@ -203,6 +271,36 @@ func (c *userServiceClient) GetEmployerByEmail(ctx context.Context, in *GetEmplo
 	return out, nil
 }
 
+func (c *userServiceClient) ChangeEmployerEmail(ctx context.Context, in *ChangeEmployerEmailRequest, opts ...grpc.CallOption) (*ChangeEmployerEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeEmployerEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangeEmployerEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RestoreEmployer(ctx context.Context, in *RestoreEmployerRequest, opts ...grpc.CallOption) (*RestoreEmployerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreEmployerResponse)
+	err := c.cc.Invoke(ctx, UserService_RestoreEmployer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetRestorableEmployersByEmail(ctx context.Context, in *GetRestorableEmployersByEmailRequest, opts ...grpc.CallOption) (*GetRestorableEmployersByEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRestorableEmployersByEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_GetRestorableEmployersByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -210,17 +308,49 @@ type UserServiceServer interface {
 	CreateApplicant(context.Context, *CreateApplicantRequest) (*CreateApplicantResponse, error)
 	ActivateApplicant(context.Context, *ActivateApplicantRequest) (*ActivateApplicantResponse, error)
 	UpdateApplicant(context.Context, *UpdateApplicantRequest) (*UpdateApplicantResponse, error)
+	// Internal: soft deletes the applicant while the authorization microservice closes the
+	// account, end users call DeleteApplicantAccount there. The profile can be restored during
+	// the grace period, then its personal data is erased. Not exposed through the http gateway.
 	DeleteApplicant(context.Context, *DeleteApplicantRequest) (*DeleteApplicantResponse, error)
 	QueryApplicants(context.Context, *QueryApplicantsRequest) (*QueryApplicantsResponse, error)
 	GetApplicant(context.Context, *GetApplicantRequest) (*GetApplicantResponse, error)
 	GetApplicantByEmail(context.Context, *GetApplicantByEmailRequest) (*GetApplicantByEmailResponse, error)
+	// Internal: changes the login email of the applicant after the authorization
+	// microservice has confirmed the new address. Fails with ALREADY_EXISTS when
+	// the email belongs to another not deleted applicant. Not exposed through the http gateway.
+	ChangeApplicantEmail(context.Context, *ChangeApplicantEmailRequest) (*ChangeApplicantEmailResponse, error)
+	// Internal: undoes the soft delete of the applicant when the authorization
+	// microservice fails to close the account on its side or the applicant restores it.
+	// Fails with FAILED_PRECONDITION once the grace period is over and with ALREADY_EXISTS
+	// when the email was taken in the meantime. Not exposed through the http gateway.
+	RestoreApplicant(context.Context, *RestoreApplicantRequest) (*RestoreApplicantResponse, error)
+	// Internal: returns the deleted applicants with the email that can still be restored,
+	// the most recently deleted first. The email may have been reused, so there can
+	// be several of them. Not exposed through the http gateway.
+	GetRestorableApplicantsByEmail(context.Context, *GetRestorableApplicantsByEmailRequest) (*GetRestorableApplicantsByEmailResponse, error)
 	CreateEmployer(context.Context, *CreateEmployerRequest) (*CreateEmployerResponse, error)
 	ActivateEmployer(context.Context, *ActivateEmployerRequest) (*ActivateEmployerResponse, error)
 	UpdateEmployer(context.Context, *UpdateEmployerRequest) (*UpdateEmployerResponse, error)
+	// Internal: soft deletes the employer while the authorization microservice closes the
+	// account, end users call DeleteEmployerAccount there. The profile can be restored during
+	// the grace period, then its personal data is erased. Not exposed through the http gateway.
 	DeleteEmployer(context.Context, *DeleteEmployerRequest) (*DeleteEmployerResponse, error)
 	QueryEmployers(context.Context, *QueryEmployersRequest) (*QueryEmployersResponse, error)
 	GetEmployer(context.Context, *GetEmployerRequest) (*GetEmployerResponse, error)
 	GetEmployerByEmail(context.Context, *GetEmployerByEmailRequest) (*GetEmployerByEmailResponse, error)
+	// Internal: changes the login email of the employer after the authorization
+	// microservice has confirmed the new address. Fails with ALREADY_EXISTS when
+	// the email belongs to another not deleted employer. Not exposed through the http gateway.
+	ChangeEmployerEmail(context.Context, *ChangeEmployerEmailRequest) (*ChangeEmployerEmailResponse, error)
+	// Internal: undoes the soft delete of the employer when the authorization
+	// microservice fails to close the account on its side or the employer restores it.
+	// Fails with FAILED_PRECONDITION once the grace period is over and with ALREADY_EXISTS
+	// when the email was taken in the meantime. Not exposed through the http gateway.
+	RestoreEmployer(context.Context, *RestoreEmployerRequest) (*RestoreEmployerResponse, error)
+	// Internal: returns the deleted employers with the email that can still be restored,
+	// the most recently deleted first. The email may have been reused, so there can
+	// be several of them. Not exposed through the http gateway.
+	GetRestorableEmployersByEmail(context.Context, *GetRestorableEmployersByEmailRequest) (*GetRestorableEmployersByEmailResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -252,6 +382,15 @@ func (UnimplementedUserServiceServer) GetApplicant(context.Context, *GetApplican
 func (UnimplementedUserServiceServer) GetApplicantByEmail(context.Context, *GetApplicantByEmailRequest) (*GetApplicantByEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApplicantByEmail not implemented")
 }
+func (UnimplementedUserServiceServer) ChangeApplicantEmail(context.Context, *ChangeApplicantEmailRequest) (*ChangeApplicantEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeApplicantEmail not implemented")
+}
+func (UnimplementedUserServiceServer) RestoreApplicant(context.Context, *RestoreApplicantRequest) (*RestoreApplicantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreApplicant not implemented")
+}
+func (UnimplementedUserServiceServer) GetRestorableApplicantsByEmail(context.Context, *GetRestorableApplicantsByEmailRequest) (*GetRestorableApplicantsByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestorableApplicantsByEmail not implemented")
+}
 func (UnimplementedUserServiceServer) CreateEmployer(context.Context, *CreateEmployerRequest) (*CreateEmployerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployer not implemented")
 }
@@ -273,6 +412,15 @@ func (UnimplementedUserServiceServer) GetEmployer(context.Context, *GetEmployerR
 func (UnimplementedUserServiceServer) GetEmployerByEmail(context.Context, *GetEmployerByEmailRequest) (*GetEmployerByEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmployerByEmail not implemented")
 }
+func (UnimplementedUserServiceServer) ChangeEmployerEmail(context.Context, *ChangeEmployerEmailRequest) (*ChangeEmployerEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmployerEmail not implemented")
+}
+func (UnimplementedUserServiceServer) RestoreEmployer(context.Context, *RestoreEmployerRequest) (*RestoreEmployerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEmployer not implemented")
+}
+func (UnimplementedUserServiceServer) GetRestorableEmployersByEmail(context.Context, *GetRestorableEmployersByEmailRequest) (*GetRestorableEmployersByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestorableEmployersByEmail not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -420,6 +568,60 @@ func _UserService_GetApplicantByEmail_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ChangeApplicantEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeApplicantEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeApplicantEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeApplicantEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeApplicantEmail(ctx, req.(*ChangeApplicantEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RestoreApplicant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreApplicantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreApplicant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestoreApplicant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreApplicant(ctx, req.(*RestoreApplicantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetRestorableApplicantsByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRestorableApplicantsByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRestorableApplicantsByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetRestorableApplicantsByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRestorableApplicantsByEmail(ctx, req.(*GetRestorableApplicantsByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateEmployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEmployerRequest)
 	if err := dec(in); err != nil {
@@ -546,6 +748,60 @@ func _UserService_GetEmployerByEmail_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ChangeEmployerEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmployerEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeEmployerEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeEmployerEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeEmployerEmail(ctx, req.(*ChangeEmployerEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RestoreEmployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEmployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreEmployer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestoreEmployer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreEmployer(ctx, req.(*RestoreEmployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetRestorableEmployersByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRestorableEmployersByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRestorableEmployersByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetRestorableEmployersByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRestorableEmployersByEmail(ctx, req.(*GetRestorableEmployersByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -581,6 +837,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApplicantByEmail",
 			Handler:    _UserService_GetApplicantByEmail_Handler,
 		},
+		{
+			MethodName: "ChangeApplicantEmail",
+			Handler:    _UserService_ChangeApplicantEmail_Handler,
+		},
+		{
+			MethodName: "RestoreApplicant",
+			Handler:    _UserService_RestoreApplicant_Handler,
+		},
+		{
+			MethodName: "GetRestorableApplicantsByEmail",
+			Handler:    _UserService_GetRestorableApplicantsByEmail_Handler,
+		},
 		{
 			MethodName: "CreateEmployer",
 			Handler:    _UserService_CreateEmployer_Handler,
@@ -609,6 +877,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEmployerByEmail",
 			Handler:    _UserService_GetEmployerByEmail_Handler,
 		},
+		{
+			MethodName: "ChangeEmployerEmail",
+			Handler:    _UserService_ChangeEmployerEmail_Handler,
+		},
+		{
+			MethodName: "RestoreEmployer",
+			Handler:    _UserService_RestoreEmployer_Handler,
+		},
+		{
+			MethodName: "GetRestorableEmployersByEmail",
+			Handler:    _UserService_GetRestorableEmployersByEmail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service/v1/user_service.proto",
@@ -8,17 +8,30 @@ import (
 	"time"
 
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/password"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
 	authservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/auth"
+	bruteforceservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/bruteforce"
 	codeservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/code"
+	mfaservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/mfa"
+	notificationservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/notification"
+	oidcservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/oidc"
 	passwordservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/password"
+	registrationservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/registration"
+	retentionservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/retention"
 	tokenservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/token"
 	userservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/user_service"
 	usergrpcclient "github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/client/grpc/user_client"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/notifier"
+	oidcclient "github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/oidc"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/postgres"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
 	grpcserver "github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/server/grpc"
 	httpgateway "github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/server/http"
+	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt/denylist"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/logger"
+	clientmiddleware "github.com/ZaiiiRan/job_search_service/common/pkg/middleware/grpc/client"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -31,15 +44,30 @@ type App struct {
 	redisClient    *redis.RedisClient
 
 	userGrpcClient *usergrpcclient.Client
-
-	userService     userservice.UserService
-	tokenService    tokenservice.TokenService
-	passwordService passwordservice.PasswordService
-	codeService     codeservice.CodeService
-	authService     authservice.AuthService
+	notifier       notifier.Notifier
+	oidcClient     *oidcclient.Client
+	jwtKeys        *tokenservice.Keys
+	denylist       denylist.Denylist
+	hasher         *secret.Hasher
+	cipher         *secret.Cipher
+
+	userService         userservice.UserService
+	tokenService        tokenservice.TokenService
+	passwordService     passwordservice.PasswordService
+	codeService         codeservice.CodeService
+	notificationService notificationservice.NotificationService
+	bruteForceService   bruteforceservice.BruteForceService
+	mfaService          mfaservice.MfaService
+	oidcService         oidcservice.OidcService
+	registrationService registrationservice.RegistrationService
+	retentionService    retentionservice.RetentionService
+	authService         authservice.AuthService
 
 	grpcServer  *grpcserver.Server
 	httpGateway *httpgateway.Server
+
+	stopReconciler context.CancelFunc
+	stopRetention  context.CancelFunc
 }
 
 func New() (*App, error) {
@@ -67,11 +95,34 @@ func (a *App) Run(ctx context.Context) error {
 	if err := a.initUserGrpcClient(ctx); err != nil {
 		return err
 	}
+	if err := a.initNotifier(); err != nil {
+		return err
+	}
+	if err := a.initOidcClient(); err != nil {
+		return err
+	}
+
+	if err := a.initJWTKeys(); err != nil {
+		return err
+	}
+	a.initDenylist()
+	if err := a.initHasher(); err != nil {
+		return err
+	}
+	if err := a.initCipher(); err != nil {
+		return err
+	}
 
 	a.initCodeService()
 	a.initPasswordService()
 	a.initTokenService()
 	a.initUserService()
+	a.initNotificationService()
+	a.initBruteForceService()
+	a.initMfaService()
+	a.initOidcService()
+	a.initRegistrationService()
+	a.initRetentionService()
 	a.initAuthService()
 
 	if err := a.initGrpcServer(); err != nil {
@@ -83,6 +134,8 @@ func (a *App) Run(ctx context.Context) error {
 
 	a.startGrpcServer()
 	a.startHttpGateway()
+	a.startRegistrationReconciler(ctx)
+	a.startRetentionPurge(ctx)
 
 	a.log.Infow("app.started")
 	return nil
@@ -94,6 +147,8 @@ func (a *App) Stop(ctx context.Context) {
 	shCtx, cancel := context.WithTimeout(ctx, time.Duration(a.cfg.Shutdown.ShutdownTimeout)*time.Second)
 	defer cancel()
 
+	a.stopReconciler()
+	a.stopRetention()
 	a.postgresClient.Close()
 	a.redisClient.Close()
 	a.grpcServer.Stop(shCtx)
@@ -138,7 +193,20 @@ func (a *App) initRedisClient(ctx context.Context) error {
 
 func (a *App) initUserGrpcClient(ctx context.Context) error {
 	a.log.Infow("user grpc addr", "addr", a.cfg.UserServiceGRPCClient.Address)
-	userClient, err := usergrpcclient.New(ctx, a.cfg.UserServiceGRPCClient, nil, nil)
+	tokenCfg := a.cfg.UserServiceToken
+	signer, err := claims.NewServiceTokenSigner(
+		tokenCfg.Service, tokenCfg.Audience,
+		[]byte(tokenCfg.Secret), time.Duration(tokenCfg.TTL)*time.Second,
+	)
+	if err != nil {
+		a.log.Errorw("app.user_grpc_client_init_failed", "err", err)
+		return err
+	}
+
+	userClient, err := usergrpcclient.New(
+		ctx, a.cfg.UserServiceGRPCClient,
+		[]grpc.UnaryClientInterceptor{clientmiddleware.ServiceTokenUnary(signer)}, nil,
+	)
 	if err != nil {
 		a.log.Errorw("app.user_grpc_client_init_failed", "err", err)
 		return err
@@ -153,28 +221,134 @@ func (a *App) initUserGrpcClient(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initNotifier() error {
+	n, err := notifier.New(a.cfg.Notifier, a.log)
+	if err != nil {
+		a.log.Errorw("app.notifier_init_failed", "err", err)
+		return err
+	}
+	a.notifier = n
+
+	a.log.Infow("app.notifier_initialized", "type", a.cfg.Notifier.Type)
+	return nil
+}
+
+func (a *App) initJWTKeys() error {
+	keys, err := tokenservice.LoadKeys(a.cfg.JWT, a.log)
+	if err != nil {
+		a.log.Errorw("app.jwt_keys_load_failed", "err", err)
+		return err
+	}
+	a.jwtKeys = keys
+
+	a.log.Infow("app.jwt_keys_loaded")
+	return nil
+}
+
+func (a *App) initOidcClient() error {
+	if !a.cfg.Oidc.Enabled {
+		a.log.Infow("app.oidc_disabled")
+		return nil
+	}
+
+	client, err := oidcclient.New(a.cfg.Oidc)
+	if err != nil {
+		a.log.Errorw("app.oidc_client_init_failed", "err", err)
+		return err
+	}
+	a.oidcClient = client
+
+	a.log.Infow("app.oidc_client_initialized", "issuer", a.cfg.Oidc.Issuer)
+	return nil
+}
+
+func (a *App) initDenylist() {
+	a.denylist = denylist.NewRedis(a.redisClient.GetClient())
+}
+
+func (a *App) initHasher() error {
+	hasher, err := secret.NewHasher([]byte(a.cfg.Hashing.SecretKey))
+	if err != nil {
+		a.log.Errorw("app.hasher_init_failed", "err", err)
+		return err
+	}
+	a.hasher = hasher
+	return nil
+}
+
+func (a *App) initCipher() error {
+	cipher, err := secret.NewCipher([]byte(a.cfg.Mfa.EncryptionKey))
+	if err != nil {
+		a.log.Errorw("app.cipher_init_failed", "err", err)
+		return err
+	}
+	a.cipher = cipher
+	return nil
+}
+
 func (a *App) initUserService() {
 	a.userService = userservice.New(a.userGrpcClient, a.log)
 }
 
 func (a *App) initPasswordService() {
-	a.passwordService = passwordservice.New(a.redisClient, a.log)
+	a.passwordService = passwordservice.New(a.redisClient, password.NewHasher(password.Argon2Params{
+		Memory:      a.cfg.PasswordHashing.Argon2.Memory,
+		Iterations:  a.cfg.PasswordHashing.Argon2.Iterations,
+		Parallelism: a.cfg.PasswordHashing.Argon2.Parallelism,
+		SaltLength:  a.cfg.PasswordHashing.Argon2.SaltLength,
+		KeyLength:   a.cfg.PasswordHashing.Argon2.KeyLength,
+	}), a.cfg.PasswordPolicy, a.log)
 }
 
 func (a *App) initTokenService() {
-	a.tokenService = tokenservice.New(a.cfg.JWT, a.redisClient, a.log)
+	a.tokenService = tokenservice.New(a.cfg.JWT, a.jwtKeys, a.hasher, a.denylist, a.redisClient, a.log)
 }
 
 func (a *App) initCodeService() {
-	a.codeService = codeservice.New(a.redisClient, a.log)
+	a.codeService = codeservice.New(a.redisClient, a.hasher, a.log)
+}
+
+func (a *App) initNotificationService() {
+	a.notificationService = notificationservice.New(a.cfg.Notifier, a.notifier, a.log)
+}
+
+func (a *App) initBruteForceService() {
+	a.bruteForceService = bruteforceservice.New(a.redisClient, a.cfg.BruteForce, a.log)
+}
+
+func (a *App) initMfaService() {
+	a.mfaService = mfaservice.New(a.redisClient, a.cipher, a.hasher, a.cfg.Mfa, a.log)
+}
+
+func (a *App) initOidcService() {
+	a.oidcService = oidcservice.New(a.redisClient, a.oidcClient, a.hasher, a.cfg.Oidc, a.log)
+}
+
+func (a *App) initRegistrationService() {
+	a.registrationService = registrationservice.New(a.postgresClient, a.userService, a.passwordService, a.cfg.Registration, a.log)
+}
+
+func (a *App) initRetentionService() {
+	a.retentionService = retentionservice.New(a.postgresClient, a.userService, a.passwordService, a.mfaService, a.oidcService, a.cfg.Retention, a.log)
 }
 
 func (a *App) initAuthService() {
-	a.authService = authservice.New(a.postgresClient, a.codeService, a.passwordService, a.tokenService, a.userService, a.log)
+	a.authService = authservice.New(
+		a.postgresClient,
+		a.codeService, a.passwordService,
+		a.tokenService, a.userService,
+		a.notificationService,
+		a.bruteForceService,
+		a.mfaService,
+		a.oidcService,
+		a.registrationService,
+		a.retentionService,
+		a.log,
+	)
 }
 
 func (a *App) initGrpcServer() error {
-	srv, err := grpcserver.New(a.cfg.GRPCServer, a.cfg.JWT, a.authService, a.log)
+	srv, err := grpcserver.New(a.cfg.GRPCServer, a.cfg.ServiceAuth, a.jwtKeys.AccessKeySet(), a.denylist, a.authService, a.log)
 	if err != nil {
 		a.log.Errorw("app.grpc_server_init_failed", "err", err)
 		return err
@@ -194,7 +368,7 @@ func (a *App) startGrpcServer() {
 }
 
 func (a *App) initHttpGateway(ctx context.Context) error {
-	srv, err := httpgateway.New(ctx, a.cfg.HTTPGatewayServer, fmt.Sprintf("localhost%s", a.cfg.GRPCServer.Port))
+	srv, err := httpgateway.New(ctx, a.cfg.HTTPGatewayServer, a.cfg.SessionCookies, a.cfg.JWT, a.hasher, fmt.Sprintf("localhost%s", a.cfg.GRPCServer.Port), a.jwtKeys.AccessKeySet())
 	if err != nil {
 		a.log.Errorw("app.http_gateway_init_failed", "err", err)
 		return err
@@ -211,3 +385,49 @@ func (a *App) startHttpGateway() {
 		}
 	}()
 }
+
+// startRegistrationReconciler repairs the registrations left open by a crash
+// until the app is stopped.
+func (a *App) startRegistrationReconciler(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	a.stopReconciler = cancel
+
+	go func() {
+		interval := time.Duration(a.cfg.Registration.ReconcileInterval) * time.Second
+		a.log.Infow("app.registration_reconciler_start", "interval", interval)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.registrationService.Reconcile(ctx)
+			}
+		}
+	}()
+}
+
+// startRetentionPurge removes the credentials of the accounts deleted longer
+// than the grace period ago until the app is stopped.
+func (a *App) startRetentionPurge(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	a.stopRetention = cancel
+
+	go func() {
+		interval := time.Duration(a.cfg.Retention.PurgeInterval) * time.Second
+		a.log.Infow("app.retention_purge_start", "interval", interval)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.retentionService.PurgeExpired(ctx)
+			}
+		}
+	}()
+}
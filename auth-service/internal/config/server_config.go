@@ -9,14 +9,26 @@ import (
 )
 
 type ServerConfig struct {
-	GRPCServer            settings.GRPCServerSettings `mapstructure:"grpc_server"`
-	HTTPGatewayServer     settings.HTTPServerSettings `mapstructure:"http_gateway_server"`
-	JWT                   settings.JWTSettings        `mapstructure:"jwt"`
-	UserServiceGRPCClient settings.GRPCClientSettings `mapstructure:"user_service_grpc_client"`
-	DB                    settings.PostgresSettings   `mapstructure:"db"`
-	Migrate               settings.MigrateSettings    `mapstructure:"migrate"`
-	Redis                 settings.RedisSettings      `mapstructure:"redis"`
-	Shutdown              settings.ShutdownSettings   `mapstructure:"shutdown"`
+	GRPCServer            settings.GRPCServerSettings      `mapstructure:"grpc_server"`
+	HTTPGatewayServer     settings.HTTPServerSettings      `mapstructure:"http_gateway_server"`
+	SessionCookies        settings.SessionCookieSettings   `mapstructure:"session_cookies"`
+	JWT                   settings.JWTSettings             `mapstructure:"jwt"`
+	UserServiceGRPCClient settings.GRPCClientSettings      `mapstructure:"user_service_grpc_client"`
+	UserServiceToken      settings.ServiceTokenSettings    `mapstructure:"user_service_token"`
+	ServiceAuth           settings.ServiceAuthSettings     `mapstructure:"service_auth"`
+	DB                    settings.PostgresSettings        `mapstructure:"db"`
+	Migrate               settings.MigrateSettings         `mapstructure:"migrate"`
+	Redis                 settings.RedisSettings           `mapstructure:"redis"`
+	Shutdown              settings.ShutdownSettings        `mapstructure:"shutdown"`
+	Notifier              settings.NotifierSettings        `mapstructure:"notifier"`
+	BruteForce            settings.BruteForceSettings      `mapstructure:"brute_force"`
+	Hashing               settings.HashingSettings         `mapstructure:"hashing"`
+	PasswordHashing       settings.PasswordHashingSettings `mapstructure:"password_hashing"`
+	PasswordPolicy        settings.PasswordPolicySettings  `mapstructure:"password_policy"`
+	Mfa                   settings.MfaSettings             `mapstructure:"mfa"`
+	Oidc                  settings.OidcSettings            `mapstructure:"oidc"`
+	Registration          settings.RegistrationSettings    `mapstructure:"registration"`
+	Retention             settings.RetentionSettings       `mapstructure:"retention"`
 }
 
 func LoadServerConfig() (*ServerConfig, error) {
@@ -33,7 +45,8 @@ func LoadServerConfig() (*ServerConfig, error) {
 		return nil, err
 	}
 
-	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	// service names are part of some keys, SERVICE_AUTH_SERVICES_AUTH_SERVICE sets services.auth-service
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
 	v.AutomaticEnv()
 
 	setServerDefaults(v)
@@ -49,10 +62,22 @@ func LoadServerConfig() (*ServerConfig, error) {
 func setServerDefaults(v *viper.Viper) {
 	settings.SetGRPCServerDefaults(v, "grpc_server", ":50052")
 	settings.SetHTTPServerDefaults(v, "http_gateway_server", ":8082")
+	settings.SetSessionCookieDefaults(v, "session_cookies")
 	settings.SetJWTDefaults(v, "jwt")
 	settings.SetGRPCClientDefaults(v, "user_service_grpc_client", "localhost:50051")
+	settings.SetServiceTokenDefaults(v, "user_service_token")
+	settings.SetServiceAuthDefaults(v, "service_auth")
 	settings.SetPostgresDefaults(v, "db")
 	settings.SetMigrateDefaults(v, "migrate")
 	settings.SetRedisDefaults(v, "redis")
 	settings.SetShutdownDefaults(v, "shutdown")
+	settings.SetNotifierDefaults(v, "notifier")
+	settings.SetBruteForceDefaults(v, "brute_force")
+	settings.SetHashingDefaults(v, "hashing")
+	settings.SetPasswordHashingDefaults(v, "password_hashing")
+	settings.SetPasswordPolicyDefaults(v, "password_policy")
+	settings.SetMfaDefaults(v, "mfa")
+	settings.SetOidcDefaults(v, "oidc")
+	settings.SetRegistrationDefaults(v, "registration")
+	settings.SetRetentionDefaults(v, "retention")
 }
@@ -0,0 +1,21 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type BruteForceSettings struct {
+	AccountMaxAttempts uint `mapstructure:"account_max_attempts"`
+	IPMaxAttempts      uint `mapstructure:"ip_max_attempts"`
+	AttemptsWindow     uint `mapstructure:"attempts_window"`
+	BaseLockout        uint `mapstructure:"base_lockout"`
+	MaxLockout         uint `mapstructure:"max_lockout"`
+	LockoutLevelTTL    uint `mapstructure:"lockout_level_ttl"`
+}
+
+func SetBruteForceDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".account_max_attempts", 5)
+	v.SetDefault(prefix+".ip_max_attempts", 20)
+	v.SetDefault(prefix+".attempts_window", 900)     // seconds
+	v.SetDefault(prefix+".base_lockout", 30)         // seconds, doubled after every lockout
+	v.SetDefault(prefix+".max_lockout", 3600)        // seconds
+	v.SetDefault(prefix+".lockout_level_ttl", 86400) // seconds without lockouts before the level is reset
+}
@@ -0,0 +1,13 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type HashingSettings struct {
+	// SecretKey keys the hashes of verification codes and refresh tokens stored at rest.
+	// Changing it invalidates every stored code and session.
+	SecretKey string `mapstructure:"secret_key"`
+}
+
+func SetHashingDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".secret_key", "") // required
+}
@@ -3,16 +3,32 @@ package settings
 import "github.com/spf13/viper"
 
 type JWTSettings struct {
-	AccessTokenSecret  string `mapstructure:"access_token_secret"`
+	// SigningKeys are the asymmetric keys access tokens are signed with.
+	// Every key is published in the JWKS document, ActiveKeyId selects the one used for new tokens.
+	SigningKeys    []SigningKeySettings `mapstructure:"signing_keys"`
+	SigningKeysDir string               `mapstructure:"signing_keys_dir"`
+	ActiveKeyId    string               `mapstructure:"active_key_id"`
+	// AllowEphemeralKey lets a service without signing keys generate one on startup.
+	// Meant for development only: the key is lost on restart and differs between replicas.
+	AllowEphemeralKey bool `mapstructure:"allow_ephemeral_key"`
+
 	RefreshTokenSecret string `mapstructure:"refresh_token_secret"`
 
 	AccessTokenTTL  uint `mapstructure:"access_token_ttl"`
 	RefreshTokenTTL uint `mapstructure:"refresh_token_ttl"`
 }
 
+type SigningKeySettings struct {
+	Id             string `mapstructure:"id"`
+	PrivateKey     string `mapstructure:"private_key"`      // PEM encoded PKCS#1/PKCS#8 RSA or PKCS#8 Ed25519 key
+	PrivateKeyFile string `mapstructure:"private_key_file"` // path to the PEM file, used when private_key is empty
+}
+
 func SetJWTDefaults(v *viper.Viper, prefix string) {
-	v.SetDefault(prefix+".access_token_secret", "access-secret-key")
-	v.SetDefault(prefix+".refresh_token_secret", "refresh-secret-key")
+	v.SetDefault(prefix+".signing_keys_dir", "")
+	v.SetDefault(prefix+".active_key_id", "")
+	v.SetDefault(prefix+".allow_ephemeral_key", false)
+	v.SetDefault(prefix+".refresh_token_secret", "") // required
 	v.SetDefault(prefix+".access_token_ttl", 900)    // 15 minutes in seconds
 	v.SetDefault(prefix+".refresh_token_ttl", 86400) // 24 hours in seconds (1 day)
 }
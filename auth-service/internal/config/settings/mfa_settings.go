@@ -0,0 +1,20 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type MfaSettings struct {
+	// Issuer is shown next to the account in authenticator apps.
+	Issuer string `mapstructure:"issuer"`
+	// EncryptionKey encrypts the TOTP secrets stored at rest.
+	// Changing it disables the second factor of every enrolled user.
+	EncryptionKey      string `mapstructure:"encryption_key"`
+	ChallengeTTL       uint   `mapstructure:"challenge_ttl"`
+	RecoveryCodesCount uint   `mapstructure:"recovery_codes_count"`
+}
+
+func SetMfaDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".issuer", "Job Search Service")
+	v.SetDefault(prefix+".encryption_key", "") // required
+	v.SetDefault(prefix+".challenge_ttl", 300) // seconds to enter the code after the password
+	v.SetDefault(prefix+".recovery_codes_count", 10)
+}
@@ -0,0 +1,44 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type NotifierSettings struct {
+	Type         string `mapstructure:"type"`
+	RetriesCount uint   `mapstructure:"retries_count"`
+	RetryDelay   uint   `mapstructure:"retry_delay"`
+	SendTimeout  uint   `mapstructure:"send_timeout"`
+	MaxPending   uint   `mapstructure:"max_pending"`
+
+	// LogBody makes the log notifier print message bodies, codes included.
+	// Only meant for local development.
+	LogBody bool `mapstructure:"log_body"`
+
+	SMTP SMTPSettings `mapstructure:"smtp"`
+
+	FilePath string `mapstructure:"file_path"`
+}
+
+type SMTPSettings struct {
+	Host     string `mapstructure:"host"`
+	Port     uint   `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+	FromName string `mapstructure:"from_name"`
+}
+
+func SetNotifierDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".type", "log") // log | file | smtp
+	v.SetDefault(prefix+".retries_count", 3)
+	v.SetDefault(prefix+".retry_delay", 500) // milliseconds, doubled after every failed attempt
+	v.SetDefault(prefix+".send_timeout", 10)
+	v.SetDefault(prefix+".max_pending", 100) // messages being sent in the background at once
+	v.SetDefault(prefix+".log_body", false)
+	v.SetDefault(prefix+".smtp.host", "localhost")
+	v.SetDefault(prefix+".smtp.port", 587)
+	v.SetDefault(prefix+".smtp.username", "")
+	v.SetDefault(prefix+".smtp.password", "")
+	v.SetDefault(prefix+".smtp.from", "no-reply@localhost")
+	v.SetDefault(prefix+".smtp.from_name", "Job Search Service")
+	v.SetDefault(prefix+".file_path", "notifications.log")
+}
@@ -0,0 +1,37 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type OidcSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Provider names the identity provider in the stored identities.
+	Provider string `mapstructure:"provider"`
+	// Issuer is the provider url, the endpoints are discovered from
+	// <issuer>/.well-known/openid-configuration.
+	Issuer       string `mapstructure:"issuer"`
+	ClientId     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+	// RedirectURL is the frontend page receiving the code, it passes the code
+	// and the state to CompleteApplicantOidcLogin.
+	RedirectURL string   `mapstructure:"redirect_url"`
+	Scopes      []string `mapstructure:"scopes"`
+
+	StateTTL        uint `mapstructure:"state_ttl"`
+	RegistrationTTL uint `mapstructure:"registration_ttl"`
+	JwksCacheTTL    uint `mapstructure:"jwks_cache_ttl"`
+	RequestTimeout  uint `mapstructure:"request_timeout"`
+}
+
+func SetOidcDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".enabled", false)
+	v.SetDefault(prefix+".provider", "oidc")
+	v.SetDefault(prefix+".issuer", "")
+	v.SetDefault(prefix+".client_id", "")
+	v.SetDefault(prefix+".client_secret", "")
+	v.SetDefault(prefix+".redirect_url", "")
+	v.SetDefault(prefix+".scopes", []string{"openid", "email", "profile"})
+	v.SetDefault(prefix+".state_ttl", 600)         // seconds to come back from the provider
+	v.SetDefault(prefix+".registration_ttl", 1800) // seconds to fill in the profile of a new account
+	v.SetDefault(prefix+".jwks_cache_ttl", 3600)
+	v.SetDefault(prefix+".request_timeout", 10)
+}
@@ -0,0 +1,23 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type PasswordHashingSettings struct {
+	Argon2 Argon2Settings `mapstructure:"argon2"`
+}
+
+type Argon2Settings struct {
+	Memory      uint32 `mapstructure:"memory"`
+	Iterations  uint32 `mapstructure:"iterations"`
+	Parallelism uint8  `mapstructure:"parallelism"`
+	SaltLength  uint32 `mapstructure:"salt_length"`
+	KeyLength   uint32 `mapstructure:"key_length"`
+}
+
+func SetPasswordHashingDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".argon2.memory", 65536) // KiB
+	v.SetDefault(prefix+".argon2.iterations", 3)
+	v.SetDefault(prefix+".argon2.parallelism", 2)
+	v.SetDefault(prefix+".argon2.salt_length", 16) // bytes
+	v.SetDefault(prefix+".argon2.key_length", 32)  // bytes
+}
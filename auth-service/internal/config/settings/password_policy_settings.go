@@ -0,0 +1,12 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type PasswordPolicySettings struct {
+	// HistorySize is the number of previous passwords a new password must differ from, 0 disables the check.
+	HistorySize uint `mapstructure:"history_size"`
+}
+
+func SetPasswordPolicyDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".history_size", 5)
+}
@@ -0,0 +1,17 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type RegistrationSettings struct {
+	// ReconcileInterval is how often registrations left open by a crash are repaired.
+	ReconcileInterval uint `mapstructure:"reconcile_interval"`
+	// StaleAfter protects registrations still in progress from being repaired.
+	StaleAfter         uint `mapstructure:"stale_after"`
+	ReconcileBatchSize uint `mapstructure:"reconcile_batch_size"`
+}
+
+func SetRegistrationDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".reconcile_interval", 60) // seconds
+	v.SetDefault(prefix+".stale_after", 300)       // seconds since the last step of the registration
+	v.SetDefault(prefix+".reconcile_batch_size", 100)
+}
@@ -0,0 +1,18 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type RetentionSettings struct {
+	// GracePeriod is how long the credentials of a deleted account are kept so
+	// it can be restored. It must not be shorter than the grace period of the
+	// user service, or restored accounts are left without a password.
+	GracePeriod    uint `mapstructure:"grace_period"`
+	PurgeInterval  uint `mapstructure:"purge_interval"`
+	PurgeBatchSize uint `mapstructure:"purge_batch_size"`
+}
+
+func SetRetentionDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".grace_period", 2592000) // 30 days in seconds
+	v.SetDefault(prefix+".purge_interval", 3600)  // 1 hour in seconds
+	v.SetDefault(prefix+".purge_batch_size", 100)
+}
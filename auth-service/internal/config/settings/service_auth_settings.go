@@ -0,0 +1,16 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type ServiceAuthSettings struct {
+	// Audience is the name the callers issue their service tokens for.
+	Audience string `mapstructure:"audience"`
+	// Services maps the callers allowed to use the internal rpcs to their secrets.
+	Services map[string]string `mapstructure:"services"`
+}
+
+func SetServiceAuthDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".audience", "auth-service")
+	// the secret has no default, the service does not start until it is set
+	v.SetDefault(prefix+".services.user-service", "")
+}
@@ -0,0 +1,19 @@
+package settings
+
+import "github.com/spf13/viper"
+
+// ServiceTokenSettings configure the token the auth service calls the
+// user service with. The secret is shared with the user service only.
+type ServiceTokenSettings struct {
+	Service  string `mapstructure:"service"`
+	Audience string `mapstructure:"audience"`
+	Secret   string `mapstructure:"secret"`
+	TTL      uint   `mapstructure:"ttl"`
+}
+
+func SetServiceTokenDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".service", "auth-service")
+	v.SetDefault(prefix+".audience", "user-service")
+	v.SetDefault(prefix+".secret", "") // required, shared with the called service
+	v.SetDefault(prefix+".ttl", 300)   // 5 minutes in seconds
+}
@@ -0,0 +1,46 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type SessionCookieSettings struct {
+	// Enabled makes the http gateway keep the tokens in HttpOnly cookies
+	// instead of returning them to the browser.
+	Enabled bool `mapstructure:"enabled"`
+	// Domain is left empty for host-only cookies.
+	Domain   string `mapstructure:"domain"`
+	Secure   bool   `mapstructure:"secure"`
+	SameSite string `mapstructure:"same_site"` // strict, lax or none
+
+	AccessTokenName  string `mapstructure:"access_token_name"`
+	AccessTokenPath  string `mapstructure:"access_token_path"`
+	RefreshTokenName string `mapstructure:"refresh_token_name"`
+	// RefreshTokenPaths are the endpoints reading the refresh token, the cookie
+	// is set once for every path so no other request carries it.
+	RefreshTokenPaths []string `mapstructure:"refresh_token_paths"`
+
+	// CsrfTokenName is the only cookie readable by scripts, its value has to
+	// be sent back in CsrfHeader with every unsafe request. The value is bound
+	// to the tokens of the session, a token planted by another site is rejected.
+	CsrfTokenName string `mapstructure:"csrf_token_name"`
+	CsrfHeader    string `mapstructure:"csrf_header"`
+}
+
+func SetSessionCookieDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".enabled", false)
+	v.SetDefault(prefix+".domain", "")
+	v.SetDefault(prefix+".secure", true)
+	v.SetDefault(prefix+".same_site", "strict")
+	v.SetDefault(prefix+".access_token_name", "access_token")
+	v.SetDefault(prefix+".access_token_path", "/")
+	v.SetDefault(prefix+".refresh_token_name", "refresh_token")
+	v.SetDefault(prefix+".refresh_token_paths", []string{
+		"/api/v1/applicant/refresh",
+		"/api/v1/applicant/logout",
+		"/api/v1/applicant/sessions",
+		"/api/v1/employer/refresh",
+		"/api/v1/employer/logout",
+		"/api/v1/employer/sessions",
+	})
+	v.SetDefault(prefix+".csrf_token_name", "csrf_token")
+	v.SetDefault(prefix+".csrf_header", "X-CSRF-Token")
+}
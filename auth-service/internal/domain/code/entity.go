@@ -1,28 +1,36 @@
 package code
 
-import "time"
+import (
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+)
 
 const (
 	maxGenerationsLeft = 3
+	maxAttemptsLeft    = 5
 	codeTTL            = 10 * time.Minute
 )
 
 type Code struct {
 	id              int64
 	userId          int64
-	code            string
+	code            string // raw code, only known right after generation
+	codeHash        string
 	generationsLeft int
 	expiresAt       time.Time
 	createdAt       time.Time
 	updatedAt       time.Time
+	attemptsLeft    int
+	newEmail        string // address being confirmed, only set for email change codes
 }
 
-func New(userId int64) (*Code, error) {
+func New(userId int64, hasher *secret.Hasher) (*Code, error) {
 	c := &Code{}
 	c.generationsLeft = maxGenerationsLeft
 	c.userId = userId
 
-	if err := c.GenerateCode(); err != nil {
+	if err := c.GenerateCode(hasher); err != nil {
 		return nil, err
 	}
 
@@ -35,28 +43,35 @@ func New(userId int64) (*Code, error) {
 
 func FromStorage(
 	id, userId int64,
-	code string,
+	codeHash string,
 	generationsLeft int,
 	expiresAt, createdAt, updatedAt time.Time,
+	attemptsLeft int,
+	newEmail string,
 ) *Code {
 	return &Code{
 		id:              id,
 		userId:          userId,
-		code:            code,
+		codeHash:        codeHash,
 		generationsLeft: generationsLeft,
 		expiresAt:       expiresAt,
 		createdAt:       createdAt,
 		updatedAt:       updatedAt,
+		attemptsLeft:    attemptsLeft,
+		newEmail:        newEmail,
 	}
 }
 
 func (c *Code) Id() int64            { return c.id }
 func (c *Code) UserId() int64        { return c.userId }
 func (c *Code) Code() string         { return c.code }
+func (c *Code) CodeHash() string     { return c.codeHash }
 func (c *Code) GenerationsLeft() int { return c.generationsLeft }
 func (c *Code) ExpiresAt() time.Time { return c.expiresAt }
 func (c *Code) CreatedAt() time.Time { return c.createdAt }
 func (c *Code) UpdatedAt() time.Time { return c.updatedAt }
+func (c *Code) AttemptsLeft() int    { return c.attemptsLeft }
+func (c *Code) NewEmail() string     { return c.newEmail }
 
 func (c *Code) SetId(id int64) {
 	if c.Id() == 0 {
@@ -64,7 +79,11 @@ func (c *Code) SetId(id int64) {
 	}
 }
 
-func (c *Code) GenerateCode() error {
+func (c *Code) SetNewEmail(newEmail string) {
+	c.newEmail = newEmail
+}
+
+func (c *Code) GenerateCode(hasher *secret.Hasher) error {
 	if c.Id() != 0 {
 		if c.generationsLeft <= 0 && time.Since(c.updatedAt) < 5*time.Minute {
 			return NewCodeValidationError("the number of code resends has been exhausted")
@@ -79,17 +98,32 @@ func (c *Code) GenerateCode() error {
 		return err
 	}
 	c.code = code
+	c.codeHash = hasher.Hash(code)
+	c.attemptsLeft = maxAttemptsLeft
 	c.expiresAt = time.Now().Add(codeTTL)
 	c.updatedAt = time.Now()
 	return nil
 }
 
-func (c *Code) CheckCode(rawCode string) (bool, error) {
+// CheckCode compares rawCode with the code. Every failed attempt is counted and
+// the code is invalidated once the attempts are exhausted, so the caller has to
+// persist the code after a failed check.
+func (c *Code) CheckCode(rawCode string, hasher *secret.Hasher) (bool, error) {
+	if c.attemptsLeft <= 0 {
+		return false, NewCodeValidationError("too many failed attempts, request a new code")
+	}
 	if time.Now().After(c.expiresAt) {
 		return false, NewCodeValidationError("code has been expired")
 	}
-	if c.code == rawCode {
+	if hasher.Equal(c.codeHash, rawCode) {
 		return true, nil
 	}
+
+	c.attemptsLeft--
+	c.updatedAt = time.Now()
+	if c.attemptsLeft <= 0 {
+		c.expiresAt = c.updatedAt
+		return false, NewCodeValidationError("too many failed attempts, request a new code")
+	}
 	return false, nil
 }
@@ -0,0 +1,184 @@
+package code
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+)
+
+func newTestHasher(t *testing.T) *secret.Hasher {
+	t.Helper()
+
+	hasher, err := secret.NewHasher([]byte("test key"))
+	if err != nil {
+		t.Fatalf("new hasher: %v", err)
+	}
+	return hasher
+}
+
+// wrongCode returns a six digit code other than c.
+func wrongCode(c *Code) string {
+	if c.Code() == "000000" {
+		return "000001"
+	}
+	return "000000"
+}
+
+func TestCheckCode(t *testing.T) {
+	hasher := newTestHasher(t)
+
+	tests := []struct {
+		name          string
+		failures      int
+		expired       bool
+		useRightCode  bool
+		want          bool
+		wantErr       bool
+		wantAttempts  int
+		wantExpiredAt bool
+	}{
+		{
+			name:         "right code",
+			useRightCode: true,
+			want:         true,
+			wantAttempts: maxAttemptsLeft,
+		},
+		{
+			name:         "wrong code",
+			wantAttempts: maxAttemptsLeft - 1,
+		},
+		{
+			name:         "right code after failures",
+			failures:     maxAttemptsLeft - 1,
+			useRightCode: true,
+			want:         true,
+			wantAttempts: 1,
+		},
+		{
+			name:          "last attempt failed",
+			failures:      maxAttemptsLeft - 1,
+			wantErr:       true,
+			wantAttempts:  0,
+			wantExpiredAt: true,
+		},
+		{
+			// guessing on does not help once the attempts are gone
+			name:          "right code after the attempts ran out",
+			failures:      maxAttemptsLeft,
+			useRightCode:  true,
+			wantErr:       true,
+			wantAttempts:  0,
+			wantExpiredAt: true,
+		},
+		{
+			name:         "expired code",
+			expired:      true,
+			useRightCode: true,
+			wantErr:      true,
+			wantAttempts: maxAttemptsLeft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(1, hasher)
+			if err != nil {
+				t.Fatalf("new code: %v", err)
+			}
+			if tt.expired {
+				c.expiresAt = time.Now().Add(-time.Second)
+			}
+			for range tt.failures {
+				c.CheckCode(wrongCode(c), hasher)
+			}
+
+			rawCode := wrongCode(c)
+			if tt.useRightCode {
+				rawCode = c.Code()
+			}
+			got, err := c.CheckCode(rawCode, hasher)
+
+			var cve *CodeValidationError
+			if tt.wantErr != errors.As(err, &cve) {
+				t.Fatalf("err = %v, want validation error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("valid = %v, want %v", got, tt.want)
+			}
+			if c.AttemptsLeft() != tt.wantAttempts {
+				t.Errorf("attempts left = %d, want %d", c.AttemptsLeft(), tt.wantAttempts)
+			}
+			if tt.wantExpiredAt && c.ExpiresAt().After(time.Now()) {
+				t.Errorf("code still valid until %v", c.ExpiresAt())
+			}
+		})
+	}
+}
+
+func TestGenerateCodeRestoresAttempts(t *testing.T) {
+	hasher := newTestHasher(t)
+
+	c, err := New(1, hasher)
+	if err != nil {
+		t.Fatalf("new code: %v", err)
+	}
+	c.SetId(1)
+	for range maxAttemptsLeft {
+		c.CheckCode(wrongCode(c), hasher)
+	}
+
+	if err := c.GenerateCode(hasher); err != nil {
+		t.Fatalf("generate code: %v", err)
+	}
+	if c.AttemptsLeft() != maxAttemptsLeft {
+		t.Errorf("attempts left = %d, want %d", c.AttemptsLeft(), maxAttemptsLeft)
+	}
+	if ok, err := c.CheckCode(c.Code(), hasher); !ok || err != nil {
+		t.Errorf("new code rejected: %v, %v", ok, err)
+	}
+}
+
+func TestGenerateCodeLimitsResends(t *testing.T) {
+	hasher := newTestHasher(t)
+
+	c, err := New(1, hasher)
+	if err != nil {
+		t.Fatalf("new code: %v", err)
+	}
+	c.SetId(1)
+	for i := 1; i < maxGenerationsLeft; i++ {
+		if err := c.GenerateCode(hasher); err != nil {
+			t.Fatalf("resend %d: %v", i, err)
+		}
+	}
+
+	var cve *CodeValidationError
+	if err := c.GenerateCode(hasher); !errors.As(err, &cve) {
+		t.Fatalf("resend over the limit: err = %v, want validation error", err)
+	}
+
+	c.updatedAt = time.Now().Add(-5 * time.Minute)
+	if err := c.GenerateCode(hasher); err != nil {
+		t.Fatalf("resend after the pause: %v", err)
+	}
+}
+
+func TestCodeIsStoredHashed(t *testing.T) {
+	hasher := newTestHasher(t)
+
+	c, err := New(1, hasher)
+	if err != nil {
+		t.Fatalf("new code: %v", err)
+	}
+	if c.CodeHash() == c.Code() || !hasher.Equal(c.CodeHash(), c.Code()) {
+		t.Fatalf("code hash %q does not hash the code", c.CodeHash())
+	}
+
+	// only the hash is stored, a code read back can still be checked
+	stored := FromStorage(1, 1, c.CodeHash(), c.GenerationsLeft(), c.ExpiresAt(), c.CreatedAt(), c.UpdatedAt(), c.AttemptsLeft(), "")
+	if ok, err := stored.CheckCode(c.Code(), hasher); !ok || err != nil {
+		t.Fatalf("stored code rejected: %v, %v", ok, err)
+	}
+}
@@ -0,0 +1,162 @@
+package mfa
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+)
+
+// Mfa is the TOTP second factor of a user. It is stored unconfirmed on
+// enrollment and only takes part in the login once a code was confirmed.
+type Mfa struct {
+	id                 int64
+	userId             int64
+	secret             string // raw base32 secret, only known right after enrollment
+	encryptedSecret    string
+	isConfirmed        bool
+	recoveryCodeHashes []string
+	lastUsedStep       int64
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+func New(userId int64, cipher *secret.Cipher) (*Mfa, error) {
+	m := &Mfa{}
+	m.userId = userId
+
+	totpSecret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	encryptedSecret, err := cipher.Encrypt(totpSecret)
+	if err != nil {
+		return nil, err
+	}
+	m.secret = totpSecret
+	m.encryptedSecret = encryptedSecret
+
+	now := time.Now()
+	m.createdAt = now
+	m.updatedAt = now
+
+	return m, nil
+}
+
+func FromStorage(
+	id, userId int64,
+	encryptedSecret string,
+	isConfirmed bool,
+	recoveryCodeHashes []string,
+	lastUsedStep int64,
+	createdAt, updatedAt time.Time,
+) *Mfa {
+	return &Mfa{
+		id:                 id,
+		userId:             userId,
+		encryptedSecret:    encryptedSecret,
+		isConfirmed:        isConfirmed,
+		recoveryCodeHashes: recoveryCodeHashes,
+		lastUsedStep:       lastUsedStep,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+func (m *Mfa) Id() int64                    { return m.id }
+func (m *Mfa) UserId() int64                { return m.userId }
+func (m *Mfa) Secret() string               { return m.secret }
+func (m *Mfa) EncryptedSecret() string      { return m.encryptedSecret }
+func (m *Mfa) IsConfirmed() bool            { return m.isConfirmed }
+func (m *Mfa) RecoveryCodeHashes() []string { return m.recoveryCodeHashes }
+func (m *Mfa) LastUsedStep() int64          { return m.lastUsedStep }
+func (m *Mfa) CreatedAt() time.Time         { return m.createdAt }
+func (m *Mfa) UpdatedAt() time.Time         { return m.updatedAt }
+
+func (m *Mfa) SetId(id int64) {
+	if m.Id() == 0 {
+		m.id = id
+	}
+}
+
+// ProvisioningURI returns the otpauth:// uri rendered as a QR code by authenticator apps.
+func (m *Mfa) ProvisioningURI(issuer, account string) string {
+	q := url.Values{}
+	q.Set("secret", m.secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", "6")
+	q.Set("period", "30")
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+// CheckTOTP compares rawCode with the current TOTP code. A matched code is
+// remembered and cannot be used again, so the caller has to persist the mfa
+// after a successful check.
+func (m *Mfa) CheckTOTP(rawCode string, cipher *secret.Cipher) (bool, error) {
+	if !isTOTPCode(rawCode) {
+		return false, nil
+	}
+
+	totpSecret, err := cipher.Decrypt(m.encryptedSecret)
+	if err != nil {
+		return false, err
+	}
+
+	step, ok, err := matchTOTP(totpSecret, rawCode, time.Now(), m.lastUsedStep)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	m.lastUsedStep = step
+	m.updatedAt = time.Now()
+	return true, nil
+}
+
+// UseRecoveryCode consumes the recovery code matching rawCode.
+func (m *Mfa) UseRecoveryCode(rawCode string, hasher *secret.Hasher) bool {
+	normalized := normalizeRecoveryCode(rawCode)
+	for i, hash := range m.recoveryCodeHashes {
+		if hasher.Equal(hash, normalized) {
+			m.recoveryCodeHashes = append(m.recoveryCodeHashes[:i:i], m.recoveryCodeHashes[i+1:]...)
+			m.updatedAt = time.Now()
+			return true
+		}
+	}
+	return false
+}
+
+// Verify accepts either a TOTP code or one of the recovery codes.
+func (m *Mfa) Verify(rawCode string, cipher *secret.Cipher, hasher *secret.Hasher) (bool, error) {
+	if isTOTPCode(rawCode) {
+		return m.CheckTOTP(rawCode, cipher)
+	}
+	return m.UseRecoveryCode(rawCode, hasher), nil
+}
+
+// Confirm enables the mfa and replaces the recovery codes. The raw recovery
+// codes are returned once and only their hashes are kept.
+func (m *Mfa) Confirm(recoveryCodesCount int, hasher *secret.Hasher) ([]string, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+	for i := 0; i < recoveryCodesCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, hasher.Hash(normalizeRecoveryCode(code)))
+	}
+
+	m.isConfirmed = true
+	m.recoveryCodeHashes = hashes
+	m.updatedAt = time.Now()
+	return codes, nil
+}
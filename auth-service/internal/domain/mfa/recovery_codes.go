@@ -0,0 +1,35 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"strings"
+)
+
+const (
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryCodeGroup    = 5
+)
+
+// generateRecoveryCode returns a code formatted as xxxxx-xxxxx.
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 2*recoveryCodeGroup)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for i, v := range b {
+		if i == recoveryCodeGroup {
+			sb.WriteByte('-')
+		}
+		// 256 % 31 != 0, but the bias is negligible for single-use codes of 50 bits
+		sb.WriteByte(recoveryCodeAlphabet[int(v)%len(recoveryCodeAlphabet)])
+	}
+	return sb.String(), nil
+}
+
+// normalizeRecoveryCode makes the comparison tolerant to case, spaces and dashes.
+func normalizeRecoveryCode(rawCode string) string {
+	rawCode = strings.ToLower(rawCode)
+	return strings.NewReplacer("-", "", " ", "").Replace(rawCode)
+}
@@ -0,0 +1,153 @@
+package mfa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+)
+
+// rfcSecret is the SHA-1 key of the RFC 6238 test vectors, "12345678901234567890".
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	key, err := base32NoPadding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatalf("decode secret: %v", err)
+	}
+
+	// the last six digits of the RFC 6238 appendix B values
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		if got := totpCode(key, totpStep(time.Unix(tt.unix, 0))); got != tt.want {
+			t.Errorf("code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestMatchTOTP(t *testing.T) {
+	key, err := base32NoPadding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatalf("decode secret: %v", err)
+	}
+	now := time.Unix(1234567890, 0)
+	current := totpStep(now)
+
+	tests := []struct {
+		name         string
+		secret       string
+		step         int64
+		lastUsedStep int64
+		wantStep     int64
+		wantOk       bool
+	}{
+		{name: "current step", step: current, wantStep: current, wantOk: true},
+		{name: "previous step", step: current - 1, wantStep: current - 1, wantOk: true},
+		{name: "next step", step: current + 1, wantStep: current + 1, wantOk: true},
+		{name: "two steps behind", step: current - 2},
+		{name: "two steps ahead", step: current + 2},
+		{name: "lowercase secret", secret: "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", step: current, wantStep: current, wantOk: true},
+		{
+			// the code just used cannot be sent again
+			name:         "replayed step",
+			step:         current,
+			lastUsedStep: current,
+		},
+		{
+			// nor can an older one once a newer code was used
+			name:         "step before the last used",
+			step:         current - 1,
+			lastUsedStep: current,
+		},
+		{
+			name:         "step after the last used",
+			step:         current + 1,
+			lastUsedStep: current,
+			wantStep:     current + 1,
+			wantOk:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := tt.secret
+			if secret == "" {
+				secret = rfcSecret
+			}
+
+			step, ok, err := matchTOTP(secret, totpCode(key, tt.step), now, tt.lastUsedStep)
+			if err != nil {
+				t.Fatalf("match: %v", err)
+			}
+			if ok != tt.wantOk || step != tt.wantStep {
+				t.Errorf("match = %d, %v, want %d, %v", step, ok, tt.wantStep, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestCheckTOTPRejectsReplay(t *testing.T) {
+	cipher, err := secret.NewCipher([]byte("test key"))
+	if err != nil {
+		t.Fatalf("new cipher: %v", err)
+	}
+	m, err := New(1, cipher)
+	if err != nil {
+		t.Fatalf("new mfa: %v", err)
+	}
+	key, err := base32NoPadding.DecodeString(m.Secret())
+	if err != nil {
+		t.Fatalf("decode secret: %v", err)
+	}
+	rawCode := totpCode(key, totpStep(time.Now()))
+
+	if ok, err := m.CheckTOTP(rawCode, cipher); !ok || err != nil {
+		t.Fatalf("first use = %v, %v, want accepted", ok, err)
+	}
+	if m.LastUsedStep() == 0 {
+		t.Fatal("used step is not remembered")
+	}
+
+	// the step survives storage, a replay after reloading the mfa fails too
+	stored := FromStorage(1, 1, m.EncryptedSecret(), true, nil, m.LastUsedStep(), m.CreatedAt(), m.UpdatedAt())
+	if ok, err := stored.CheckTOTP(rawCode, cipher); ok || err != nil {
+		t.Fatalf("replay = %v, %v, want rejected", ok, err)
+	}
+}
+
+func TestMatchTOTPInvalidSecret(t *testing.T) {
+	if _, _, err := matchTOTP("not base32!", "123456", time.Now(), 0); err == nil {
+		t.Fatal("invalid secret accepted")
+	}
+}
+
+func TestIsTOTPCode(t *testing.T) {
+	tests := []struct {
+		rawCode string
+		want    bool
+	}{
+		{rawCode: "123456", want: true},
+		{rawCode: "000000", want: true},
+		{rawCode: "12345"},
+		{rawCode: "1234567"},
+		{rawCode: "12a456"},
+		{rawCode: "ABCD-EFGH"},
+		{rawCode: ""},
+	}
+
+	for _, tt := range tests {
+		if got := isTOTPCode(tt.rawCode); got != tt.want {
+			t.Errorf("isTOTPCode(%q) = %v, want %v", tt.rawCode, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package oidc
+
+import "time"
+
+// Identity links an account of the identity provider, identified by the
+// subject, to a user.
+type Identity struct {
+	id        int64
+	userId    int64
+	provider  string
+	subject   string
+	email     string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func New(userId int64, profile *Profile) *Identity {
+	now := time.Now()
+	return &Identity{
+		userId:    userId,
+		provider:  profile.Provider,
+		subject:   profile.Subject,
+		email:     profile.Email,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+func FromStorage(
+	id, userId int64,
+	provider, subject, email string,
+	createdAt, updatedAt time.Time,
+) *Identity {
+	return &Identity{
+		id:        id,
+		userId:    userId,
+		provider:  provider,
+		subject:   subject,
+		email:     email,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (i *Identity) Id() int64            { return i.id }
+func (i *Identity) UserId() int64        { return i.userId }
+func (i *Identity) Provider() string     { return i.provider }
+func (i *Identity) Subject() string      { return i.subject }
+func (i *Identity) Email() string        { return i.email }
+func (i *Identity) CreatedAt() time.Time { return i.createdAt }
+func (i *Identity) UpdatedAt() time.Time { return i.updatedAt }
+
+func (i *Identity) SetId(id int64) {
+	if i.Id() == 0 {
+		i.id = id
+	}
+}
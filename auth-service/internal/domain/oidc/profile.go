@@ -0,0 +1,12 @@
+package oidc
+
+// Profile is the user verified by the identity provider.
+type Profile struct {
+	Provider      string `json:"provider"`
+	Subject       string `json:"subject"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	Birthdate     string `json:"birthdate"` // YYYY-MM-DD as defined by OpenID Connect
+}
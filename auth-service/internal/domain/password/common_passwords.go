@@ -0,0 +1,52 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"strings"
+	"sync"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+var (
+	commonPasswordsOnce sync.Once
+	commonPasswords     map[string][]string // hash prefix -> hash suffixes
+)
+
+// IsCommonPassword reports whether the lowercased password is on the bundled
+// list of common passwords. The list holds SHA-1 hashes grouped by their
+// 5 character prefix, so no plaintext passwords ship with the service.
+func IsCommonPassword(password string) bool {
+	commonPasswordsOnce.Do(loadCommonPasswords)
+
+	sum := sha1.Sum([]byte(strings.ToLower(password)))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	for _, suffix := range commonPasswords[hash[:5]] {
+		if suffix == hash[5:] {
+			return true
+		}
+	}
+	return false
+}
+
+func loadCommonPasswords() {
+	commonPasswords = make(map[string][]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(commonPasswordsFile))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		prefix, suffix, ok := strings.Cut(line, ":")
+		if !ok || len(prefix) != 5 {
+			continue
+		}
+		commonPasswords[strings.ToUpper(prefix)] = append(commonPasswords[strings.ToUpper(prefix)], strings.ToUpper(suffix))
+	}
+}
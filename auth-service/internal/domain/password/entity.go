@@ -2,8 +2,6 @@ package password
 
 import (
 	"time"
-
-	"golang.org/x/crypto/bcrypt"
 )
 
 type Password struct {
@@ -14,11 +12,11 @@ type Password struct {
 	updatedAt    time.Time
 }
 
-func New(userId int64, password string) (*Password, error) {
+func New(userId int64, password string, hasher *Hasher) (*Password, error) {
 	p := &Password{}
 	p.userId = userId
 
-	if err := p.SetPassword(password); err != nil {
+	if err := p.SetPassword(password, hasher); err != nil {
 		return nil, err
 	}
 
@@ -55,7 +53,7 @@ func (p *Password) SetId(id int64) {
 	}
 }
 
-func (p *Password) SetPassword(password string) error {
+func (p *Password) SetPassword(password string, hasher *Hasher) error {
 	if err := ValidatePassword(password); err != nil {
 		return err
 	}
@@ -66,19 +64,59 @@ func (p *Password) SetPassword(password string) error {
 		}
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hash, err := hasher.Hash(password)
 	if err != nil {
 		return err
 	}
-	p.passwordHash = string(hash)
+	p.passwordHash = hash
 	p.updatedAt = time.Now()
 	return nil
 }
 
-func (p *Password) Check(password string) bool {
-	if err := bcrypt.CompareHashAndPassword([]byte(p.passwordHash), []byte(password)); err != nil {
-		return false
-	} else {
-		return true
+// Check reports whether password is correct and whether the stored hash is
+// outdated and should be replaced with Rehash.
+func (p *Password) Check(password string, hasher *Hasher) (correct bool, needsRehash bool, err error) {
+	return hasher.Verify(p.passwordHash, password)
+}
+
+// ToHistoryEntry snapshots the current hash before the password is changed.
+func (p *Password) ToHistoryEntry() *HistoryEntry {
+	return &HistoryEntry{
+		userId:       p.userId,
+		passwordHash: p.passwordHash,
+		createdAt:    time.Now(),
 	}
 }
+
+// CheckReuse returns a validation error when password is the current
+// password or one of the previous passwords in history.
+func (p *Password) CheckReuse(password string, history []*HistoryEntry, hasher *Hasher) error {
+	hashes := make([]string, 0, len(history)+1)
+	hashes = append(hashes, p.passwordHash)
+	for _, e := range history {
+		hashes = append(hashes, e.PasswordHash())
+	}
+
+	for _, hash := range hashes {
+		reused, _, err := hasher.Verify(hash, password)
+		if err != nil {
+			return err
+		}
+		if reused {
+			return NewPasswordValidationError("password has been used recently, choose a different one")
+		}
+	}
+	return nil
+}
+
+// Rehash replaces the hash of the already verified password with a hash in
+// the current format. Unlike SetPassword it is not a password change, so
+// neither the validation nor the change limit applies.
+func (p *Password) Rehash(password string, hasher *Hasher) error {
+	hash, err := hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	p.passwordHash = hash
+	return nil
+}
@@ -0,0 +1,96 @@
+package password
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckReuse(t *testing.T) {
+	hasher := NewHasher(testParams)
+
+	current := FromStorage(1, 1, mustHash(t, hasher, "Current#2024"), time.Time{}, time.Time{})
+	history := []*HistoryEntry{
+		HistoryEntryFromStorage(1, 1, mustHash(t, hasher, "Previous#2023"), time.Time{}),
+		// entries hashed before argon2id was introduced are still compared
+		HistoryEntryFromStorage(2, 1, mustBcrypt(t, "Ancient#2019"), time.Time{}),
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		history  []*HistoryEntry
+		wantErr  bool
+	}{
+		{name: "current password", password: "Current#2024", history: history, wantErr: true},
+		{name: "previous password", password: "Previous#2023", history: history, wantErr: true},
+		{name: "bcrypt history entry", password: "Ancient#2019", history: history, wantErr: true},
+		{name: "new password", password: "Brandnew#2025", history: history},
+		{name: "differs in case only", password: "current#2024", history: history},
+		{name: "no history", password: "Previous#2023"},
+		{name: "current password without history", password: "Current#2024", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := current.CheckReuse(tt.password, tt.history, hasher)
+
+			var pve *PasswordValidationError
+			if tt.wantErr != errors.As(err, &pve) {
+				t.Fatalf("err = %v, want validation error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestCheckReuseUnknownHash(t *testing.T) {
+	hasher := NewHasher(testParams)
+
+	current := FromStorage(1, 1, mustHash(t, hasher, "Current#2024"), time.Time{}, time.Time{})
+	history := []*HistoryEntry{HistoryEntryFromStorage(1, 1, "$1$salt$hash", time.Time{})}
+
+	if err := current.CheckReuse("Brandnew#2025", history, hasher); !errors.Is(err, ErrUnknownHashFormat) {
+		t.Fatalf("err = %v, want %v", err, ErrUnknownHashFormat)
+	}
+}
+
+func TestIsCommonPassword(t *testing.T) {
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{password: "password", want: true},
+		{password: "Password", want: true},
+		{password: "PASSWORD", want: true},
+		{password: "P@ssw0rd", want: true},
+		{password: "Password123!", want: true},
+		{password: "Qwerty123!", want: true},
+		{password: "Welcome1!", want: true},
+		{password: "Tr0ub4dour&3-horse-staple"},
+		{password: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			if got := IsCommonPassword(tt.password); got != tt.want {
+				t.Errorf("IsCommonPassword(%q) = %v, want %v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePasswordRejectsCommon(t *testing.T) {
+	// passes every character class rule, only the list rejects it
+	err := ValidatePassword("Password123!")
+
+	var pve *PasswordValidationError
+	if !errors.As(err, &pve) {
+		t.Fatalf("err = %v, want validation error", err)
+	}
+	if err := ValidatePassword("Tr0ub4dour&3-horse-staple"); err != nil {
+		t.Fatalf("uncommon password rejected: %v", err)
+	}
+}
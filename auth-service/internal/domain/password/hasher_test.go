@@ -0,0 +1,158 @@
+package password
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testParams are cheap argon2id parameters, the hashes are not meant to be strong.
+var testParams = Argon2Params{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func mustHash(t *testing.T, h *Hasher, password string) string {
+	t.Helper()
+
+	hash, err := h.Hash(password)
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	return hash
+}
+
+func mustBcrypt(t *testing.T, password string) string {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt: %v", err)
+	}
+	return string(hash)
+}
+
+func TestVerify(t *testing.T) {
+	const password = "Secret#2024"
+	hasher := NewHasher(testParams)
+
+	outdated := testParams
+	outdated.Iterations = 2
+	moreMemory := testParams
+	moreMemory.Memory = 128
+	longerKey := testParams
+	longerKey.KeyLength = 16
+
+	tests := []struct {
+		name            string
+		hash            string
+		password        string
+		wantOk          bool
+		wantNeedsRehash bool
+		wantErr         error
+	}{
+		{
+			name:     "current params",
+			hash:     mustHash(t, hasher, password),
+			password: password,
+			wantOk:   true,
+		},
+		{
+			name:     "current params, wrong password",
+			hash:     mustHash(t, hasher, password),
+			password: "Secret#2025",
+		},
+		{
+			name:            "outdated iterations",
+			hash:            mustHash(t, NewHasher(outdated), password),
+			password:        password,
+			wantOk:          true,
+			wantNeedsRehash: true,
+		},
+		{
+			name:            "outdated memory",
+			hash:            mustHash(t, NewHasher(moreMemory), password),
+			password:        password,
+			wantOk:          true,
+			wantNeedsRehash: true,
+		},
+		{
+			name:            "outdated key length",
+			hash:            mustHash(t, NewHasher(longerKey), password),
+			password:        password,
+			wantOk:          true,
+			wantNeedsRehash: true,
+		},
+		{
+			// a wrong password must not trigger a rehash with it
+			name:     "outdated params, wrong password",
+			hash:     mustHash(t, NewHasher(outdated), password),
+			password: "Secret#2025",
+		},
+		{
+			name:            "bcrypt",
+			hash:            mustBcrypt(t, password),
+			password:        password,
+			wantOk:          true,
+			wantNeedsRehash: true,
+		},
+		{
+			name:     "bcrypt, wrong password",
+			hash:     mustBcrypt(t, password),
+			password: "Secret#2025",
+		},
+		{
+			name:     "unknown format",
+			hash:     "$1$salt$hash",
+			password: password,
+			wantErr:  ErrUnknownHashFormat,
+		},
+		{
+			name:     "malformed argon2id",
+			hash:     "$argon2id$v=19$m=64,t=1,p=1$salt",
+			password: password,
+			wantErr:  ErrUnknownHashFormat,
+		},
+		{
+			name:     "unsupported argon2 version",
+			hash:     "$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5",
+			password: password,
+			wantErr:  ErrUnknownHashFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, needsRehash, err := hasher.Verify(tt.hash, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("ok = %v, want %v", ok, tt.wantOk)
+			}
+			if needsRehash != tt.wantNeedsRehash {
+				t.Errorf("needsRehash = %v, want %v", needsRehash, tt.wantNeedsRehash)
+			}
+		})
+	}
+}
+
+func TestRehashUsesCurrentParams(t *testing.T) {
+	const password = "Secret#2024"
+	hasher := NewHasher(testParams)
+
+	p := FromStorage(1, 1, mustBcrypt(t, password), time.Time{}, time.Time{})
+	if err := p.Rehash(password, hasher); err != nil {
+		t.Fatalf("rehash: %v", err)
+	}
+
+	ok, needsRehash, err := p.Check(password, hasher)
+	if err != nil || !ok || needsRehash {
+		t.Fatalf("check after rehash = %v, %v, %v, want a current hash of the password", ok, needsRehash, err)
+	}
+}
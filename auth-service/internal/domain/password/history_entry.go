@@ -0,0 +1,36 @@
+package password
+
+import "time"
+
+// HistoryEntry is the hash of a password the user had before, kept to
+// prevent setting it again right after a change or a reset.
+type HistoryEntry struct {
+	id           int64
+	userId       int64
+	passwordHash string
+	createdAt    time.Time
+}
+
+func HistoryEntryFromStorage(
+	id, userId int64,
+	passwordHash string,
+	createdAt time.Time,
+) *HistoryEntry {
+	return &HistoryEntry{
+		id:           id,
+		userId:       userId,
+		passwordHash: passwordHash,
+		createdAt:    createdAt,
+	}
+}
+
+func (e *HistoryEntry) Id() int64            { return e.id }
+func (e *HistoryEntry) UserId() int64        { return e.userId }
+func (e *HistoryEntry) PasswordHash() string { return e.passwordHash }
+func (e *HistoryEntry) CreatedAt() time.Time { return e.createdAt }
+
+func (e *HistoryEntry) SetId(id int64) {
+	if e.Id() == 0 {
+		e.id = id
+	}
+}
@@ -0,0 +1,17 @@
+package password
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+// GenerateRandom returns a password nobody knows. It is set for accounts
+// created through an identity provider, the user can replace it with the
+// password reset flow. The suffix keeps it valid for ValidatePassword.
+func GenerateRandom() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b) + "-aA1", nil
+}
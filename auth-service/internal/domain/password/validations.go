@@ -37,5 +37,9 @@ func ValidatePassword(password string) error {
 		return NewPasswordValidationError("password must contain at least one special character")
 	}
 
+	if IsCommonPassword(password) {
+		return NewPasswordValidationError("password is too common, choose a less predictable one")
+	}
+
 	return nil
 }
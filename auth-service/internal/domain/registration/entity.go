@@ -0,0 +1,110 @@
+package registration
+
+import "time"
+
+type State string
+
+const (
+	// StatePending is set before the user is created in the user service.
+	StatePending State = "pending"
+	// StateUserCreated is set once the user service returned the user.
+	StateUserCreated State = "user_created"
+	// StateCompleted is set in the transaction storing the credentials.
+	StateCompleted State = "completed"
+	// StateCompensated is set once the user created by the saga is deleted.
+	StateCompensated State = "compensated"
+	// StateAborted is set when the saga left nothing to compensate.
+	StateAborted State = "aborted"
+)
+
+// Saga tracks a registration spanning the user service and the local
+// credentials, so a user left without credentials can be found and deleted.
+type Saga struct {
+	id        int64
+	userId    int64
+	email     string
+	state     State
+	attempts  int
+	lastError string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func New(email string) *Saga {
+	now := time.Now()
+	return &Saga{
+		email:     email,
+		state:     StatePending,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+func FromStorage(
+	id, userId int64,
+	email string, state State,
+	attempts int, lastError string,
+	createdAt, updatedAt time.Time,
+) *Saga {
+	return &Saga{
+		id:        id,
+		userId:    userId,
+		email:     email,
+		state:     state,
+		attempts:  attempts,
+		lastError: lastError,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (s *Saga) Id() int64            { return s.id }
+func (s *Saga) UserId() int64        { return s.userId }
+func (s *Saga) Email() string        { return s.email }
+func (s *Saga) State() State         { return s.state }
+func (s *Saga) Attempts() int        { return s.attempts }
+func (s *Saga) LastError() string    { return s.lastError }
+func (s *Saga) CreatedAt() time.Time { return s.createdAt }
+func (s *Saga) UpdatedAt() time.Time { return s.updatedAt }
+
+func (s *Saga) SetId(id int64) {
+	if s.Id() == 0 {
+		s.id = id
+	}
+}
+
+// IsOpen reports whether the saga may still have left a user behind.
+func (s *Saga) IsOpen() bool {
+	return s.state == StatePending || s.state == StateUserCreated
+}
+
+func (s *Saga) SetUserCreated(userId int64) {
+	s.userId = userId
+	s.setState(StateUserCreated)
+}
+
+func (s *Saga) SetCompleted() {
+	s.setState(StateCompleted)
+}
+
+func (s *Saga) SetCompensated() {
+	s.setState(StateCompensated)
+}
+
+func (s *Saga) SetAborted(reason string) {
+	s.lastError = reason
+	s.setState(StateAborted)
+}
+
+// RecordFailure counts a failed compensation attempt, the saga stays open
+// so the attempt is repeated later.
+func (s *Saga) RecordFailure(err error) {
+	s.attempts++
+	s.lastError = err.Error()
+	s.updatedAt = time.Now()
+}
+
+func (s *Saga) setState(state State) {
+	s.state = state
+	s.updatedAt = time.Now()
+}
@@ -0,0 +1,61 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+var ErrMalformedCiphertext = errors.New("malformed ciphertext")
+
+// Cipher encrypts (AES-256-GCM) the secrets that have to be read back, such
+// as TOTP seeds, and therefore cannot be stored as a hash.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher derives the AES-256 key from key with SHA-256, so any non empty string can be configured.
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) == 0 {
+		return nil, errors.New("encryption key is empty")
+	}
+	sum := sha256.Sum256(key)
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt returns base64(nonce || ciphertext).
+func (c *Cipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *Cipher) Decrypt(ciphertext string) (string, error) {
+	sealed, err := base64.RawStdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", ErrMalformedCiphertext
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", ErrMalformedCiphertext
+	}
+	nonce, data := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
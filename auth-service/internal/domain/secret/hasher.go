@@ -0,0 +1,34 @@
+package secret
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+// Hasher computes keyed hashes (HMAC-SHA256) of the secrets handed out to
+// users, so verification codes and refresh tokens are never stored as is.
+// Unlike passwords these secrets have enough entropy or a short lifetime,
+// which makes a fast deterministic hash usable for lookups.
+type Hasher struct {
+	key []byte
+}
+
+func NewHasher(key []byte) (*Hasher, error) {
+	if len(key) == 0 {
+		return nil, errors.New("hashing key is empty")
+	}
+	return &Hasher{key: key}, nil
+}
+
+func (h *Hasher) Hash(value string) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Equal reports in constant time whether hash is the hash of value.
+func (h *Hasher) Equal(hash string, value string) bool {
+	return hmac.Equal([]byte(hash), []byte(h.Hash(value)))
+}
@@ -1,6 +1,10 @@
 package token
 
-import "time"
+import (
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+)
 
 const (
 	AccessTokenType  = "access"
@@ -10,62 +14,97 @@ const (
 type Token struct {
 	id        int64
 	userId    int64
-	token     string
+	token     string // raw token, only known right after it was issued
+	tokenHash string
 	tokenType string
 	expiresAt time.Time
 	createdAt time.Time
 	updatedAt time.Time
+
+	device     string
+	userAgent  string
+	ip         string
+	lastUsedAt time.Time
 }
 
 func New(
 	userId int64,
 	token string, tokenType string,
 	expiresAt time.Time,
+	hasher *secret.Hasher,
 ) *Token {
 	now := time.Now()
 
 	return &Token{
-		userId:    userId,
-		token:     token,
-		tokenType: tokenType,
-		expiresAt: expiresAt,
-		createdAt: now,
-		updatedAt: now,
+		userId:     userId,
+		token:      token,
+		tokenHash:  hasher.Hash(token),
+		tokenType:  tokenType,
+		expiresAt:  expiresAt,
+		createdAt:  now,
+		updatedAt:  now,
+		lastUsedAt: now,
 	}
 }
 
 func FromStorage(
 	id int64, userId int64,
-	token string, tokenType string,
+	tokenHash string, tokenType string,
 	expiresAt time.Time, createdAt time.Time, updatedAt time.Time,
+	device string, userAgent string, ip string, lastUsedAt time.Time,
 ) *Token {
 	return &Token{
-		id:        id,
-		userId:    userId,
-		token:     token,
-		tokenType: tokenType,
-		expiresAt: expiresAt,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		id:         id,
+		userId:     userId,
+		tokenHash:  tokenHash,
+		tokenType:  tokenType,
+		expiresAt:  expiresAt,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+		device:     device,
+		userAgent:  userAgent,
+		ip:         ip,
+		lastUsedAt: lastUsedAt,
 	}
 }
 
 func (t *Token) Id() int64            { return t.id }
 func (t *Token) UserId() int64        { return t.userId }
 func (t *Token) Token() string        { return t.token }
+func (t *Token) TokenHash() string    { return t.tokenHash }
 func (t *Token) TokenType() string    { return t.tokenType }
 func (t *Token) ExpiresAt() time.Time { return t.expiresAt }
 func (t *Token) CreatedAt() time.Time { return t.createdAt }
 func (t *Token) UpdatedAt() time.Time { return t.updatedAt }
 
+func (t *Token) Device() string        { return t.device }
+func (t *Token) UserAgent() string     { return t.userAgent }
+func (t *Token) IP() string            { return t.ip }
+func (t *Token) LastUsedAt() time.Time { return t.lastUsedAt }
+
 func (t *Token) SetId(id int64) {
 	if t.Id() == 0 {
 		t.id = id
 	}
 }
 
-func (t *Token) SetToken(token string, expiresAt time.Time) {
+func (t *Token) SetToken(token string, expiresAt time.Time, hasher *secret.Hasher) {
 	t.token = token
+	t.tokenHash = hasher.Hash(token)
 	t.expiresAt = expiresAt
 	t.updatedAt = time.Now()
 }
+
+// CheckToken reports in constant time whether rawToken is the token.
+func (t *Token) CheckToken(rawToken string, hasher *secret.Hasher) bool {
+	return rawToken != "" && hasher.Equal(t.tokenHash, rawToken)
+}
+
+func (t *Token) SetClientInfo(device string, userAgent string, ip string) {
+	now := time.Now()
+	t.device = device
+	t.userAgent = userAgent
+	t.ip = ip
+	t.lastUsedAt = now
+	t.updatedAt = now
+}
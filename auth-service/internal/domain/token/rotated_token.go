@@ -0,0 +1,52 @@
+package token
+
+import "time"
+
+// RotatedToken is a refresh token that has already been exchanged for a new one.
+// FamilyId points to the refresh token row (session) the token belonged to.
+type RotatedToken struct {
+	id        int64
+	familyId  int64
+	userId    int64
+	tokenHash string
+	expiresAt time.Time
+	rotatedAt time.Time
+}
+
+func NewRotatedToken(t *Token) *RotatedToken {
+	return &RotatedToken{
+		familyId:  t.Id(),
+		userId:    t.UserId(),
+		tokenHash: t.TokenHash(),
+		expiresAt: t.ExpiresAt(),
+		rotatedAt: time.Now(),
+	}
+}
+
+func RotatedTokenFromStorage(
+	id int64, familyId int64, userId int64,
+	tokenHash string,
+	expiresAt time.Time, rotatedAt time.Time,
+) *RotatedToken {
+	return &RotatedToken{
+		id:        id,
+		familyId:  familyId,
+		userId:    userId,
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+		rotatedAt: rotatedAt,
+	}
+}
+
+func (t *RotatedToken) Id() int64            { return t.id }
+func (t *RotatedToken) FamilyId() int64      { return t.familyId }
+func (t *RotatedToken) UserId() int64        { return t.userId }
+func (t *RotatedToken) TokenHash() string    { return t.tokenHash }
+func (t *RotatedToken) ExpiresAt() time.Time { return t.expiresAt }
+func (t *RotatedToken) RotatedAt() time.Time { return t.rotatedAt }
+
+func (t *RotatedToken) SetId(id int64) {
+	if t.Id() == 0 {
+		t.id = id
+	}
+}
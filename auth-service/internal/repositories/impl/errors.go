@@ -0,0 +1,7 @@
+package impl
+
+import "errors"
+
+// ErrConflict is returned when a conditional write affects no rows because
+// the stored row was changed concurrently.
+var ErrConflict = errors.New("conflict")
@@ -0,0 +1,105 @@
+package postgresimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	postgresunitofwork "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+const (
+	ApplicantAccountDeletions impl.RepositoryType = "applicant_account_deletions"
+	EmployerAccountDeletions  impl.RepositoryType = "employer_account_deletions"
+)
+
+type AccountDeletionRepository struct {
+	uow       *postgresunitofwork.UnitOfWork
+	tableName string
+}
+
+func NewAccountDeletionRepository(uow *postgresunitofwork.UnitOfWork, repoType impl.RepositoryType) interfaces.AccountDeletionRepository {
+	return &AccountDeletionRepository{uow: uow, tableName: string(repoType)}
+}
+
+// MarkDeleted restarts the grace period when the user is marked again.
+func (r *AccountDeletionRepository) MarkDeleted(ctx context.Context, userId int64, deletedAt time.Time) error {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		INSERT INTO ` + r.tableName + ` (user_id, deleted_at)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at
+	`)
+
+	if _, err := conn.Exec(ctx, sb.String(), userId, deletedAt); err != nil {
+		return fmt.Errorf("mark account deleted: %w", err)
+	}
+	return nil
+}
+
+// UnmarkDeleted reports whether the user was marked, it waits for a purge
+// holding the mark to finish.
+func (r *AccountDeletionRepository) UnmarkDeleted(ctx context.Context, userId int64) (bool, error) {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		DELETE FROM ` + r.tableName + `
+		WHERE user_id = $1
+	`)
+
+	tag, err := conn.Exec(ctx, sb.String(), userId)
+	if err != nil {
+		return false, fmt.Errorf("unmark account deleted: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListDeletedBefore locks the returned rows until the transaction ends, so
+// concurrent purges skip them.
+func (r *AccountDeletionRepository) ListDeletedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		SELECT user_id
+		FROM ` + r.tableName + `
+		WHERE deleted_at < $1
+		ORDER BY deleted_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), deletedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query account deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan account deletion: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
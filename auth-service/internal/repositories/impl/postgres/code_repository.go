@@ -17,6 +17,10 @@ const (
 	EmployerActivationCodes     impl.RepositoryType = "employer_activation_codes"
 	ApplicantResetPasswordCodes impl.RepositoryType = "applicant_reset_password_codes"
 	EmployerResetPasswordCodes  impl.RepositoryType = "employer_reset_password_codes"
+	ApplicantEmailChangeCodes   impl.RepositoryType = "applicant_email_change_codes"
+	EmployerEmailChangeCodes    impl.RepositoryType = "employer_email_change_codes"
+	ApplicantLoginCodes         impl.RepositoryType = "applicant_login_codes"
+	EmployerLoginCodes          impl.RepositoryType = "employer_login_codes"
 )
 
 type CodeRepository struct {
@@ -41,28 +45,34 @@ func (r *CodeRepository) CreateCode(ctx context.Context, code *code.Code) error
 	sb.WriteString(`
 		INSERT INTO ` + r.tableName + ` (
 			user_id,
-			code,
+			code_hash,
 			generations_left,
 			expires_at,
 			created_at,
-			updated_at
+			updated_at,
+			attempts_left,
+			new_email
 		)
 		SELECT
 			(i).user_id,
-			(i).code,
+			(i).code_hash,
 			(i).generations_left,
 			(i).expires_at,
 			(i).created_at,
-			(i).updated_at
+			(i).updated_at,
+			(i).attempts_left,
+			(i).new_email
 		FROM UNNEST($1::v1_code[]) i
 		RETURNING
 			id,
 			user_id,
-			code,
+			code_hash,
 			generations_left,
 			expires_at,
 			created_at,
-			updated_at
+			updated_at,
+			attempts_left,
+			new_email
 	`)
 
 	rows, err := conn.Query(ctx, sb.String(), []models.V1CodeDal{dal})
@@ -76,15 +86,18 @@ func (r *CodeRepository) CreateCode(ctx context.Context, code *code.Code) error
 		if err := rows.Scan(
 			&res.Id,
 			&res.UserId,
-			&res.Code,
+			&res.CodeHash,
 			&res.GenerationsLeft,
 			&res.ExpiresAt,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.AttemptsLeft,
+			&res.NewEmail,
 		); err != nil {
 			return fmt.Errorf("scan code: %w", err)
 		}
-		*code = *res.ToDomain()
+		// the row only holds the hash, keep the raw code generated in memory
+		code.SetId(res.Id)
 		return nil
 	}
 
@@ -105,21 +118,25 @@ func (r *CodeRepository) UpdateCode(ctx context.Context, code *code.Code) error
 		UPDATE ` + r.tableName + ` AS t
 		SET
 			user_id = (i).user_id,
-			code = (i).code,
+			code_hash = (i).code_hash,
 			generations_left = (i).generations_left,
 			expires_at = (i).expires_at,
 			created_at = (i).created_at,
-			updated_at = (i).updated_at
+			updated_at = (i).updated_at,
+			attempts_left = (i).attempts_left,
+			new_email = (i).new_email
 		FROM UNNEST($1::v1_code[]) i
 		WHERE t.id = (i).id
 		RETURNING
 			t.id,
 			t.user_id,
-			t.code,
+			t.code_hash,
 			t.generations_left,
 			t.expires_at,
 			t.created_at,
-			t.updated_at
+			t.updated_at,
+			t.attempts_left,
+			t.new_email
 	`)
 
 	rows, err := conn.Query(ctx, sb.String(), []models.V1CodeDal{dal})
@@ -133,15 +150,17 @@ func (r *CodeRepository) UpdateCode(ctx context.Context, code *code.Code) error
 		if err := rows.Scan(
 			&res.Id,
 			&res.UserId,
-			&res.Code,
+			&res.CodeHash,
 			&res.GenerationsLeft,
 			&res.ExpiresAt,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.AttemptsLeft,
+			&res.NewEmail,
 		); err != nil {
 			return fmt.Errorf("scan code: %w", err)
 		}
-		*code = *res.ToDomain()
+		// the row only holds the hash, keep the raw code generated in memory
 		return nil
 	}
 
@@ -168,11 +187,13 @@ func (r *CodeRepository) DeleteCode(ctx context.Context, code *code.Code) error
 		RETURNING
 			t.id,
 			t.user_id,
-			t.code,
+			t.code_hash,
 			t.generations_left,
 			t.expires_at,
 			t.created_at,
-			t.updated_at
+			t.updated_at,
+			t.attempts_left,
+			t.new_email
 	`)
 
 	rows, err := conn.Query(ctx, sb.String(), []models.V1CodeDal{dal})
@@ -186,11 +207,13 @@ func (r *CodeRepository) DeleteCode(ctx context.Context, code *code.Code) error
 		if err := rows.Scan(
 			&res.Id,
 			&res.UserId,
-			&res.Code,
+			&res.CodeHash,
 			&res.GenerationsLeft,
 			&res.ExpiresAt,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.AttemptsLeft,
+			&res.NewEmail,
 		); err != nil {
 			return fmt.Errorf("scan code: %w", err)
 		}
@@ -219,9 +242,11 @@ func (r *CodeRepository) QueryCode(ctx context.Context, query *models.QueryCodeD
 
 	sb.WriteString(`
 		SELECT
-			id, user_id, code,
+			id, user_id, code_hash,
 			generations_left, expires_at,
-			created_at, updated_at
+			created_at, updated_at,
+			attempts_left,
+			new_email
 		FROM ` + r.tableName + `
 		WHERE 1=1
 	`)
@@ -241,11 +266,13 @@ func (r *CodeRepository) QueryCode(ctx context.Context, query *models.QueryCodeD
 		if err := rows.Scan(
 			&res.Id,
 			&res.UserId,
-			&res.Code,
+			&res.CodeHash,
 			&res.GenerationsLeft,
 			&res.ExpiresAt,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.AttemptsLeft,
+			&res.NewEmail,
 		); err != nil {
 			return nil, fmt.Errorf("scan code: %w", err)
 		}
@@ -0,0 +1,167 @@
+package postgresimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	postgresunitofwork "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+const (
+	ApplicantIdentities impl.RepositoryType = "applicant_identities"
+)
+
+type IdentityRepository struct {
+	uow       *postgresunitofwork.UnitOfWork
+	tableName string
+}
+
+func NewIdentityRepository(uow *postgresunitofwork.UnitOfWork, repoType impl.RepositoryType) interfaces.IdentityRepository {
+	return &IdentityRepository{uow: uow, tableName: string(repoType)}
+}
+
+func (r *IdentityRepository) CreateIdentity(ctx context.Context, identity *oidc.Identity) error {
+	dal := models.V1IdentityDalFromDomain(identity)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		INSERT INTO ` + r.tableName + ` (
+			user_id,
+			provider,
+			subject,
+			email,
+			created_at,
+			updated_at
+		)
+		SELECT
+			(i).user_id,
+			(i).provider,
+			(i).subject,
+			(i).email,
+			(i).created_at,
+			(i).updated_at
+		FROM UNNEST($1::v1_identity[]) i
+		RETURNING
+			id,
+			user_id,
+			provider,
+			subject,
+			email,
+			created_at,
+			updated_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1IdentityDal{dal})
+	if err != nil {
+		return fmt.Errorf("insert identity: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1IdentityDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.Provider,
+			&res.Subject,
+			&res.Email,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("scan identity: %w", err)
+		}
+		identity.SetId(res.Id)
+		return nil
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("insert identity: %w", err)
+	}
+
+	return fmt.Errorf("no identity returned from insert")
+}
+
+func (r *IdentityRepository) QueryIdentity(ctx context.Context, query *models.QueryIdentityDal) (*oidc.Identity, error) {
+	if query == nil {
+		query = &models.QueryIdentityDal{}
+	}
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sb     strings.Builder
+		args   []any
+		argPos = 1
+	)
+
+	sb.WriteString(`
+		SELECT
+			id, user_id,
+			provider, subject, email,
+			created_at, updated_at
+		FROM ` + r.tableName + `
+		WHERE 1=1
+	`)
+
+	appendEqual(&sb, "id", query.Id, &args, &argPos)
+	appendEqual(&sb, "user_id", query.UserId, &args, &argPos)
+	appendEqual(&sb, "provider", query.Provider, &args, &argPos)
+	appendEqual(&sb, "subject", query.Subject, &args, &argPos)
+	appendLimitOffset(&sb, 1, 0, &args, &argPos)
+
+	rows, err := conn.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query identity: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1IdentityDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.Provider,
+			&res.Subject,
+			&res.Email,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan identity: %w", err)
+		}
+		return res.ToDomain(), nil
+	}
+
+	return nil, nil
+}
+
+func (r *IdentityRepository) DeleteIdentitiesByUserId(ctx context.Context, userId int64) error {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		DELETE FROM ` + r.tableName + `
+		WHERE user_id = $1
+	`)
+
+	if _, err := conn.Exec(ctx, sb.String(), userId); err != nil {
+		return fmt.Errorf("delete identities: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package postgresimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/mfa"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	postgresunitofwork "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+const (
+	ApplicantMfaRepository impl.RepositoryType = "applicant_mfa"
+	EmployerMfaRepository  impl.RepositoryType = "employer_mfa"
+)
+
+type MfaRepository struct {
+	uow       *postgresunitofwork.UnitOfWork
+	tableName string
+}
+
+func NewMfaRepository(uow *postgresunitofwork.UnitOfWork, repoType impl.RepositoryType) interfaces.MfaRepository {
+	return &MfaRepository{uow: uow, tableName: string(repoType)}
+}
+
+func (r *MfaRepository) CreateMfa(ctx context.Context, mfa *mfa.Mfa) error {
+	dal := models.V1MfaDalFromDomain(mfa)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		INSERT INTO ` + r.tableName + ` (
+			user_id,
+			encrypted_secret,
+			is_confirmed,
+			recovery_code_hashes,
+			last_used_step,
+			created_at,
+			updated_at
+		) 
+		SELECT 
+			(i).user_id,
+			(i).encrypted_secret,
+			(i).is_confirmed,
+			(i).recovery_code_hashes,
+			(i).last_used_step,
+			(i).created_at,
+			(i).updated_at
+		FROM UNNEST($1::v1_mfa[]) i
+		RETURNING
+			id,
+			user_id,
+			encrypted_secret,
+			is_confirmed,
+			recovery_code_hashes,
+			last_used_step,
+			created_at,
+			updated_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1MfaDal{dal})
+	if err != nil {
+		return fmt.Errorf("insert mfa: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1MfaDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.EncryptedSecret,
+			&res.IsConfirmed,
+			&res.RecoveryCodeHashes,
+			&res.LastUsedStep,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("scan mfa: %w", err)
+		}
+		// the row only holds the encrypted secret, keep the raw secret in memory
+		mfa.SetId(res.Id)
+		return nil
+	}
+
+	return fmt.Errorf("no mfa returned from insert")
+}
+
+func (r *MfaRepository) UpdateMfa(ctx context.Context, mfa *mfa.Mfa) error {
+	dal := models.V1MfaDalFromDomain(mfa)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		UPDATE ` + r.tableName + ` AS t
+		SET
+			user_id = (i).user_id,
+			encrypted_secret = (i).encrypted_secret,
+			is_confirmed = (i).is_confirmed,
+			recovery_code_hashes = (i).recovery_code_hashes,
+			last_used_step = (i).last_used_step,
+			created_at = (i).created_at,
+			updated_at = (i).updated_at
+		FROM UNNEST($1::v1_mfa[]) i
+		WHERE t.id = (i).id
+		RETURNING
+			t.id,
+			t.user_id,
+			t.encrypted_secret,
+			t.is_confirmed,
+			t.recovery_code_hashes,
+			t.last_used_step,
+			t.created_at,
+			t.updated_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1MfaDal{dal})
+	if err != nil {
+		return fmt.Errorf("update mfa: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1MfaDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.EncryptedSecret,
+			&res.IsConfirmed,
+			&res.RecoveryCodeHashes,
+			&res.LastUsedStep,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("scan mfa: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no mfa updated")
+}
+
+func (r *MfaRepository) DeleteMfa(ctx context.Context, mfa *mfa.Mfa) error {
+	dal := models.V1MfaDalFromDomain(mfa)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		DELETE FROM ` + r.tableName + ` AS t
+		USING (
+			SELECT (i).id AS id
+			FROM UNNEST($1::v1_mfa[]) AS i
+		) AS d
+		WHERE t.id = d.id
+		RETURNING
+			t.id,
+			t.user_id,
+			t.encrypted_secret,
+			t.is_confirmed,
+			t.recovery_code_hashes,
+			t.last_used_step,
+			t.created_at,
+			t.updated_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1MfaDal{dal})
+	if err != nil {
+		return fmt.Errorf("delete mfa: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1MfaDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.EncryptedSecret,
+			&res.IsConfirmed,
+			&res.RecoveryCodeHashes,
+			&res.LastUsedStep,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("scan mfa: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no mfa deleted")
+}
+
+func (r *MfaRepository) QueryMfa(ctx context.Context, query *models.QueryMfaDal) (*mfa.Mfa, error) {
+	if query == nil {
+		query = &models.QueryMfaDal{}
+	}
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sb     strings.Builder
+		args   []any
+		argPos = 1
+	)
+
+	sb.WriteString(`
+		SELECT
+			id, user_id, encrypted_secret,
+			is_confirmed, recovery_code_hashes, last_used_step,
+			created_at, updated_at
+		FROM ` + r.tableName + `
+		WHERE 1=1
+	`)
+
+	appendEqual(&sb, "id", query.Id, &args, &argPos)
+	appendEqual(&sb, "user_id", query.UserId, &args, &argPos)
+	appendLimitOffset(&sb, 1, 0, &args, &argPos)
+
+	rows, err := conn.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query mfa: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1MfaDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.EncryptedSecret,
+			&res.IsConfirmed,
+			&res.RecoveryCodeHashes,
+			&res.LastUsedStep,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan mfa: %w", err)
+		}
+		return res.ToDomain(), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,159 @@
+package postgresimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/password"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	postgresunitofwork "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+const (
+	ApplicantPasswordHistoryRepository impl.RepositoryType = "applicant_password_history"
+	EmployerPasswordHistoryRepository  impl.RepositoryType = "employer_password_history"
+)
+
+type PasswordHistoryRepository struct {
+	uow       *postgresunitofwork.UnitOfWork
+	tableName string
+}
+
+func NewPasswordHistoryRepository(uow *postgresunitofwork.UnitOfWork, repoType impl.RepositoryType) interfaces.PasswordHistoryRepository {
+	return &PasswordHistoryRepository{uow: uow, tableName: string(repoType)}
+}
+
+func (r *PasswordHistoryRepository) CreateEntry(ctx context.Context, entry *password.HistoryEntry) error {
+	dal := models.V1PasswordHistoryDalFromDomain(entry)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		INSERT INTO ` + r.tableName + ` (
+			user_id,
+			password_hash,
+			created_at
+		)
+		SELECT
+			(i).user_id,
+			(i).password_hash,
+			(i).created_at
+		FROM UNNEST($1::v1_password_history[]) i
+		RETURNING
+			id,
+			user_id,
+			password_hash,
+			created_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1PasswordHistoryDal{dal})
+	if err != nil {
+		return fmt.Errorf("insert password history entry: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1PasswordHistoryDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.PasswordHash,
+			&res.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("scan password history entry: %w", err)
+		}
+		*entry = *res.ToDomain()
+		return nil
+	}
+
+	return fmt.Errorf("no password history entry returned from insert")
+}
+
+// QueryEntries returns the newest entries first.
+func (r *PasswordHistoryRepository) QueryEntries(ctx context.Context, query *models.QueryPasswordHistoryDal) ([]*password.HistoryEntry, error) {
+	if query == nil {
+		query = &models.QueryPasswordHistoryDal{}
+	}
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sb     strings.Builder
+		args   []any
+		argPos = 1
+	)
+
+	sb.WriteString(`
+		SELECT
+			id, user_id, password_hash, created_at
+		FROM ` + r.tableName + `
+		WHERE 1=1
+	`)
+
+	appendEqual(&sb, "user_id", query.UserId, &args, &argPos)
+	appendOrder(&sb, "created_at", false)
+	if query.Limit > 0 {
+		appendLimitOffset(&sb, query.Limit, 0, &args, &argPos)
+	}
+
+	rows, err := conn.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query password history: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*password.HistoryEntry
+	for rows.Next() {
+		var res models.V1PasswordHistoryDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.PasswordHash,
+			&res.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan password history entry: %w", err)
+		}
+		entries = append(entries, res.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query password history: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *PasswordHistoryRepository) DeleteEntriesExceptLatest(ctx context.Context, userId int64, keep int) error {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		DELETE FROM ` + r.tableName + ` AS t
+		WHERE t.user_id = $1 AND t.id NOT IN (
+			SELECT id
+			FROM ` + r.tableName + `
+			WHERE user_id = $1
+			ORDER BY created_at DESC
+			LIMIT $2
+		)
+	`)
+
+	if _, err := conn.Exec(ctx, sb.String(), userId, keep); err != nil {
+		return fmt.Errorf("delete password history entries: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package postgresimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/registration"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	postgresunitofwork "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+const (
+	ApplicantRegistrationSagas impl.RepositoryType = "applicant_registration_sagas"
+	EmployerRegistrationSagas  impl.RepositoryType = "employer_registration_sagas"
+)
+
+type RegistrationSagaRepository struct {
+	uow       *postgresunitofwork.UnitOfWork
+	tableName string
+}
+
+func NewRegistrationSagaRepository(uow *postgresunitofwork.UnitOfWork, repoType impl.RepositoryType) interfaces.RegistrationSagaRepository {
+	return &RegistrationSagaRepository{uow: uow, tableName: string(repoType)}
+}
+
+func (r *RegistrationSagaRepository) CreateSaga(ctx context.Context, saga *registration.Saga) error {
+	dal := models.V1RegistrationSagaDalFromDomain(saga)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		INSERT INTO ` + r.tableName + ` (
+			user_id,
+			email,
+			state,
+			attempts,
+			last_error,
+			created_at,
+			updated_at
+		)
+		SELECT
+			(i).user_id,
+			(i).email,
+			(i).state,
+			(i).attempts,
+			(i).last_error,
+			(i).created_at,
+			(i).updated_at
+		FROM UNNEST($1::v1_registration_saga[]) i
+		RETURNING id
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1RegistrationSagaDal{dal})
+	if err != nil {
+		return fmt.Errorf("insert registration saga: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("scan registration saga: %w", err)
+		}
+		saga.SetId(id)
+		return nil
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("insert registration saga: %w", err)
+	}
+
+	return fmt.Errorf("no registration saga returned from insert")
+}
+
+func (r *RegistrationSagaRepository) UpdateSaga(ctx context.Context, saga *registration.Saga) error {
+	dal := models.V1RegistrationSagaDalFromDomain(saga)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		UPDATE ` + r.tableName + ` AS t
+		SET
+			user_id = (i).user_id,
+			email = (i).email,
+			state = (i).state,
+			attempts = (i).attempts,
+			last_error = (i).last_error,
+			updated_at = (i).updated_at
+		FROM UNNEST($1::v1_registration_saga[]) i
+		WHERE t.id = (i).id
+		RETURNING t.id
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1RegistrationSagaDal{dal})
+	if err != nil {
+		return fmt.Errorf("update registration saga: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		return nil
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("update registration saga: %w", err)
+	}
+
+	return fmt.Errorf("no registration saga updated")
+}
+
+func (r *RegistrationSagaRepository) QuerySagas(ctx context.Context, query *models.QueryRegistrationSagaDal) ([]*registration.Saga, error) {
+	if query == nil {
+		query = &models.QueryRegistrationSagaDal{}
+	}
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sb     strings.Builder
+		args   []any
+		argPos = 1
+	)
+
+	sb.WriteString(`
+		SELECT
+			id, user_id, email, state,
+			attempts, last_error,
+			created_at, updated_at
+		FROM ` + r.tableName + `
+		WHERE 1=1
+	`)
+
+	appendEqual(&sb, "id", query.Id, &args, &argPos)
+	appendEqual(&sb, "email", query.Email, &args, &argPos)
+	appendAnyEqual(&sb, "state", query.States, &args, &argPos)
+	appendRange(&sb, "updated_at", nil, query.UpdatedBefore, &args, &argPos)
+	appendOrder(&sb, "updated_at", true)
+	if query.Limit > 0 {
+		appendLimitOffset(&sb, query.Limit, 0, &args, &argPos)
+	}
+
+	rows, err := conn.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query registration sagas: %w", err)
+	}
+	defer rows.Close()
+
+	var sagas []*registration.Saga
+	for rows.Next() {
+		var res models.V1RegistrationSagaDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.Email,
+			&res.State,
+			&res.Attempts,
+			&res.LastError,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan registration saga: %w", err)
+		}
+		sagas = append(sagas, res.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query registration sagas: %w", err)
+	}
+
+	return sagas, nil
+}
@@ -0,0 +1,138 @@
+package postgresimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/token"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	postgresunitofwork "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+const (
+	ApplicantRotatedRefreshTokenRepository impl.RepositoryType = "applicant_rotated_refresh_tokens"
+	EmployerRotatedRefreshTokenRepository  impl.RepositoryType = "employer_rotated_refresh_tokens"
+)
+
+type RotatedTokenRepository struct {
+	uow       *postgresunitofwork.UnitOfWork
+	tableName string
+}
+
+func NewRotatedTokenRepository(uow *postgresunitofwork.UnitOfWork, repoType impl.RepositoryType) interfaces.RotatedTokenRepository {
+	return &RotatedTokenRepository{uow: uow, tableName: string(repoType)}
+}
+
+func (r *RotatedTokenRepository) CreateRotatedToken(ctx context.Context, token *token.RotatedToken) error {
+	dal := models.V1RotatedRefreshTokenDalFromDomain(token)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		INSERT INTO ` + r.tableName + ` (
+			family_id,
+			user_id,
+			token_hash,
+			expires_at,
+			rotated_at
+		)
+		SELECT
+			(i).family_id,
+			(i).user_id,
+			(i).token_hash,
+			(i).expires_at,
+			(i).rotated_at
+		FROM UNNEST($1::v1_rotated_refresh_token[]) i
+		RETURNING
+			id,
+			family_id,
+			user_id,
+			token_hash,
+			expires_at,
+			rotated_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1RotatedRefreshTokenDal{dal})
+	if err != nil {
+		return fmt.Errorf("insert rotated token: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1RotatedRefreshTokenDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.FamilyId,
+			&res.UserId,
+			&res.TokenHash,
+			&res.ExpiresAt,
+			&res.RotatedAt,
+		); err != nil {
+			return fmt.Errorf("scan rotated token: %w", err)
+		}
+		*token = *res.ToDomain()
+		return nil
+	}
+
+	return fmt.Errorf("no rotated token returned from insert")
+}
+
+func (r *RotatedTokenRepository) QueryRotatedToken(ctx context.Context, query *models.QueryRotatedTokenDal) (*token.RotatedToken, error) {
+	if query == nil {
+		query = &models.QueryRotatedTokenDal{}
+	}
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sb     strings.Builder
+		args   []any
+		argPos = 1
+	)
+
+	sb.WriteString(`
+		SELECT
+			id, family_id, user_id, token_hash,
+			expires_at, rotated_at
+		FROM ` + r.tableName + `
+		WHERE 1=1
+	`)
+
+	appendEqual(&sb, "family_id", query.FamilyId, &args, &argPos)
+	appendEqual(&sb, "token_hash", query.TokenHash, &args, &argPos)
+	appendLimitOffset(&sb, 1, 0, &args, &argPos)
+
+	rows, err := conn.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query rotated token: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1RotatedRefreshTokenDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.FamilyId,
+			&res.UserId,
+			&res.TokenHash,
+			&res.ExpiresAt,
+			&res.RotatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan rotated token: %w", err)
+		}
+		return res.ToDomain(), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,92 @@
+package postgresimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	postgresunitofwork "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+const (
+	ApplicantSecurityVersionRepository impl.RepositoryType = "applicant_security_versions"
+	EmployerSecurityVersionRepository  impl.RepositoryType = "employer_security_versions"
+)
+
+type SecurityVersionRepository struct {
+	uow       *postgresunitofwork.UnitOfWork
+	tableName string
+}
+
+func NewSecurityVersionRepository(uow *postgresunitofwork.UnitOfWork, repoType impl.RepositoryType) interfaces.SecurityVersionRepository {
+	return &SecurityVersionRepository{uow: uow, tableName: string(repoType)}
+}
+
+// GetVersion returns 0 for users whose version has never been incremented.
+func (r *SecurityVersionRepository) GetVersion(ctx context.Context, userId int64) (int, error) {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		SELECT version
+		FROM ` + r.tableName + `
+		WHERE user_id = $1
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), userId)
+	if err != nil {
+		return 0, fmt.Errorf("query security version: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return 0, fmt.Errorf("scan security version: %w", err)
+		}
+		return version, nil
+	}
+
+	return 0, nil
+}
+
+func (r *SecurityVersionRepository) IncrementVersion(ctx context.Context, userId int64) (int, error) {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		INSERT INTO ` + r.tableName + ` AS t (user_id, version, updated_at)
+		VALUES ($1, 1, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET
+			version = t.version + 1,
+			updated_at = NOW()
+		RETURNING t.version
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), userId)
+	if err != nil {
+		return 0, fmt.Errorf("increment security version: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return 0, fmt.Errorf("scan security version: %w", err)
+		}
+		return version, nil
+	}
+
+	return 0, fmt.Errorf("no security version returned from upsert")
+}
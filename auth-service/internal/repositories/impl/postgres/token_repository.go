@@ -39,25 +39,37 @@ func (r *TokenRepository) CreateToken(ctx context.Context, token *token.Token) e
 	sb.WriteString(`
 		INSERT INTO ` + r.tableName + ` (
 			user_id,
-			token,
+			token_hash,
 			expires_at,
 			created_at,
-			updated_at
+			updated_at,
+			device,
+			user_agent,
+			ip,
+			last_used_at
 		)
 		SELECT
 			(i).user_id,
-			(i).token,
+			(i).token_hash,
 			(i).expires_at,
 			(i).created_at,
-			(i).updated_at
+			(i).updated_at,
+			(i).device,
+			(i).user_agent,
+			(i).ip,
+			(i).last_used_at
 		FROM UNNEST($1::v1_refresh_token[]) i
 		RETURNING
 			id,
 			user_id,
-			token,
+			token_hash,
 			expires_at,
 			created_at,
-			updated_at
+			updated_at,
+			device,
+			user_agent,
+			ip,
+			last_used_at
 	`)
 
 	rows, err := conn.Query(ctx, sb.String(), []models.V1RefreshTokenDal{dal})
@@ -71,14 +83,19 @@ func (r *TokenRepository) CreateToken(ctx context.Context, token *token.Token) e
 		if err := rows.Scan(
 			&res.Id,
 			&res.UserId,
-			&res.Token,
+			&res.TokenHash,
 			&res.ExpiresAt,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.Device,
+			&res.UserAgent,
+			&res.IP,
+			&res.LastUsedAt,
 		); err != nil {
 			return fmt.Errorf("scan token: %w", err)
 		}
-		*token = *res.ToDomain()
+		// the row only holds the hash, keep the raw token issued in memory
+		token.SetId(res.Id)
 		return nil
 	}
 
@@ -99,19 +116,27 @@ func (r *TokenRepository) UpdateToken(ctx context.Context, token *token.Token) e
 		UPDATE ` + r.tableName + ` AS t
 		SET
 			user_id = (i).user_id,
-			token = (i).token,
+			token_hash = (i).token_hash,
 			expires_at = (i).expires_at,
 			created_at = (i).created_at,
-			updated_at = (i).updated_at
+			updated_at = (i).updated_at,
+			device = (i).device,
+			user_agent = (i).user_agent,
+			ip = (i).ip,
+			last_used_at = (i).last_used_at
 		FROM UNNEST($1::v1_refresh_token[]) i
 		WHERE t.id = (i).id
 		RETURNING
 			t.id,
 			t.user_id,
-			t.token,
+			t.token_hash,
 			t.expires_at,
 			t.created_at,
-			t.updated_at
+			t.updated_at,
+			t.device,
+			t.user_agent,
+			t.ip,
+			t.last_used_at
 	`)
 
 	rows, err := conn.Query(ctx, sb.String(), []models.V1RefreshTokenDal{dal})
@@ -125,21 +150,92 @@ func (r *TokenRepository) UpdateToken(ctx context.Context, token *token.Token) e
 		if err := rows.Scan(
 			&res.Id,
 			&res.UserId,
-			&res.Token,
+			&res.TokenHash,
 			&res.ExpiresAt,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.Device,
+			&res.UserAgent,
+			&res.IP,
+			&res.LastUsedAt,
 		); err != nil {
 			return fmt.Errorf("scan token: %w", err)
 		}
-		*token = *res.ToDomain()
+		// the row only holds the hash, keep the raw token issued in memory
 		return nil
 	}
 
 	return fmt.Errorf("no token updated")
 }
 
-func (r *TokenRepository) DeleteToken(ctx context.Context, tokenStr string) error {
+func (r *TokenRepository) RotateToken(ctx context.Context, token *token.Token, oldTokenHash string) error {
+	dal := models.V1RefreshTokenDalFromDomain(token)
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		UPDATE ` + r.tableName + ` AS t
+		SET
+			token_hash = (i).token_hash,
+			expires_at = (i).expires_at,
+			updated_at = (i).updated_at,
+			device = (i).device,
+			user_agent = (i).user_agent,
+			ip = (i).ip,
+			last_used_at = (i).last_used_at
+		FROM UNNEST($1::v1_refresh_token[]) i
+		WHERE t.id = (i).id AND t.token_hash = $2
+		RETURNING
+			t.id,
+			t.user_id,
+			t.token_hash,
+			t.expires_at,
+			t.created_at,
+			t.updated_at,
+			t.device,
+			t.user_agent,
+			t.ip,
+			t.last_used_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), []models.V1RefreshTokenDal{dal}, oldTokenHash)
+	if err != nil {
+		return fmt.Errorf("rotate token: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		var res models.V1RefreshTokenDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.TokenHash,
+			&res.ExpiresAt,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+			&res.Device,
+			&res.UserAgent,
+			&res.IP,
+			&res.LastUsedAt,
+		); err != nil {
+			return fmt.Errorf("scan token: %w", err)
+		}
+		// the row only holds the hash, keep the raw token issued in memory
+		return nil
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rotate token: %w", err)
+	}
+
+	return fmt.Errorf("rotate token: %w", impl.ErrConflict)
+}
+
+func (r *TokenRepository) DeleteToken(ctx context.Context, tokenHash string) error {
 	conn, err := r.uow.GetConn(ctx)
 	if err != nil {
 		return err
@@ -149,19 +245,19 @@ func (r *TokenRepository) DeleteToken(ctx context.Context, tokenStr string) erro
 
 	sb.WriteString(`
 		DELETE FROM ` + r.tableName + ` AS t
-		WHERE token = $1
+		WHERE token_hash = $1
 	`)
 
-	if _, err := conn.Exec(ctx, sb.String(), tokenStr); err != nil {
+	if _, err := conn.Exec(ctx, sb.String(), tokenHash); err != nil {
 		return fmt.Errorf("delete token: %w", err)
 	}
 	return nil
 }
 
-func (r *TokenRepository) DeleteTokensByUserId(ctx context.Context, userId int64) error {
+func (r *TokenRepository) DeleteTokensByUserId(ctx context.Context, userId int64) ([]*token.Token, error) {
 	conn, err := r.uow.GetConn(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var sb strings.Builder
@@ -169,12 +265,105 @@ func (r *TokenRepository) DeleteTokensByUserId(ctx context.Context, userId int64
 	sb.WriteString(`
 		DELETE FROM ` + r.tableName + ` AS t
 		WHERE t.user_id = $1
+		RETURNING
+			t.id,
+			t.user_id,
+			t.token_hash,
+			t.expires_at,
+			t.created_at,
+			t.updated_at,
+			t.device,
+			t.user_agent,
+			t.ip,
+			t.last_used_at
 	`)
 
-	if _, err := conn.Exec(ctx, sb.String(), userId); err != nil {
-		return fmt.Errorf("delete tokens by user id: %w", err)
+	rows, err := conn.Query(ctx, sb.String(), userId)
+	if err != nil {
+		return nil, fmt.Errorf("delete tokens by user id: %w", err)
 	}
-	return nil
+	defer rows.Close()
+
+	var tokens []*token.Token
+	for rows.Next() {
+		var res models.V1RefreshTokenDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.TokenHash,
+			&res.ExpiresAt,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+			&res.Device,
+			&res.UserAgent,
+			&res.IP,
+			&res.LastUsedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan token: %w", err)
+		}
+		tokens = append(tokens, res.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("delete tokens by user id: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *TokenRepository) DeleteTokensByUserIdExceptId(ctx context.Context, userId int64, exceptId int64) ([]*token.Token, error) {
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`
+		DELETE FROM ` + r.tableName + ` AS t
+		WHERE t.user_id = $1 AND t.id <> $2
+		RETURNING
+			t.id,
+			t.user_id,
+			t.token_hash,
+			t.expires_at,
+			t.created_at,
+			t.updated_at,
+			t.device,
+			t.user_agent,
+			t.ip,
+			t.last_used_at
+	`)
+
+	rows, err := conn.Query(ctx, sb.String(), userId, exceptId)
+	if err != nil {
+		return nil, fmt.Errorf("delete tokens by user id except id: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*token.Token
+	for rows.Next() {
+		var res models.V1RefreshTokenDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.TokenHash,
+			&res.ExpiresAt,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+			&res.Device,
+			&res.UserAgent,
+			&res.IP,
+			&res.LastUsedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan token: %w", err)
+		}
+		tokens = append(tokens, res.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("delete tokens by user id except id: %w", err)
+	}
+
+	return tokens, nil
 }
 
 func (r *TokenRepository) QueryToken(ctx context.Context, query *models.QueryTokenDal) (*token.Token, error) {
@@ -195,15 +384,16 @@ func (r *TokenRepository) QueryToken(ctx context.Context, query *models.QueryTok
 
 	sb.WriteString(`
 		SELECT
-			id, user_id, token,
-			expires_at, created_at, updated_at
+			id, user_id, token_hash,
+			expires_at, created_at, updated_at,
+			device, user_agent, ip, last_used_at
 		FROM ` + r.tableName + `
 		WHERE 1=1
 	`)
 
 	appendEqual(&sb, "id", query.Id, &args, &argPos)
 	appendEqual(&sb, "user_id", query.UserId, &args, &argPos)
-	appendEqual(&sb, "token", query.Token, &args, &argPos)
+	appendEqual(&sb, "token_hash", query.TokenHash, &args, &argPos)
 	appendLimitOffset(&sb, 1, 0, &args, &argPos)
 
 	rows, err := conn.Query(ctx, sb.String(), args...)
@@ -217,10 +407,14 @@ func (r *TokenRepository) QueryToken(ctx context.Context, query *models.QueryTok
 		if err := rows.Scan(
 			&res.Id,
 			&res.UserId,
-			&res.Token,
+			&res.TokenHash,
 			&res.ExpiresAt,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.Device,
+			&res.UserAgent,
+			&res.IP,
+			&res.LastUsedAt,
 		); err != nil {
 			return nil, fmt.Errorf("scan token: %w", err)
 		}
@@ -230,3 +424,65 @@ func (r *TokenRepository) QueryToken(ctx context.Context, query *models.QueryTok
 
 	return nil, nil
 }
+
+func (r *TokenRepository) QueryTokens(ctx context.Context, query *models.QueryTokenDal) ([]*token.Token, error) {
+	if query == nil {
+		query = &models.QueryTokenDal{}
+	}
+
+	conn, err := r.uow.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sb     strings.Builder
+		args   []any
+		argPos = 1
+	)
+
+	sb.WriteString(`
+		SELECT
+			id, user_id, token_hash,
+			expires_at, created_at, updated_at,
+			device, user_agent, ip, last_used_at
+		FROM ` + r.tableName + `
+		WHERE expires_at > NOW()
+	`)
+
+	appendEqual(&sb, "id", query.Id, &args, &argPos)
+	appendEqual(&sb, "user_id", query.UserId, &args, &argPos)
+	appendEqual(&sb, "token_hash", query.TokenHash, &args, &argPos)
+	appendOrder(&sb, "last_used_at", false)
+
+	rows, err := conn.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("query tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*token.Token
+	for rows.Next() {
+		var res models.V1RefreshTokenDal
+		if err := rows.Scan(
+			&res.Id,
+			&res.UserId,
+			&res.TokenHash,
+			&res.ExpiresAt,
+			&res.CreatedAt,
+			&res.UpdatedAt,
+			&res.Device,
+			&res.UserAgent,
+			&res.IP,
+			&res.LastUsedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan token: %w", err)
+		}
+		tokens = append(tokens, res.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query tokens: %w", err)
+	}
+
+	return tokens, nil
+}
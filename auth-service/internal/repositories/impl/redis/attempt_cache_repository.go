@@ -0,0 +1,112 @@
+package redisimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+)
+
+const (
+	ApplicantLoginAttemptsCache         impl.RepositoryType = "attempts:login:applicant"
+	EmployerLoginAttemptsCache          impl.RepositoryType = "attempts:login:employer"
+	ApplicantActivationAttemptsCache    impl.RepositoryType = "attempts:activation:applicant"
+	EmployerActivationAttemptsCache     impl.RepositoryType = "attempts:activation:employer"
+	ApplicantResetPasswordAttemptsCache impl.RepositoryType = "attempts:reset_password:applicant"
+	EmployerResetPasswordAttemptsCache  impl.RepositoryType = "attempts:reset_password:employer"
+	ApplicantMfaAttemptsCache           impl.RepositoryType = "attempts:mfa:applicant"
+	EmployerMfaAttemptsCache            impl.RepositoryType = "attempts:mfa:employer"
+	ApplicantEmailChangeAttemptsCache   impl.RepositoryType = "attempts:email_change:applicant"
+	EmployerEmailChangeAttemptsCache    impl.RepositoryType = "attempts:email_change:employer"
+)
+
+type AttemptCacheRepository struct {
+	redis          *redis.RedisClient
+	repositoryType impl.RepositoryType
+}
+
+func NewAttemptCacheRepository(redis *redis.RedisClient, repositoryType impl.RepositoryType) interfaces.AttemptCacheRepository {
+	return &AttemptCacheRepository{
+		redis:          redis,
+		repositoryType: repositoryType,
+	}
+}
+
+// GetLockout returns the remaining lockout of the subject, zero when it is not locked.
+func (r *AttemptCacheRepository) GetLockout(ctx context.Context, subject string) (time.Duration, error) {
+	ttl, err := r.redis.GetClient().PTTL(ctx, r.keyLock(subject)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// IncrementFailures counts a failed attempt inside a fixed window started by the first failure.
+func (r *AttemptCacheRepository) IncrementFailures(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	cl := r.redis.GetClient()
+	key := r.keyFailures(subject)
+
+	n, err := cl.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		if err := cl.PExpire(ctx, key, window).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// Lock locks the subject for base * 2^(level-1), capped by max, where level
+// is the number of lockouts not yet forgotten after levelTTL. The failures
+// counter is cleared so the next window starts after the lockout.
+func (r *AttemptCacheRepository) Lock(ctx context.Context, subject string, base, max, levelTTL time.Duration) (time.Duration, error) {
+	cl := r.redis.GetClient()
+
+	level, err := cl.Incr(ctx, r.keyLevel(subject)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if err := cl.PExpire(ctx, r.keyLevel(subject), levelTTL).Err(); err != nil {
+		return 0, err
+	}
+
+	lockout := base
+	for i := int64(1); i < level && lockout < max; i++ {
+		lockout *= 2
+	}
+	if lockout > max {
+		lockout = max
+	}
+
+	pipe := cl.TxPipeline()
+	pipe.Set(ctx, r.keyLock(subject), level, lockout)
+	pipe.Del(ctx, r.keyFailures(subject))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return lockout, nil
+}
+
+func (r *AttemptCacheRepository) Reset(ctx context.Context, subject string) error {
+	return r.redis.GetClient().Del(ctx, r.keyFailures(subject), r.keyLevel(subject), r.keyLock(subject)).Err()
+}
+
+func (r *AttemptCacheRepository) keyFailures(subject string) string {
+	return fmt.Sprintf("%s:%s:failures", r.repositoryType, subject)
+}
+
+func (r *AttemptCacheRepository) keyLevel(subject string) string {
+	return fmt.Sprintf("%s:%s:level", r.repositoryType, subject)
+}
+
+func (r *AttemptCacheRepository) keyLock(subject string) string {
+	return fmt.Sprintf("%s:%s:lock", r.repositoryType, subject)
+}
@@ -34,6 +34,23 @@ func get[T any](ctx context.Context, redisClient *rediscl.RedisClient, key strin
 	return &res, nil
 }
 
+// getDel reads and deletes the key atomically.
+func getDel[T any](ctx context.Context, redisClient *rediscl.RedisClient, key string) (*T, error) {
+	val, err := redisClient.GetClient().GetDel(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var res T
+	if err := json.Unmarshal([]byte(val), &res); err != nil {
+		return nil, fmt.Errorf("unmarshal cache value: %w", err)
+	}
+	return &res, nil
+}
+
 func del(ctx context.Context, redisClient *rediscl.RedisClient, key string) error {
 	return redisClient.GetClient().Del(ctx, key).Err()
 }
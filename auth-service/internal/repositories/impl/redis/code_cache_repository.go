@@ -17,6 +17,10 @@ const (
 	EmployerActivationCodesCache     impl.RepositoryType = "code:activation:employer"
 	ApplicantResetPasswordCodesCache impl.RepositoryType = "code:reset:applicant"
 	EmployerResetPasswordCodesCache  impl.RepositoryType = "code:reset:employer"
+	ApplicantEmailChangeCodesCache   impl.RepositoryType = "code:email_change:applicant"
+	EmployerEmailChangeCodesCache    impl.RepositoryType = "code:email_change:employer"
+	ApplicantLoginCodesCache         impl.RepositoryType = "code:login:applicant"
+	EmployerLoginCodesCache          impl.RepositoryType = "code:login:employer"
 )
 
 type CodeCacheRepository struct {
@@ -0,0 +1,58 @@
+package redisimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+)
+
+const (
+	ApplicantMfaChallengeCache impl.RepositoryType = "mfa_challenge:applicant"
+	EmployerMfaChallengeCache  impl.RepositoryType = "mfa_challenge:employer"
+)
+
+type mfaChallenge struct {
+	UserId int64 `json:"user_id"`
+}
+
+// MfaChallengeCacheRepository keeps the pending logins waiting for the second
+// factor, keyed by the hash of the handed out mfa token.
+type MfaChallengeCacheRepository struct {
+	redis          *redis.RedisClient
+	repositoryType impl.RepositoryType
+}
+
+func NewMfaChallengeCacheRepository(redis *redis.RedisClient, repositoryType impl.RepositoryType) interfaces.MfaChallengeCacheRepository {
+	return &MfaChallengeCacheRepository{
+		redis:          redis,
+		repositoryType: repositoryType,
+	}
+}
+
+func (r *MfaChallengeCacheRepository) Set(ctx context.Context, tokenHash string, userId int64, ttl time.Duration) error {
+	return set(ctx, r.redis, r.key(tokenHash), mfaChallenge{UserId: userId}, ttl)
+}
+
+// GetUserId returns zero when the challenge does not exist or has expired.
+func (r *MfaChallengeCacheRepository) GetUserId(ctx context.Context, tokenHash string) (int64, error) {
+	challenge, err := get[mfaChallenge](ctx, r.redis, r.key(tokenHash))
+	if err != nil {
+		return 0, err
+	}
+	if challenge == nil {
+		return 0, nil
+	}
+	return challenge.UserId, nil
+}
+
+func (r *MfaChallengeCacheRepository) Del(ctx context.Context, tokenHash string) error {
+	return del(ctx, r.redis, r.key(tokenHash))
+}
+
+func (r *MfaChallengeCacheRepository) key(tokenHash string) string {
+	return fmt.Sprintf("%s:hash:%s", r.repositoryType, tokenHash)
+}
@@ -0,0 +1,88 @@
+package redisimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/interfaces"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+)
+
+const (
+	ApplicantOidcAuthRequestCache  impl.RepositoryType = "oidc_auth_request:applicant"
+	ApplicantOidcRegistrationCache impl.RepositoryType = "oidc_registration:applicant"
+)
+
+type oidcAuthRequest struct {
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// OidcAuthRequestCacheRepository keeps the started provider logins, keyed by
+// the hash of the state sent to the provider.
+type OidcAuthRequestCacheRepository struct {
+	redis          *redis.RedisClient
+	repositoryType impl.RepositoryType
+}
+
+func NewOidcAuthRequestCacheRepository(redis *redis.RedisClient, repositoryType impl.RepositoryType) interfaces.OidcAuthRequestCacheRepository {
+	return &OidcAuthRequestCacheRepository{
+		redis:          redis,
+		repositoryType: repositoryType,
+	}
+}
+
+func (r *OidcAuthRequestCacheRepository) Set(ctx context.Context, stateHash string, req *oidc.AuthRequest, ttl time.Duration) error {
+	return set(ctx, r.redis, r.key(stateHash), oidcAuthRequest{Nonce: req.Nonce, CodeVerifier: req.CodeVerifier}, ttl)
+}
+
+// Pop returns nil when the request does not exist, has expired or was already used.
+func (r *OidcAuthRequestCacheRepository) Pop(ctx context.Context, stateHash string) (*oidc.AuthRequest, error) {
+	req, err := getDel[oidcAuthRequest](ctx, r.redis, r.key(stateHash))
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, nil
+	}
+	return &oidc.AuthRequest{Nonce: req.Nonce, CodeVerifier: req.CodeVerifier}, nil
+}
+
+func (r *OidcAuthRequestCacheRepository) key(stateHash string) string {
+	return fmt.Sprintf("%s:hash:%s", r.repositoryType, stateHash)
+}
+
+// OidcRegistrationCacheRepository keeps the verified provider profiles without
+// an account until the user completes the registration, keyed by the hash of
+// the handed out registration token.
+type OidcRegistrationCacheRepository struct {
+	redis          *redis.RedisClient
+	repositoryType impl.RepositoryType
+}
+
+func NewOidcRegistrationCacheRepository(redis *redis.RedisClient, repositoryType impl.RepositoryType) interfaces.OidcRegistrationCacheRepository {
+	return &OidcRegistrationCacheRepository{
+		redis:          redis,
+		repositoryType: repositoryType,
+	}
+}
+
+func (r *OidcRegistrationCacheRepository) Set(ctx context.Context, tokenHash string, profile *oidc.Profile, ttl time.Duration) error {
+	return set(ctx, r.redis, r.key(tokenHash), profile, ttl)
+}
+
+// Get returns nil when the registration does not exist or has expired.
+func (r *OidcRegistrationCacheRepository) Get(ctx context.Context, tokenHash string) (*oidc.Profile, error) {
+	return get[oidc.Profile](ctx, r.redis, r.key(tokenHash))
+}
+
+func (r *OidcRegistrationCacheRepository) Del(ctx context.Context, tokenHash string) error {
+	return del(ctx, r.redis, r.key(tokenHash))
+}
+
+func (r *OidcRegistrationCacheRepository) key(tokenHash string) string {
+	return fmt.Sprintf("%s:hash:%s", r.repositoryType, tokenHash)
+}
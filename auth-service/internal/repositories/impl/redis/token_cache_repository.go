@@ -29,8 +29,8 @@ func NewTokenCacheRepository(redis *redis.RedisClient, repositoryType impl.Repos
 	}
 }
 
-func (r *TokenCacheRepository) Get(ctx context.Context, token string) (*token.Token, error) {
-	dal, err := get[models.V1RefreshTokenDal](ctx, r.redis, r.keyToken(token))
+func (r *TokenCacheRepository) Get(ctx context.Context, tokenHash string) (*token.Token, error) {
+	dal, err := get[models.V1RefreshTokenDal](ctx, r.redis, r.keyTokenHash(tokenHash))
 	if err != nil {
 		return nil, err
 	}
@@ -42,13 +42,13 @@ func (r *TokenCacheRepository) Get(ctx context.Context, token string) (*token.To
 
 func (r *TokenCacheRepository) Set(ctx context.Context, token *token.Token) error {
 	dal := models.V1RefreshTokenDalFromDomain(token)
-	return set(ctx, r.redis, r.keyToken(dal.Token), dal, time.Until(dal.ExpiresAt))
+	return set(ctx, r.redis, r.keyTokenHash(dal.TokenHash), dal, time.Until(dal.ExpiresAt))
 }
 
-func (r *TokenCacheRepository) Del(ctx context.Context, token string) error {
-	return del(ctx, r.redis, r.keyToken(token))
+func (r *TokenCacheRepository) Del(ctx context.Context, tokenHash string) error {
+	return del(ctx, r.redis, r.keyTokenHash(tokenHash))
 }
 
-func (r *TokenCacheRepository) keyToken(token string) string {
-	return fmt.Sprintf("%s:%s", r.repositoryType, token)
+func (r *TokenCacheRepository) keyTokenHash(tokenHash string) string {
+	return fmt.Sprintf("%s:hash:%s", r.repositoryType, tokenHash)
 }
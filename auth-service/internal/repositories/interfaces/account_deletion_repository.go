@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+type AccountDeletionRepository interface {
+	MarkDeleted(ctx context.Context, userId int64, deletedAt time.Time) error
+	UnmarkDeleted(ctx context.Context, userId int64) (bool, error)
+	ListDeletedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+type AttemptCacheRepository interface {
+	GetLockout(ctx context.Context, subject string) (time.Duration, error)
+	IncrementFailures(ctx context.Context, subject string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, subject string, base, max, levelTTL time.Duration) (time.Duration, error)
+	Reset(ctx context.Context, subject string) error
+}
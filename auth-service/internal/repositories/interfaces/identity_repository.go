@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+)
+
+type IdentityRepository interface {
+	CreateIdentity(ctx context.Context, identity *oidc.Identity) error
+	QueryIdentity(ctx context.Context, query *models.QueryIdentityDal) (*oidc.Identity, error)
+	DeleteIdentitiesByUserId(ctx context.Context, userId int64) error
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+type MfaChallengeCacheRepository interface {
+	Set(ctx context.Context, tokenHash string, userId int64, ttl time.Duration) error
+	GetUserId(ctx context.Context, tokenHash string) (int64, error)
+	Del(ctx context.Context, tokenHash string) error
+}
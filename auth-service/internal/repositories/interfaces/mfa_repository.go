@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/mfa"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+)
+
+type MfaRepository interface {
+	CreateMfa(ctx context.Context, mfa *mfa.Mfa) error
+	UpdateMfa(ctx context.Context, mfa *mfa.Mfa) error
+	DeleteMfa(ctx context.Context, mfa *mfa.Mfa) error
+	QueryMfa(ctx context.Context, query *models.QueryMfaDal) (*mfa.Mfa, error)
+}
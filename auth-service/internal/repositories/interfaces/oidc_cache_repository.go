@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+)
+
+type OidcAuthRequestCacheRepository interface {
+	Set(ctx context.Context, stateHash string, req *oidc.AuthRequest, ttl time.Duration) error
+	// Pop returns the request and deletes it, so a state is accepted once.
+	Pop(ctx context.Context, stateHash string) (*oidc.AuthRequest, error)
+}
+
+type OidcRegistrationCacheRepository interface {
+	Set(ctx context.Context, tokenHash string, profile *oidc.Profile, ttl time.Duration) error
+	Get(ctx context.Context, tokenHash string) (*oidc.Profile, error)
+	Del(ctx context.Context, tokenHash string) error
+}
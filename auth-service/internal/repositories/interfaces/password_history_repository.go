@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/password"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+)
+
+type PasswordHistoryRepository interface {
+	CreateEntry(ctx context.Context, entry *password.HistoryEntry) error
+	QueryEntries(ctx context.Context, query *models.QueryPasswordHistoryDal) ([]*password.HistoryEntry, error)
+	DeleteEntriesExceptLatest(ctx context.Context, userId int64, keep int) error
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/registration"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+)
+
+type RegistrationSagaRepository interface {
+	CreateSaga(ctx context.Context, saga *registration.Saga) error
+	UpdateSaga(ctx context.Context, saga *registration.Saga) error
+	QuerySagas(ctx context.Context, query *models.QueryRegistrationSagaDal) ([]*registration.Saga, error)
+}
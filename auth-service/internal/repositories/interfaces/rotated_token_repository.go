@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/token"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+)
+
+type RotatedTokenRepository interface {
+	CreateRotatedToken(ctx context.Context, token *token.RotatedToken) error
+	QueryRotatedToken(ctx context.Context, query *models.QueryRotatedTokenDal) (*token.RotatedToken, error)
+}
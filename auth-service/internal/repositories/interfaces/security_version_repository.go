@@ -0,0 +1,8 @@
+package interfaces
+
+import "context"
+
+type SecurityVersionRepository interface {
+	GetVersion(ctx context.Context, userId int64) (int, error)
+	IncrementVersion(ctx context.Context, userId int64) (int, error)
+}
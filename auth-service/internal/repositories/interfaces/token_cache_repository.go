@@ -7,7 +7,7 @@ import (
 )
 
 type TokenCacheRepository interface {
-	Get(ctx context.Context, token string) (*token.Token, error)
+	Get(ctx context.Context, tokenHash string) (*token.Token, error)
 	Set(ctx context.Context, token *token.Token) error
-	Del(ctx context.Context, token string) error
+	Del(ctx context.Context, tokenHash string) error
 }
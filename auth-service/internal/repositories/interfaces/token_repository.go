@@ -10,7 +10,10 @@ import (
 type TokenRepository interface {
 	CreateToken(ctx context.Context, token *token.Token) error
 	UpdateToken(ctx context.Context, token *token.Token) error
-	DeleteToken(ctx context.Context, tokenStr string) error
-	DeleteTokensByUserId(ctx context.Context, userId int64) error
+	RotateToken(ctx context.Context, token *token.Token, oldTokenHash string) error
+	DeleteToken(ctx context.Context, tokenHash string) error
+	DeleteTokensByUserId(ctx context.Context, userId int64) ([]*token.Token, error)
+	DeleteTokensByUserIdExceptId(ctx context.Context, userId int64, exceptId int64) ([]*token.Token, error)
 	QueryToken(ctx context.Context, query *models.QueryTokenDal) (*token.Token, error)
+	QueryTokens(ctx context.Context, query *models.QueryTokenDal) ([]*token.Token, error)
 }
@@ -0,0 +1,17 @@
+package models
+
+type QueryIdentityDal struct {
+	Id       *int64
+	UserId   *int64
+	Provider *string
+	Subject  *string
+}
+
+func NewQueryIdentityDal(id, userId *int64, provider, subject *string) *QueryIdentityDal {
+	return &QueryIdentityDal{
+		Id:       id,
+		UserId:   userId,
+		Provider: provider,
+		Subject:  subject,
+	}
+}
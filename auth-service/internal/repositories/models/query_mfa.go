@@ -0,0 +1,13 @@
+package models
+
+type QueryMfaDal struct {
+	Id     *int64
+	UserId *int64
+}
+
+func NewQueryMfaDal(id, userId *int64) *QueryMfaDal {
+	return &QueryMfaDal{
+		Id:     id,
+		UserId: userId,
+	}
+}
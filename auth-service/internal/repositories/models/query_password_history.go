@@ -0,0 +1,13 @@
+package models
+
+type QueryPasswordHistoryDal struct {
+	UserId *int64
+	Limit  int
+}
+
+func NewQueryPasswordHistoryDal(userId *int64, limit int) *QueryPasswordHistoryDal {
+	return &QueryPasswordHistoryDal{
+		UserId: userId,
+		Limit:  limit,
+	}
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+type QueryRegistrationSagaDal struct {
+	Id            *int64
+	Email         *string
+	States        []string
+	UpdatedBefore *time.Time
+	Limit         int
+}
+
+func NewQueryRegistrationSagaDal(id *int64, email *string, states []string, updatedBefore *time.Time, limit int) *QueryRegistrationSagaDal {
+	return &QueryRegistrationSagaDal{
+		Id:            id,
+		Email:         email,
+		States:        states,
+		UpdatedBefore: updatedBefore,
+		Limit:         limit,
+	}
+}
@@ -0,0 +1,13 @@
+package models
+
+type QueryRotatedTokenDal struct {
+	FamilyId  *int64
+	TokenHash *string
+}
+
+func NewQueryRotatedTokenDal(familyId *int64, tokenHash *string) *QueryRotatedTokenDal {
+	return &QueryRotatedTokenDal{
+		FamilyId:  familyId,
+		TokenHash: tokenHash,
+	}
+}
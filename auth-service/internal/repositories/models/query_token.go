@@ -1,15 +1,15 @@
 package models
 
 type QueryTokenDal struct {
-	Id     *int64
-	UserId *int64
-	Token  *string
+	Id        *int64
+	UserId    *int64
+	TokenHash *string
 }
 
-func NewQueryTokenDal(id, userId *int64, token *string) *QueryTokenDal {
+func NewQueryTokenDal(id, userId *int64, tokenHash *string) *QueryTokenDal {
 	return &QueryTokenDal{
-		Id:     id,
-		UserId: userId,
-		Token:  token,
+		Id:        id,
+		UserId:    userId,
+		TokenHash: tokenHash,
 	}
 }
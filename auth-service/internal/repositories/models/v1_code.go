@@ -9,11 +9,13 @@ import (
 type V1CodeDal struct {
 	Id              int64     `db:"id" json:"id"`
 	UserId          int64     `db:"user_id" json:"user_id"`
-	Code            string    `db:"code" json:"code"`
+	CodeHash        string    `db:"code_hash" json:"code_hash"`
 	GenerationsLeft int       `db:"generations_left" json:"generations_left"`
 	ExpiresAt       time.Time `db:"expires_at" json:"expires_at"`
 	CreatedAt       time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
+	AttemptsLeft    int       `db:"attempts_left" json:"attempts_left"`
+	NewEmail        string    `db:"new_email" json:"new_email"`
 }
 
 func V1CodeDalFromDomain(c *code.Code) V1CodeDal {
@@ -24,11 +26,13 @@ func V1CodeDalFromDomain(c *code.Code) V1CodeDal {
 	return V1CodeDal{
 		Id:              c.Id(),
 		UserId:          c.UserId(),
-		Code:            c.Code(),
+		CodeHash:        c.CodeHash(),
 		GenerationsLeft: c.GenerationsLeft(),
 		ExpiresAt:       c.ExpiresAt(),
 		CreatedAt:       c.CreatedAt(),
 		UpdatedAt:       c.UpdatedAt(),
+		AttemptsLeft:    c.AttemptsLeft(),
+		NewEmail:        c.NewEmail(),
 	}
 }
 
@@ -40,7 +44,7 @@ func (c V1CodeDal) Index(i int) any {
 	case 1:
 		return c.UserId
 	case 2:
-		return c.Code
+		return c.CodeHash
 	case 3:
 		return c.GenerationsLeft
 	case 4:
@@ -49,6 +53,10 @@ func (c V1CodeDal) Index(i int) any {
 		return c.CreatedAt
 	case 6:
 		return c.UpdatedAt
+	case 7:
+		return c.AttemptsLeft
+	case 8:
+		return c.NewEmail
 	default:
 		return nil
 	}
@@ -57,7 +65,9 @@ func (c V1CodeDal) Index(i int) any {
 func (c V1CodeDal) ToDomain() *code.Code {
 	return code.FromStorage(
 		c.Id, c.UserId,
-		c.Code, c.GenerationsLeft,
+		c.CodeHash, c.GenerationsLeft,
 		c.ExpiresAt, c.CreatedAt, c.UpdatedAt,
+		c.AttemptsLeft,
+		c.NewEmail,
 	)
 }
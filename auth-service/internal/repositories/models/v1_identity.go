@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+)
+
+type V1IdentityDal struct {
+	Id        int64     `db:"id" json:"id"`
+	UserId    int64     `db:"user_id" json:"user_id"`
+	Provider  string    `db:"provider" json:"provider"`
+	Subject   string    `db:"subject" json:"subject"`
+	Email     string    `db:"email" json:"email"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func V1IdentityDalFromDomain(i *oidc.Identity) V1IdentityDal {
+	if i == nil {
+		return V1IdentityDal{}
+	}
+
+	return V1IdentityDal{
+		Id:        i.Id(),
+		UserId:    i.UserId(),
+		Provider:  i.Provider(),
+		Subject:   i.Subject(),
+		Email:     i.Email(),
+		CreatedAt: i.CreatedAt(),
+		UpdatedAt: i.UpdatedAt(),
+	}
+}
+
+func (i V1IdentityDal) IsNull() bool { return false }
+func (i V1IdentityDal) Index(idx int) any {
+	switch idx {
+	case 0:
+		return i.Id
+	case 1:
+		return i.UserId
+	case 2:
+		return i.Provider
+	case 3:
+		return i.Subject
+	case 4:
+		return i.Email
+	case 5:
+		return i.CreatedAt
+	case 6:
+		return i.UpdatedAt
+	default:
+		return nil
+	}
+}
+
+func (i V1IdentityDal) ToDomain() *oidc.Identity {
+	return oidc.FromStorage(
+		i.Id, i.UserId,
+		i.Provider, i.Subject, i.Email,
+		i.CreatedAt, i.UpdatedAt,
+	)
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/mfa"
+)
+
+type V1MfaDal struct {
+	Id                 int64     `db:"id" json:"id"`
+	UserId             int64     `db:"user_id" json:"user_id"`
+	EncryptedSecret    string    `db:"encrypted_secret" json:"encrypted_secret"`
+	IsConfirmed        bool      `db:"is_confirmed" json:"is_confirmed"`
+	RecoveryCodeHashes []string  `db:"recovery_code_hashes" json:"recovery_code_hashes"`
+	LastUsedStep       int64     `db:"last_used_step" json:"last_used_step"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func V1MfaDalFromDomain(m *mfa.Mfa) V1MfaDal {
+	if m == nil {
+		return V1MfaDal{}
+	}
+
+	recoveryCodeHashes := m.RecoveryCodeHashes()
+	if recoveryCodeHashes == nil {
+		recoveryCodeHashes = []string{}
+	}
+
+	return V1MfaDal{
+		Id:                 m.Id(),
+		UserId:             m.UserId(),
+		EncryptedSecret:    m.EncryptedSecret(),
+		IsConfirmed:        m.IsConfirmed(),
+		RecoveryCodeHashes: recoveryCodeHashes,
+		LastUsedStep:       m.LastUsedStep(),
+		CreatedAt:          m.CreatedAt(),
+		UpdatedAt:          m.UpdatedAt(),
+	}
+}
+
+func (m V1MfaDal) IsNull() bool { return false }
+func (m V1MfaDal) Index(i int) any {
+	switch i {
+	case 0:
+		return m.Id
+	case 1:
+		return m.UserId
+	case 2:
+		return m.EncryptedSecret
+	case 3:
+		return m.IsConfirmed
+	case 4:
+		return m.RecoveryCodeHashes
+	case 5:
+		return m.LastUsedStep
+	case 6:
+		return m.CreatedAt
+	case 7:
+		return m.UpdatedAt
+	default:
+		return nil
+	}
+}
+
+func (m V1MfaDal) ToDomain() *mfa.Mfa {
+	return mfa.FromStorage(
+		m.Id, m.UserId,
+		m.EncryptedSecret,
+		m.IsConfirmed,
+		m.RecoveryCodeHashes,
+		m.LastUsedStep,
+		m.CreatedAt, m.UpdatedAt,
+	)
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/password"
+)
+
+type V1PasswordHistoryDal struct {
+	Id           int64     `db:"id" json:"id"`
+	UserId       int64     `db:"user_id" json:"user_id"`
+	PasswordHash string    `db:"password_hash" json:"password_hash"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
+
+func V1PasswordHistoryDalFromDomain(e *password.HistoryEntry) V1PasswordHistoryDal {
+	if e == nil {
+		return V1PasswordHistoryDal{}
+	}
+
+	return V1PasswordHistoryDal{
+		Id:           e.Id(),
+		UserId:       e.UserId(),
+		PasswordHash: e.PasswordHash(),
+		CreatedAt:    e.CreatedAt(),
+	}
+}
+
+func (e V1PasswordHistoryDal) IsNull() bool { return false }
+func (e V1PasswordHistoryDal) Index(i int) any {
+	switch i {
+	case 0:
+		return e.Id
+	case 1:
+		return e.UserId
+	case 2:
+		return e.PasswordHash
+	case 3:
+		return e.CreatedAt
+	default:
+		return nil
+	}
+}
+
+func (e V1PasswordHistoryDal) ToDomain() *password.HistoryEntry {
+	return password.HistoryEntryFromStorage(
+		e.Id, e.UserId,
+		e.PasswordHash,
+		e.CreatedAt,
+	)
+}
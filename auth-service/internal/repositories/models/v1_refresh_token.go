@@ -7,12 +7,16 @@ import (
 )
 
 type V1RefreshTokenDal struct {
-	Id        int64     `db:"id" json:"id"`
-	UserId    int64     `db:"user_id" json:"user_id"`
-	Token     string    `db:"token" json:"token"`
-	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	Id         int64     `db:"id" json:"id"`
+	UserId     int64     `db:"user_id" json:"user_id"`
+	TokenHash  string    `db:"token_hash" json:"token_hash"`
+	ExpiresAt  time.Time `db:"expires_at" json:"expires_at"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+	Device     string    `db:"device" json:"device"`
+	UserAgent  string    `db:"user_agent" json:"user_agent"`
+	IP         string    `db:"ip" json:"ip"`
+	LastUsedAt time.Time `db:"last_used_at" json:"last_used_at"`
 }
 
 func V1RefreshTokenDalFromDomain(t *token.Token) V1RefreshTokenDal {
@@ -21,12 +25,16 @@ func V1RefreshTokenDalFromDomain(t *token.Token) V1RefreshTokenDal {
 	}
 
 	return V1RefreshTokenDal{
-		Id:        t.Id(),
-		UserId:    t.UserId(),
-		Token:     t.Token(),
-		ExpiresAt: t.ExpiresAt(),
-		CreatedAt: t.CreatedAt(),
-		UpdatedAt: t.UpdatedAt(),
+		Id:         t.Id(),
+		UserId:     t.UserId(),
+		TokenHash:  t.TokenHash(),
+		ExpiresAt:  t.ExpiresAt(),
+		CreatedAt:  t.CreatedAt(),
+		UpdatedAt:  t.UpdatedAt(),
+		Device:     t.Device(),
+		UserAgent:  t.UserAgent(),
+		IP:         t.IP(),
+		LastUsedAt: t.LastUsedAt(),
 	}
 }
 
@@ -38,13 +46,21 @@ func (p V1RefreshTokenDal) Index(i int) any {
 	case 1:
 		return p.UserId
 	case 2:
-		return p.Token
+		return p.TokenHash
 	case 3:
 		return p.ExpiresAt
 	case 4:
 		return p.CreatedAt
 	case 5:
 		return p.UpdatedAt
+	case 6:
+		return p.Device
+	case 7:
+		return p.UserAgent
+	case 8:
+		return p.IP
+	case 9:
+		return p.LastUsedAt
 	default:
 		return nil
 	}
@@ -53,7 +69,8 @@ func (p V1RefreshTokenDal) Index(i int) any {
 func (p V1RefreshTokenDal) ToDomain() *token.Token {
 	return token.FromStorage(
 		p.Id, p.UserId,
-		p.Token, token.RefreshTokenType,
+		p.TokenHash, token.RefreshTokenType,
 		p.ExpiresAt, p.CreatedAt, p.UpdatedAt,
+		p.Device, p.UserAgent, p.IP, p.LastUsedAt,
 	)
 }
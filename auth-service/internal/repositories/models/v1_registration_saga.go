@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/registration"
+)
+
+type V1RegistrationSagaDal struct {
+	Id        int64     `db:"id" json:"id"`
+	UserId    int64     `db:"user_id" json:"user_id"`
+	Email     string    `db:"email" json:"email"`
+	State     string    `db:"state" json:"state"`
+	Attempts  int       `db:"attempts" json:"attempts"`
+	LastError string    `db:"last_error" json:"last_error"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func V1RegistrationSagaDalFromDomain(s *registration.Saga) V1RegistrationSagaDal {
+	if s == nil {
+		return V1RegistrationSagaDal{}
+	}
+
+	return V1RegistrationSagaDal{
+		Id:        s.Id(),
+		UserId:    s.UserId(),
+		Email:     s.Email(),
+		State:     string(s.State()),
+		Attempts:  s.Attempts(),
+		LastError: s.LastError(),
+		CreatedAt: s.CreatedAt(),
+		UpdatedAt: s.UpdatedAt(),
+	}
+}
+
+func (s V1RegistrationSagaDal) IsNull() bool { return false }
+func (s V1RegistrationSagaDal) Index(idx int) any {
+	switch idx {
+	case 0:
+		return s.Id
+	case 1:
+		return s.UserId
+	case 2:
+		return s.Email
+	case 3:
+		return s.State
+	case 4:
+		return s.Attempts
+	case 5:
+		return s.LastError
+	case 6:
+		return s.CreatedAt
+	case 7:
+		return s.UpdatedAt
+	default:
+		return nil
+	}
+}
+
+func (s V1RegistrationSagaDal) ToDomain() *registration.Saga {
+	return registration.FromStorage(
+		s.Id, s.UserId,
+		s.Email, registration.State(s.State),
+		s.Attempts, s.LastError,
+		s.CreatedAt, s.UpdatedAt,
+	)
+}
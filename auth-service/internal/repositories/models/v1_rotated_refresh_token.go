@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/token"
+)
+
+type V1RotatedRefreshTokenDal struct {
+	Id        int64     `db:"id" json:"id"`
+	FamilyId  int64     `db:"family_id" json:"family_id"`
+	UserId    int64     `db:"user_id" json:"user_id"`
+	TokenHash string    `db:"token_hash" json:"token_hash"`
+	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
+	RotatedAt time.Time `db:"rotated_at" json:"rotated_at"`
+}
+
+func V1RotatedRefreshTokenDalFromDomain(t *token.RotatedToken) V1RotatedRefreshTokenDal {
+	if t == nil {
+		return V1RotatedRefreshTokenDal{}
+	}
+
+	return V1RotatedRefreshTokenDal{
+		Id:        t.Id(),
+		FamilyId:  t.FamilyId(),
+		UserId:    t.UserId(),
+		TokenHash: t.TokenHash(),
+		ExpiresAt: t.ExpiresAt(),
+		RotatedAt: t.RotatedAt(),
+	}
+}
+
+func (p V1RotatedRefreshTokenDal) IsNull() bool { return false }
+func (p V1RotatedRefreshTokenDal) Index(i int) any {
+	switch i {
+	case 0:
+		return p.Id
+	case 1:
+		return p.FamilyId
+	case 2:
+		return p.UserId
+	case 3:
+		return p.TokenHash
+	case 4:
+		return p.ExpiresAt
+	case 5:
+		return p.RotatedAt
+	default:
+		return nil
+	}
+}
+
+func (p V1RotatedRefreshTokenDal) ToDomain() *token.RotatedToken {
+	return token.RotatedTokenFromStorage(
+		p.Id, p.FamilyId, p.UserId,
+		p.TokenHash,
+		p.ExpiresAt, p.RotatedAt,
+	)
+}
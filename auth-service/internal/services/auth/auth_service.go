@@ -3,25 +3,40 @@ package authservice
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/auth_service/v1"
 	userv1 "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/code"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/password"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/registration"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/token"
 	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+	bruteforceservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/bruteforce"
 	codeservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/code"
+	mfaservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/mfa"
+	notificationservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/notification"
+	oidcservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/oidc"
 	passwordservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/password"
+	registrationservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/registration"
+	retentionservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/retention"
 	tokenservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/token"
 	userservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/user_service"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/postgres"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
 	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/golang-jwt/jwt/v5"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AuthService interface {
@@ -29,77 +44,129 @@ type AuthService interface {
 	GetNewApplicantActivationCode(ctx context.Context, req *pb.GetNewApplicantActivationCodeRequest) (*pb.GetNewApplicantActivationCodeResponse, error)
 	ActivateApplicant(ctx context.Context, req *pb.ActivateApplicantRequest) (*pb.ActivateApplicantResponse, error)
 	LoginApplicant(ctx context.Context, req *pb.LoginApplicantRequest) (*pb.LoginApplicantResponse, error)
+	RequestApplicantLoginCode(ctx context.Context, req *pb.RequestApplicantLoginCodeRequest) (*pb.RequestApplicantLoginCodeResponse, error)
+	LoginApplicantWithCode(ctx context.Context, req *pb.LoginApplicantWithCodeRequest) (*pb.LoginApplicantWithCodeResponse, error)
+	StartApplicantOidcLogin(ctx context.Context, req *pb.StartApplicantOidcLoginRequest) (*pb.StartApplicantOidcLoginResponse, error)
+	CompleteApplicantOidcLogin(ctx context.Context, req *pb.CompleteApplicantOidcLoginRequest) (*pb.CompleteApplicantOidcLoginResponse, error)
+	RegisterApplicantWithOidc(ctx context.Context, req *pb.RegisterApplicantWithOidcRequest) (*pb.RegisterApplicantWithOidcResponse, error)
 	RefreshApplicant(ctx context.Context, req *pb.RefreshApplicantRequest) (*pb.RefreshApplicantResponse, error)
 	LogoutApplicant(ctx context.Context, req *pb.LogoutApplicantRequest) (*pb.LogoutApplicantResponse, error)
 	GetResetApplicantPasswordCode(ctx context.Context, req *pb.GetResetApplicantPasswordCodeRequest) (*pb.GetResetApplicantPasswordCodeResponse, error)
 	ResetApplicantPassword(ctx context.Context, req *pb.ResetApplicantPasswordRequest) (*pb.ResetApplicantPasswordResponse, error)
 	ChangeApplicantPassword(ctx context.Context, req *pb.ChangeApplicantPasswordRequest) (*pb.ChangeApplicantPasswordResponse, error)
+	ListApplicantSessions(ctx context.Context, req *pb.ListApplicantSessionsRequest) (*pb.ListApplicantSessionsResponse, error)
+	RevokeApplicantSession(ctx context.Context, req *pb.RevokeApplicantSessionRequest) (*pb.RevokeApplicantSessionResponse, error)
+	RevokeOtherApplicantSessions(ctx context.Context, req *pb.RevokeOtherApplicantSessionsRequest) (*pb.RevokeOtherApplicantSessionsResponse, error)
+	RequestApplicantEmailChange(ctx context.Context, req *pb.RequestApplicantEmailChangeRequest) (*pb.RequestApplicantEmailChangeResponse, error)
+	ConfirmApplicantEmailChange(ctx context.Context, req *pb.ConfirmApplicantEmailChangeRequest) (*pb.ConfirmApplicantEmailChangeResponse, error)
+	DeleteApplicantAccount(ctx context.Context, req *pb.DeleteApplicantAccountRequest) (*pb.DeleteApplicantAccountResponse, error)
+	RestoreApplicantAccount(ctx context.Context, req *pb.RestoreApplicantAccountRequest) (*pb.RestoreApplicantAccountResponse, error)
+	EnrollApplicantMfa(ctx context.Context, req *pb.EnrollApplicantMfaRequest) (*pb.EnrollApplicantMfaResponse, error)
+	ConfirmApplicantMfa(ctx context.Context, req *pb.ConfirmApplicantMfaRequest) (*pb.ConfirmApplicantMfaResponse, error)
+	DisableApplicantMfa(ctx context.Context, req *pb.DisableApplicantMfaRequest) (*pb.DisableApplicantMfaResponse, error)
+	VerifyApplicantMfa(ctx context.Context, req *pb.VerifyApplicantMfaRequest) (*pb.VerifyApplicantMfaResponse, error)
+	BumpApplicantSecurityVersion(ctx context.Context, req *pb.BumpApplicantSecurityVersionRequest) (*pb.BumpApplicantSecurityVersionResponse, error)
+
+	RegisterEmployer(ctx context.Context, req *pb.RegisterEmployerRequest) (*pb.RegisterEmployerResponse, error)
+	GetNewEmployerActivationCode(ctx context.Context, req *pb.GetNewEmployerActivationCodeRequest) (*pb.GetNewEmployerActivationCodeResponse, error)
+	ActivateEmployer(ctx context.Context, req *pb.ActivateEmployerRequest) (*pb.ActivateEmployerResponse, error)
+	LoginEmployer(ctx context.Context, req *pb.LoginEmployerRequest) (*pb.LoginEmployerResponse, error)
+	RequestEmployerLoginCode(ctx context.Context, req *pb.RequestEmployerLoginCodeRequest) (*pb.RequestEmployerLoginCodeResponse, error)
+	LoginEmployerWithCode(ctx context.Context, req *pb.LoginEmployerWithCodeRequest) (*pb.LoginEmployerWithCodeResponse, error)
+	RefreshEmployer(ctx context.Context, req *pb.RefreshEmployerRequest) (*pb.RefreshEmployerResponse, error)
+	LogoutEmployer(ctx context.Context, req *pb.LogoutEmployerRequest) (*pb.LogoutEmployerResponse, error)
+	GetResetEmployerPasswordCode(ctx context.Context, req *pb.GetResetEmployerPasswordCodeRequest) (*pb.GetResetEmployerPasswordCodeResponse, error)
+	ResetEmployerPassword(ctx context.Context, req *pb.ResetEmployerPasswordRequest) (*pb.ResetEmployerPasswordResponse, error)
+	ChangeEmployerPassword(ctx context.Context, req *pb.ChangeEmployerPasswordRequest) (*pb.ChangeEmployerPasswordResponse, error)
+	ListEmployerSessions(ctx context.Context, req *pb.ListEmployerSessionsRequest) (*pb.ListEmployerSessionsResponse, error)
+	RevokeEmployerSession(ctx context.Context, req *pb.RevokeEmployerSessionRequest) (*pb.RevokeEmployerSessionResponse, error)
+	RevokeOtherEmployerSessions(ctx context.Context, req *pb.RevokeOtherEmployerSessionsRequest) (*pb.RevokeOtherEmployerSessionsResponse, error)
+	RequestEmployerEmailChange(ctx context.Context, req *pb.RequestEmployerEmailChangeRequest) (*pb.RequestEmployerEmailChangeResponse, error)
+	ConfirmEmployerEmailChange(ctx context.Context, req *pb.ConfirmEmployerEmailChangeRequest) (*pb.ConfirmEmployerEmailChangeResponse, error)
+	DeleteEmployerAccount(ctx context.Context, req *pb.DeleteEmployerAccountRequest) (*pb.DeleteEmployerAccountResponse, error)
+	RestoreEmployerAccount(ctx context.Context, req *pb.RestoreEmployerAccountRequest) (*pb.RestoreEmployerAccountResponse, error)
+	EnrollEmployerMfa(ctx context.Context, req *pb.EnrollEmployerMfaRequest) (*pb.EnrollEmployerMfaResponse, error)
+	ConfirmEmployerMfa(ctx context.Context, req *pb.ConfirmEmployerMfaRequest) (*pb.ConfirmEmployerMfaResponse, error)
+	DisableEmployerMfa(ctx context.Context, req *pb.DisableEmployerMfaRequest) (*pb.DisableEmployerMfaResponse, error)
+	VerifyEmployerMfa(ctx context.Context, req *pb.VerifyEmployerMfaRequest) (*pb.VerifyEmployerMfaResponse, error)
+	BumpEmployerSecurityVersion(ctx context.Context, req *pb.BumpEmployerSecurityVersionRequest) (*pb.BumpEmployerSecurityVersionResponse, error)
+
+	IntrospectToken(ctx context.Context, req *pb.IntrospectTokenRequest) (*pb.IntrospectTokenResponse, error)
 }
 
 type service struct {
-	codeService     codeservice.CodeService
-	passwordService passwordservice.PasswordService
-	tokenService    tokenservice.TokenService
-	userService     userservice.UserService
-	postgresClient  *postgres.PostgresClient
-	log             *zap.SugaredLogger
+	codeService         codeservice.CodeService
+	passwordService     passwordservice.PasswordService
+	tokenService        tokenservice.TokenService
+	userService         userservice.UserService
+	notificationService notificationservice.NotificationService
+	bruteForceService   bruteforceservice.BruteForceService
+	mfaService          mfaservice.MfaService
+	oidcService         oidcservice.OidcService
+	registrationService registrationservice.RegistrationService
+	retentionService    retentionservice.RetentionService
+	postgresClient      *postgres.PostgresClient
+	log                 *zap.SugaredLogger
 }
 
 func New(
 	postgresClient *postgres.PostgresClient,
 	codeSvc codeservice.CodeService, passwordSvc passwordservice.PasswordService,
 	tokenSvc tokenservice.TokenService, userSvc userservice.UserService,
+	notificationSvc notificationservice.NotificationService,
+	bruteForceSvc bruteforceservice.BruteForceService,
+	mfaSvc mfaservice.MfaService,
+	oidcSvc oidcservice.OidcService,
+	registrationSvc registrationservice.RegistrationService,
+	retentionSvc retentionservice.RetentionService,
 	log *zap.SugaredLogger,
 ) AuthService {
 	return &service{
-		codeService:     codeSvc,
-		passwordService: passwordSvc,
-		tokenService:    tokenSvc,
-		userService:     userSvc,
-		postgresClient:  postgresClient,
-		log:             log,
+		codeService:         codeSvc,
+		passwordService:     passwordSvc,
+		tokenService:        tokenSvc,
+		userService:         userSvc,
+		notificationService: notificationSvc,
+		bruteForceService:   bruteForceSvc,
+		mfaService:          mfaSvc,
+		oidcService:         oidcSvc,
+		registrationService: registrationSvc,
+		retentionService:    retentionSvc,
+		postgresClient:      postgresClient,
+		log:                 log,
 	}
 }
 
 func (s *service) RegisterApplicant(ctx context.Context, req *pb.RegisterApplicantRequest) (*pb.RegisterApplicantResponse, error) {
 	l := s.log.With("op", "register_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	applicant, err := s.userService.CreateApplicant(ctx, req.Applicant)
-	if err != nil {
-		return nil, err
-	}
-
-	uow := uow.New(s.postgresClient)
-	defer uow.Close()
-	_, err = uow.BeginTransaction(ctx)
+	saga, err := s.registrationService.StartApplicant(ctx, req.Applicant.GetEmail())
 	if err != nil {
-		l.Errorw("auth.register_applicant_failed", "err", err)
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
-	_, err = s.passwordService.CreateApplicantPassword(ctx, uow, applicant, req.Password)
+	applicant, err := s.userService.CreateApplicant(ctx, req.Applicant)
 	if err != nil {
-		var pve *password.PasswordValidationError
-		if errors.As(err, &pve) {
-			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		// the applicant may have been created, the open saga is left to the reconciliation
+		if !outcomeUnknown(err) {
+			s.registrationService.AbortApplicant(ctx, saga, err)
 		}
-		return nil, status.Errorf(codes.Internal, "internal server error")
+		return nil, err
 	}
 
-	_, err = s.codeService.CreateApplicantActivationCode(ctx, uow, applicant)
+	activationCode, err := s.storeApplicantCredentials(ctx, saga, applicant, req.Password)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "internal server error")
-	}
-
-	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
+		s.registrationService.CompensateApplicant(context.WithoutCancel(ctx), saga, applicant)
 		return nil, err
 	}
 
-	if err := uow.Commit(ctx); err != nil {
-		l.Errorw("auth.register_applicant_failed", "err", err)
-		return nil, status.Errorf(codes.Internal, "internal server error")
+	// the account is already created, so a delivery failure is not fatal:
+	// the user can request a new activation code
+	if err := s.notificationService.SendApplicantActivationCode(ctx, applicant, activationCode); err != nil {
+		l.Warnw("auth.register_applicant.send_activation_code_failed", "err", err)
 	}
 
+	l.Infow("auth.register_applicant.success")
 	return &pb.RegisterApplicantResponse{Applicant: applicant}, nil
 }
 
@@ -114,7 +181,7 @@ func (s *service) GetNewApplicantActivationCode(ctx context.Context, req *pb.Get
 	uow := uow.New(s.postgresClient)
 	defer uow.Close()
 
-	_, err = s.codeService.RegenerateApplicantActivationCode(ctx, uow, applicant)
+	activationCode, err := s.codeService.RegenerateApplicantActivationCode(ctx, uow, applicant)
 	if err != nil {
 		var cve *code.CodeValidationError
 		if errors.As(err, &cve) {
@@ -123,6 +190,10 @@ func (s *service) GetNewApplicantActivationCode(ctx context.Context, req *pb.Get
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
+	if err := s.notificationService.SendApplicantActivationCode(ctx, applicant, activationCode); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
 	l.Infow("auth.get_new_activation_code.success")
 
 	return &pb.GetNewApplicantActivationCodeResponse{}, nil
@@ -144,16 +215,23 @@ func (s *service) ActivateApplicant(ctx context.Context, req *pb.ActivateApplica
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
+	account := strconv.FormatInt(applicant.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantActivation, account); err != nil {
+		return nil, err
+	}
+
 	valid, err := s.codeService.CheckApplicantActivationCode(ctx, uow, applicant, req.Code)
 	if err != nil {
 		var cve *code.CodeValidationError
 		if errors.As(err, &cve) {
-			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantActivation, account,
+				status.Errorf(codes.InvalidArgument, "%s", err.Error()))
 		}
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 	if !valid {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid code")
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantActivation, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
 	}
 
 	applicant, err = s.userService.ActivateApplicant(ctx, applicant)
@@ -161,7 +239,15 @@ func (s *service) ActivateApplicant(ctx context.Context, req *pb.ActivateApplica
 		return nil, err
 	}
 
-	// invalidate all refresh tokens
+	if err := s.tokenService.InvalidateAllApplicant(ctx, uow, applicant.Id); err != nil {
+		l.Errorw("auth.activate_applicant_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if _, err := s.tokenService.BumpApplicantVersion(ctx, uow, applicant.Id); err != nil {
+		l.Errorw("auth.activate_applicant_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
 
 	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
 		return nil, err
@@ -172,6 +258,8 @@ func (s *service) ActivateApplicant(ctx context.Context, req *pb.ActivateApplica
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantActivation, account)
+
 	l.Infow("auth.activate_applicant.success")
 	return &pb.ActivateApplicantResponse{Applicant: applicant}, nil
 }
@@ -179,12 +267,17 @@ func (s *service) ActivateApplicant(ctx context.Context, req *pb.ActivateApplica
 func (s *service) LoginApplicant(ctx context.Context, req *pb.LoginApplicantRequest) (*pb.LoginApplicantResponse, error) {
 	l := s.log.With("op", "login_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantLogin, req.Email); err != nil {
+		return nil, err
+	}
+
 	applicant, err := s.userService.GetApplicantByEmail(ctx, req.Email)
 	if err != nil {
 		return nil, err
 	}
 	if applicant == nil || applicant.IsDeleted {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid email or password")
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantLogin, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or password"))
 	}
 
 	uow := uow.New(s.postgresClient)
@@ -195,7 +288,25 @@ func (s *service) LoginApplicant(ctx context.Context, req *pb.LoginApplicantRequ
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 	if !valid {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid email or password")
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantLogin, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or password"))
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantLogin, req.Email)
+
+	mfaEnabled, err := s.mfaService.IsApplicantEnabled(ctx, uow, applicant)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if mfaEnabled {
+		// no tokens until the second factor is verified with VerifyApplicantMfa
+		mfaToken, err := s.mfaService.CreateApplicantChallenge(ctx, applicant)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+
+		l.Infow("auth.login_applicant.mfa_required")
+		return &pb.LoginApplicantResponse{MfaRequired: true, MfaToken: mfaToken}, nil
 	}
 
 	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
@@ -206,6 +317,273 @@ func (s *service) LoginApplicant(ctx context.Context, req *pb.LoginApplicantRequ
 	return &pb.LoginApplicantResponse{Applicant: applicant}, nil
 }
 
+func (s *service) RequestApplicantLoginCode(ctx context.Context, req *pb.RequestApplicantLoginCodeRequest) (*pb.RequestApplicantLoginCodeResponse, error) {
+	l := s.log.With("op", "request_applicant_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	applicant, err := s.userService.GetApplicantByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if applicant == nil || applicant.IsDeleted {
+		return &pb.RequestApplicantLoginCodeResponse{}, nil
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	// Every outcome past this point answers like an unknown email does, so the
+	// endpoint cannot be used to find out which addresses have accounts.
+	loginCode, err := s.codeService.RegenerateApplicantLoginCode(ctx, uow, applicant)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if !errors.As(err, &cve) {
+			l.Errorw("auth.request_applicant_login_code_failed", "err", err)
+		}
+		return &pb.RequestApplicantLoginCodeResponse{}, nil
+	}
+
+	s.notificationService.QueueApplicantLoginCode(ctx, applicant, loginCode)
+
+	l.Infow("auth.request_applicant_login_code.success")
+	return &pb.RequestApplicantLoginCodeResponse{}, nil
+}
+
+// LoginApplicantWithCode is the passwordless login. Failed codes are counted with
+// the activation attempts, both being six digit codes sent by email. The attempts
+// are keyed by the email and every failure answers alike, so known and unknown
+// addresses cannot be told apart.
+func (s *service) LoginApplicantWithCode(ctx context.Context, req *pb.LoginApplicantWithCodeRequest) (*pb.LoginApplicantWithCodeResponse, error) {
+	l := s.log.With("op", "login_applicant_with_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantActivation, req.Email); err != nil {
+		return nil, err
+	}
+
+	applicant, err := s.userService.GetApplicantByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if applicant == nil || applicant.IsDeleted {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantActivation, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or code"))
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.login_applicant_with_code_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.codeService.CheckApplicantLoginCode(ctx, uow, applicant, req.Code)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantActivation, req.Email,
+				status.Errorf(codes.Unauthenticated, "invalid email or code"))
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantActivation, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or code"))
+	}
+
+	mfaEnabled, err := s.mfaService.IsApplicantEnabled(ctx, uow, applicant)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !mfaEnabled {
+		if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.login_applicant_with_code_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantActivation, req.Email)
+
+	if mfaEnabled {
+		// the code replaces the password only, the second factor is still required
+		mfaToken, err := s.mfaService.CreateApplicantChallenge(ctx, applicant)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+
+		l.Infow("auth.login_applicant_with_code.mfa_required")
+		return &pb.LoginApplicantWithCodeResponse{MfaRequired: true, MfaToken: mfaToken}, nil
+	}
+
+	l.Infow("auth.login_applicant_with_code.success")
+	return &pb.LoginApplicantWithCodeResponse{Applicant: applicant}, nil
+}
+
+func (s *service) StartApplicantOidcLogin(ctx context.Context, req *pb.StartApplicantOidcLoginRequest) (*pb.StartApplicantOidcLoginResponse, error) {
+	l := s.log.With("op", "start_applicant_oidc_login", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	authURL, err := s.oidcService.StartApplicant(ctx)
+	if err != nil {
+		return nil, oidcError(err)
+	}
+
+	l.Infow("auth.start_applicant_oidc_login.success")
+	return &pb.StartApplicantOidcLoginResponse{AuthorizationUrl: authURL}, nil
+}
+
+// CompleteApplicantOidcLogin logs in the applicant linked to the provider account.
+// An unlinked account is linked to the applicant with the same verified email,
+// unless that applicant never activated the account: whoever registered it did
+// not prove to own the email, so it must not be taken over by the provider login.
+func (s *service) CompleteApplicantOidcLogin(ctx context.Context, req *pb.CompleteApplicantOidcLoginRequest) (*pb.CompleteApplicantOidcLoginResponse, error) {
+	l := s.log.With("op", "complete_applicant_oidc_login", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	profile, err := s.oidcService.CompleteApplicant(ctx, req.State, req.Code)
+	if err != nil {
+		return nil, oidcError(err)
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.complete_applicant_oidc_login_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	identity, err := s.oidcService.GetApplicantIdentity(ctx, uow, profile)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	var applicant *userv1.Applicant
+	if identity != nil {
+		applicant, err = s.userService.GetApplicantById(ctx, identity.UserId())
+		if err != nil {
+			return nil, err
+		}
+		if applicant == nil || applicant.IsDeleted {
+			return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+		}
+	} else {
+		if err := checkOidcProfileLinkable(profile); err != nil {
+			return nil, err
+		}
+
+		applicant, err = s.userService.GetApplicantByEmail(ctx, profile.Email)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkApplicantOidcLinkable(applicant); err != nil {
+			return nil, err
+		}
+
+		if applicant == nil {
+			registrationToken, err := s.oidcService.CreateApplicantRegistration(ctx, profile)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "internal server error")
+			}
+
+			l.Infow("auth.complete_applicant_oidc_login.registration_required")
+			return &pb.CompleteApplicantOidcLoginResponse{RegistrationRequired: true, RegistrationToken: registrationToken}, nil
+		}
+
+		if _, err := s.oidcService.LinkApplicant(ctx, uow, applicant, profile); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+	}
+
+	mfaEnabled, err := s.mfaService.IsApplicantEnabled(ctx, uow, applicant)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !mfaEnabled {
+		if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.complete_applicant_oidc_login_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if mfaEnabled {
+		// the provider login replaces the password only, the second factor is still required
+		mfaToken, err := s.mfaService.CreateApplicantChallenge(ctx, applicant)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+
+		l.Infow("auth.complete_applicant_oidc_login.mfa_required")
+		return &pb.CompleteApplicantOidcLoginResponse{MfaRequired: true, MfaToken: mfaToken}, nil
+	}
+
+	l.Infow("auth.complete_applicant_oidc_login.success")
+	return &pb.CompleteApplicantOidcLoginResponse{Applicant: applicant}, nil
+}
+
+// RegisterApplicantWithOidc creates the applicant of a provider account without one.
+// The email is verified by the provider, so the applicant is activated right away.
+// The account gets a random password, a real one can be set with the password reset.
+func (s *service) RegisterApplicantWithOidc(ctx context.Context, req *pb.RegisterApplicantWithOidcRequest) (*pb.RegisterApplicantWithOidcResponse, error) {
+	l := s.log.With("op", "register_applicant_with_oidc", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	profile, err := s.oidcService.GetApplicantRegistration(ctx, req.RegistrationToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if profile == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired registration token")
+	}
+
+	newApplicant := req.Applicant
+	if newApplicant == nil {
+		newApplicant = &userv1.Applicant{}
+	}
+	newApplicant.Email = profile.Email
+	if newApplicant.FirstName == "" {
+		newApplicant.FirstName = profile.GivenName
+	}
+	if newApplicant.LastName == "" {
+		newApplicant.LastName = profile.FamilyName
+	}
+	if newApplicant.BirthDate == "" {
+		newApplicant.BirthDate = oidcBirthDate(profile.Birthdate)
+	}
+
+	saga, err := s.registrationService.StartApplicant(ctx, newApplicant.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	applicant, err := s.userService.CreateApplicant(ctx, newApplicant)
+	if err != nil {
+		// the applicant may have been created, the open saga is left to the reconciliation
+		if !outcomeUnknown(err) {
+			s.registrationService.AbortApplicant(ctx, saga, err)
+		}
+		return nil, err
+	}
+
+	applicant, err = s.storeApplicantOidcCredentials(ctx, saga, applicant, profile)
+	if err != nil {
+		s.registrationService.CompensateApplicant(context.WithoutCancel(ctx), saga, applicant)
+		return nil, err
+	}
+
+	// the registration cannot be reused anyway, the email is taken now
+	if err := s.oidcService.DeleteApplicantRegistration(ctx, req.RegistrationToken); err != nil {
+		l.Warnw("auth.register_applicant_with_oidc.delete_registration_failed", "err", err)
+	}
+
+	l.Infow("auth.register_applicant_with_oidc.success")
+	return &pb.RegisterApplicantWithOidcResponse{Applicant: applicant}, nil
+}
+
 func (s *service) RefreshApplicant(ctx context.Context, req *pb.RefreshApplicantRequest) (*pb.RefreshApplicantResponse, error) {
 	l := s.log.With("op", "login_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
@@ -224,6 +602,9 @@ func (s *service) RefreshApplicant(ctx context.Context, req *pb.RefreshApplicant
 
 	refreshToken, err := s.tokenService.ValidateApplicantRefreshToken(ctx, uow, refreshTokenStr[0])
 	if err != nil {
+		if errors.Is(err, tokenservice.ErrTokenReused) {
+			s.clearTokens(ctx)
+		}
 		if errors.Is(err, claims.ErrInvalidToken) {
 			return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
 		}
@@ -263,6 +644,9 @@ func (s *service) LogoutApplicant(ctx context.Context, req *pb.LogoutApplicantRe
 	defer uow.Close()
 
 	s.tokenService.InvalidateApplicant(ctx, uow, refreshTokenStr[0])
+	if accessTokenStr := getAccessTokenFromMetadata(ctx); accessTokenStr != "" {
+		s.tokenService.RevokeApplicantAccessToken(ctx, accessTokenStr)
+	}
 
 	s.clearTokens(ctx)
 	l.Infow("auth.logout_applicant.success")
@@ -283,15 +667,19 @@ func (s *service) GetResetApplicantPasswordCode(ctx context.Context, req *pb.Get
 	uow := uow.New(s.postgresClient)
 	defer uow.Close()
 
-	_, err = s.codeService.RegenerateApplicantResetPasswordCode(ctx, uow, applicant)
+	// Every outcome past this point answers like an unknown email does, so the
+	// endpoint cannot be used to find out which addresses have accounts.
+	resetCode, err := s.codeService.RegenerateApplicantResetPasswordCode(ctx, uow, applicant)
 	if err != nil {
 		var cve *code.CodeValidationError
-		if errors.As(err, &cve) {
-			return &pb.GetResetApplicantPasswordCodeResponse{}, nil
+		if !errors.As(err, &cve) {
+			l.Errorw("auth.get_reset_applicant_password_code_failed", "err", err)
 		}
-		return nil, status.Errorf(codes.Internal, "internal server error")
+		return &pb.GetResetApplicantPasswordCodeResponse{}, nil
 	}
 
+	s.notificationService.QueueApplicantResetPasswordCode(ctx, applicant, resetCode)
+
 	l.Infow("auth.get_reset_applicant_password_code.success")
 	return &pb.GetResetApplicantPasswordCodeResponse{}, nil
 }
@@ -299,12 +687,17 @@ func (s *service) GetResetApplicantPasswordCode(ctx context.Context, req *pb.Get
 func (s *service) ResetApplicantPassword(ctx context.Context, req *pb.ResetApplicantPasswordRequest) (*pb.ResetApplicantPasswordResponse, error) {
 	l := s.log.With("op", "reset_applicant_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantResetPassword, req.Email); err != nil {
+		return nil, err
+	}
+
 	applicant, err := s.userService.GetApplicantByEmail(ctx, req.Email)
 	if err != nil {
 		return nil, err
 	}
 	if applicant == nil || applicant.IsDeleted {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid email or code")
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantResetPassword, req.Email,
+			status.Errorf(codes.InvalidArgument, "invalid email or code"))
 	}
 
 	uow := uow.New(s.postgresClient)
@@ -319,12 +712,14 @@ func (s *service) ResetApplicantPassword(ctx context.Context, req *pb.ResetAppli
 	if err != nil {
 		var cve *code.CodeValidationError
 		if errors.As(err, &cve) {
-			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantResetPassword, req.Email,
+				status.Errorf(codes.InvalidArgument, "%s", err.Error()))
 		}
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 	if !valid {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid email or code")
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantResetPassword, req.Email,
+			status.Errorf(codes.InvalidArgument, "invalid email or code"))
 	}
 
 	_, err = s.passwordService.UpdateApplicantPassword(ctx, uow, applicant, req.NewPassword)
@@ -336,7 +731,10 @@ func (s *service) ResetApplicantPassword(ctx context.Context, req *pb.ResetAppli
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
-	// invalidate all active tokens
+	if err := s.tokenService.InvalidateAllApplicant(ctx, uow, applicant.Id); err != nil {
+		l.Errorw("auth.reset_applicant_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
 
 	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
 		return nil, err
@@ -347,6 +745,8 @@ func (s *service) ResetApplicantPassword(ctx context.Context, req *pb.ResetAppli
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantResetPassword, req.Email)
+
 	l.Infow("auth.reset_applicant_password_failed.success")
 	return &pb.ResetApplicantPasswordResponse{Applicant: applicant}, nil
 }
@@ -373,6 +773,11 @@ func (s *service) ChangeApplicantPassword(ctx context.Context, req *pb.ChangeApp
 
 	uow := uow.New(s.postgresClient)
 	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.change_applicant_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
 
 	valid, err := s.passwordService.CheckApplicantPassword(ctx, uow, applicant, req.OldPassword)
 	if err != nil {
@@ -391,7 +796,10 @@ func (s *service) ChangeApplicantPassword(ctx context.Context, req *pb.ChangeApp
 		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
-	// invalidate all active tokens
+	if err := s.tokenService.InvalidateAllApplicant(ctx, uow, applicant.Id); err != nil {
+		l.Errorw("auth.change_applicant_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
 
 	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
 		return nil, err
@@ -406,41 +814,1814 @@ func (s *service) ChangeApplicantPassword(ctx context.Context, req *pb.ChangeApp
 	return &pb.ChangeApplicantPasswordResponse{}, nil
 }
 
-func (s *service) generateApplicantTokens(ctx context.Context, uow *uow.UnitOfWork, applicant *userv1.Applicant, existedRefreshToken *token.Token) error {
-	access, refresh, err := s.tokenService.GenerateApplicant(ctx, uow, applicant, existedRefreshToken)
+func (s *service) ListApplicantSessions(ctx context.Context, req *pb.ListApplicantSessionsRequest) (*pb.ListApplicantSessionsResponse, error) {
+	l := s.log.With("op", "list_applicant_sessions", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	claims, _ := ctxmetadata.GetApplicantClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	tokens, err := s.tokenService.ListApplicant(ctx, uow, claims.Id)
 	if err != nil {
-		return status.Errorf(codes.Internal, "internal server error")
+		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
 
-	trailer := metadata.Pairs(
-		"x-access-token", access.Token(),
-		"x-refresh-token", refresh.Token(),
-	)
+	current := getRefreshTokenFromMetadata(ctx)
+	sessions := make([]*pb.Session, 0, len(tokens))
+	for _, t := range tokens {
+		sessions = append(sessions, toPbSession(t, s.tokenService.CheckToken(t, current)))
+	}
 
-	grpc.SetTrailer(ctx, trailer)
-	return nil
+	l.Infow("auth.list_applicant_sessions.success", "applicant_id", claims.Id)
+	return &pb.ListApplicantSessionsResponse{Sessions: sessions}, nil
 }
 
-func (s *service) getAndCheckApplicantForActivation(ctx context.Context) (*userv1.Applicant, error) {
+func (s *service) RevokeApplicantSession(ctx context.Context, req *pb.RevokeApplicantSessionRequest) (*pb.RevokeApplicantSessionResponse, error) {
+	l := s.log.With("op", "revoke_applicant_session", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
 	claims, _ := ctxmetadata.GetApplicantClaimsFromContext(ctx)
 	if claims == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
 	}
-	if claims.IsActive {
-		return nil, status.Errorf(codes.AlreadyExists, "applicant is already activated")
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	revoked, err := s.tokenService.InvalidateApplicantById(ctx, uow, claims.Id, req.SessionId)
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrTokenNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
 	}
-	if claims.IsDeleted {
-		return nil, status.Errorf(codes.PermissionDenied, "applicant is deleted")
+
+	if s.tokenService.CheckToken(revoked, getRefreshTokenFromMetadata(ctx)) {
+		s.clearTokens(ctx)
 	}
 
-	applicant, err := s.userService.GetApplicantById(ctx, claims.Id)
-	if err != nil {
-		return nil, err
+	l.Infow("auth.revoke_applicant_session.success", "applicant_id", claims.Id, "session_id", req.SessionId)
+	return &pb.RevokeApplicantSessionResponse{}, nil
+}
+
+func (s *service) RevokeOtherApplicantSessions(ctx context.Context, req *pb.RevokeOtherApplicantSessionsRequest) (*pb.RevokeOtherApplicantSessionsResponse, error) {
+	l := s.log.With("op", "revoke_other_applicant_sessions", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	cl, _ := ctxmetadata.GetApplicantClaimsFromContext(ctx)
+	if cl == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
 	}
-	if applicant == nil {
+
+	refreshTokenStr := getRefreshTokenFromMetadata(ctx)
+	if refreshTokenStr == "" {
 		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
 	}
-	if applicant.IsActive {
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	current, err := s.tokenService.ValidateApplicantRefreshToken(ctx, uow, refreshTokenStr)
+	if err != nil {
+		if errors.Is(err, claims.ErrInvalidToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if current.UserId() != cl.Id {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.tokenService.InvalidateOtherApplicant(ctx, uow, cl.Id, current.Id()); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.revoke_other_applicant_sessions.success", "applicant_id", cl.Id)
+	return &pb.RevokeOtherApplicantSessionsResponse{}, nil
+}
+
+func (s *service) RequestApplicantEmailChange(ctx context.Context, req *pb.RequestApplicantEmailChangeRequest) (*pb.RequestApplicantEmailChangeResponse, error) {
+	l := s.log.With("op", "request_applicant_email_change", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	applicant, err := s.getAuthorizedApplicant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(applicant.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantEmailChange, account); err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(applicant.Email, req.NewEmail) {
+		return nil, status.Errorf(codes.InvalidArgument, "new email is the same as the current one")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.request_applicant_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckApplicantPassword(ctx, uow, applicant, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantEmailChange, account,
+			status.Errorf(codes.InvalidArgument, "invalid password"))
+	}
+
+	existed, err := s.userService.GetApplicantByEmail(ctx, req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	if existed != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "email is already in use")
+	}
+
+	emailChangeCode, err := s.codeService.CreateApplicantEmailChangeCode(ctx, uow, applicant, req.NewEmail)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.request_applicant_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.notificationService.SendApplicantEmailChangeCode(ctx, applicant, emailChangeCode); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.request_applicant_email_change.success", "applicant_id", applicant.Id)
+	return &pb.RequestApplicantEmailChangeResponse{}, nil
+}
+
+// ConfirmApplicantEmailChange applies the address the code was sent to. The email is
+// the login identity, so every session is revoked and only the current client
+// receives new tokens.
+func (s *service) ConfirmApplicantEmailChange(ctx context.Context, req *pb.ConfirmApplicantEmailChangeRequest) (*pb.ConfirmApplicantEmailChangeResponse, error) {
+	l := s.log.With("op", "confirm_applicant_email_change", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	applicant, err := s.getAuthorizedApplicant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(applicant.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantEmailChange, account); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.confirm_applicant_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	newEmail, valid, err := s.codeService.CheckApplicantEmailChangeCode(ctx, uow, applicant, req.Code)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantEmailChange, account,
+				status.Errorf(codes.InvalidArgument, "%s", err.Error()))
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.ApplicantEmailChange, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	applicant, err = s.userService.ChangeApplicantEmail(ctx, applicant, newEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tokenService.InvalidateAllApplicant(ctx, uow, applicant.Id); err != nil {
+		l.Errorw("auth.confirm_applicant_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.confirm_applicant_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantEmailChange, account)
+
+	l.Infow("auth.confirm_applicant_email_change.success", "applicant_id", applicant.Id)
+	return &pb.ConfirmApplicantEmailChangeResponse{Applicant: applicant}, nil
+}
+
+// DeleteApplicantAccount closes the account in both services. The auth data is marked
+// deleted in a transaction that is committed only after user-service deleted the applicant,
+// so a failed delete leaves nothing behind. The password and the mfa are kept
+// until the grace period ends so the account can be restored. When the outcome of the delete is
+// unknown or the commit fails, the applicant is restored in user-service.
+func (s *service) DeleteApplicantAccount(ctx context.Context, req *pb.DeleteApplicantAccountRequest) (*pb.DeleteApplicantAccountResponse, error) {
+	l := s.log.With("op", "delete_applicant_account", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	applicant, err := s.getAuthorizedApplicant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a wrong password counts as a failed login, both guess the same secret
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantLogin, applicant.Email); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.delete_applicant_account_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckApplicantPassword(ctx, uow, applicant, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantLogin, applicant.Email,
+			status.Errorf(codes.InvalidArgument, "invalid password"))
+	}
+
+	if err := s.codeService.DeleteApplicantCodes(ctx, uow, applicant); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	// the credentials stay until the grace period ends, see RestoreApplicantAccount
+	if err := s.retentionService.MarkApplicantDeleted(ctx, uow, applicant); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	// also denies the access tokens issued so far
+	if err := s.tokenService.InvalidateAllApplicant(ctx, uow, applicant.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if _, err := s.userService.DeleteApplicant(ctx, applicant); err != nil {
+		if outcomeUnknown(err) {
+			s.restoreApplicant(context.WithoutCancel(ctx), applicant)
+		}
+		return nil, err
+	}
+
+	// the applicant is deleted already, a cancelled request must not abort the commit
+	commitCtx := context.WithoutCancel(ctx)
+	if err := uow.Commit(commitCtx); err != nil {
+		l.Errorw("auth.delete_applicant_account_failed", "err", err)
+		s.restoreApplicant(commitCtx, applicant)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantLogin, applicant.Email)
+	s.clearTokens(ctx)
+
+	l.Infow("auth.delete_applicant_account.success", "applicant_id", applicant.Id)
+	return &pb.DeleteApplicantAccountResponse{}, nil
+}
+
+// RestoreApplicantAccount brings back the account deleted within the grace period.
+// Several deleted accounts may share the email, the password picks the one to
+// restore. No tokens are issued, the applicant logs in afterwards.
+func (s *service) RestoreApplicantAccount(ctx context.Context, req *pb.RestoreApplicantAccountRequest) (*pb.RestoreApplicantAccountResponse, error) {
+	l := s.log.With("op", "restore_applicant_account", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	// a wrong password counts as a failed login, both guess the same secret
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantLogin, req.Email); err != nil {
+		return nil, err
+	}
+
+	applicants, err := s.userService.GetRestorableApplicantsByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, applicant := range applicants {
+		restored, err := s.restoreApplicantAccount(ctx, applicant, req.Password)
+		if err != nil {
+			return nil, err
+		}
+		if restored != nil {
+			s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantLogin, req.Email)
+
+			l.Infow("auth.restore_applicant_account.success", "applicant_id", restored.Id)
+			return &pb.RestoreApplicantAccountResponse{Applicant: restored}, nil
+		}
+	}
+
+	return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantLogin, req.Email,
+		status.Errorf(codes.InvalidArgument, "invalid email or password"))
+}
+
+// restoreApplicantAccount returns nil when the password does not match or the
+// credentials are purged already. The deletion mark is removed in a transaction
+// committed only after user-service restored the applicant, so the purge cannot
+// remove the credentials in between.
+func (s *service) restoreApplicantAccount(ctx context.Context, applicant *userv1.Applicant, password string) (*userv1.Applicant, error) {
+	l := s.log.With("op", "restore_applicant_account", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err := uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.restore_applicant_account_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.retentionService.UnmarkApplicantDeleted(ctx, uow, applicant); err != nil {
+		if errors.Is(err, retentionservice.ErrCredentialsPurged) {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckApplicantPassword(ctx, uow, applicant, password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, nil
+	}
+
+	restored, err := s.userService.RestoreApplicant(ctx, applicant)
+	if err != nil {
+		return nil, err
+	}
+
+	// the applicant is restored already, a cancelled request must not abort the commit.
+	// A failed commit leaves the mark behind, the purge keeps the credentials of
+	// the restored applicant and drops the mark
+	if err := uow.Commit(context.WithoutCancel(ctx)); err != nil {
+		l.Errorw("auth.restore_applicant_account_failed", "err", err)
+	}
+	return restored, nil
+}
+
+func (s *service) EnrollApplicantMfa(ctx context.Context, req *pb.EnrollApplicantMfaRequest) (*pb.EnrollApplicantMfaResponse, error) {
+	l := s.log.With("op", "enroll_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	applicant, err := s.getAuthorizedApplicant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	mfa, err := s.mfaService.EnrollApplicant(ctx, uow, applicant)
+	if err != nil {
+		if errors.Is(err, mfaservice.ErrMfaAlreadyEnabled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "mfa is already enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.enroll_applicant_mfa.success", "applicant_id", applicant.Id)
+	return &pb.EnrollApplicantMfaResponse{
+		Secret:     mfa.Secret(),
+		OtpauthUri: s.mfaService.ProvisioningURI(mfa, applicant.Email),
+	}, nil
+}
+
+func (s *service) ConfirmApplicantMfa(ctx context.Context, req *pb.ConfirmApplicantMfaRequest) (*pb.ConfirmApplicantMfaResponse, error) {
+	l := s.log.With("op", "confirm_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	applicant, err := s.getAuthorizedApplicant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(applicant.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantMfa, account); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	recoveryCodes, err := s.mfaService.ConfirmApplicant(ctx, uow, applicant, req.Code)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	if recoveryCodes == nil {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantMfa, account)
+
+	l.Infow("auth.confirm_applicant_mfa.success", "applicant_id", applicant.Id)
+	return &pb.ConfirmApplicantMfaResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *service) DisableApplicantMfa(ctx context.Context, req *pb.DisableApplicantMfaRequest) (*pb.DisableApplicantMfaResponse, error) {
+	l := s.log.With("op", "disable_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	applicant, err := s.getAuthorizedApplicant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(applicant.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantMfa, account); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.disable_applicant_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckApplicantPassword(ctx, uow, applicant, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid password"))
+	}
+
+	disabled, err := s.mfaService.DisableApplicant(ctx, uow, applicant, req.Code)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	if !disabled {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.disable_applicant_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantMfa, account)
+
+	l.Infow("auth.disable_applicant_mfa.success", "applicant_id", applicant.Id)
+	return &pb.DisableApplicantMfaResponse{}, nil
+}
+
+func (s *service) VerifyApplicantMfa(ctx context.Context, req *pb.VerifyApplicantMfaRequest) (*pb.VerifyApplicantMfaResponse, error) {
+	l := s.log.With("op", "verify_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	userId, err := s.mfaService.GetApplicantChallenge(ctx, req.MfaToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if userId == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired mfa token")
+	}
+
+	account := strconv.FormatInt(userId, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.ApplicantMfa, account); err != nil {
+		return nil, err
+	}
+
+	applicant, err := s.userService.GetApplicantById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if applicant == nil || applicant.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.verify_applicant_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.mfaService.VerifyApplicant(ctx, uow, applicant, req.Code)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.ApplicantMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	if err := s.mfaService.DeleteApplicantChallenge(ctx, req.MfaToken); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.verify_applicant_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.ApplicantMfa, account)
+
+	l.Infow("auth.verify_applicant_mfa.success", "applicant_id", applicant.Id)
+	return &pb.VerifyApplicantMfaResponse{Applicant: applicant}, nil
+}
+
+func (s *service) BumpApplicantSecurityVersion(ctx context.Context, req *pb.BumpApplicantSecurityVersionRequest) (*pb.BumpApplicantSecurityVersionResponse, error) {
+	l := s.log.With("op", "bump_applicant_security_version", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if req.ApplicantId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid applicant id")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	version, err := s.tokenService.BumpApplicantVersion(ctx, uow, req.ApplicantId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.bump_applicant_security_version.success", "applicant_id", req.ApplicantId, "version", version)
+	return &pb.BumpApplicantSecurityVersionResponse{Version: int32(version)}, nil
+}
+
+func (s *service) RegisterEmployer(ctx context.Context, req *pb.RegisterEmployerRequest) (*pb.RegisterEmployerResponse, error) {
+	l := s.log.With("op", "register_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	saga, err := s.registrationService.StartEmployer(ctx, req.Employer.GetEmail())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	employer, err := s.userService.CreateEmployer(ctx, req.Employer)
+	if err != nil {
+		// the employer may have been created, the open saga is left to the reconciliation
+		if !outcomeUnknown(err) {
+			s.registrationService.AbortEmployer(ctx, saga, err)
+		}
+		return nil, err
+	}
+
+	activationCode, err := s.storeEmployerCredentials(ctx, saga, employer, req.Password)
+	if err != nil {
+		s.registrationService.CompensateEmployer(context.WithoutCancel(ctx), saga, employer)
+		return nil, err
+	}
+
+	// the account is already created, so a delivery failure is not fatal:
+	// the user can request a new activation code
+	if err := s.notificationService.SendEmployerActivationCode(ctx, employer, activationCode); err != nil {
+		l.Warnw("auth.register_employer.send_activation_code_failed", "err", err)
+	}
+
+	l.Infow("auth.register_employer.success")
+	return &pb.RegisterEmployerResponse{Employer: employer}, nil
+}
+
+func (s *service) GetNewEmployerActivationCode(ctx context.Context, req *pb.GetNewEmployerActivationCodeRequest) (*pb.GetNewEmployerActivationCodeResponse, error) {
+	l := s.log.With("op", "get_new_employer_activation_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAndCheckEmployerForActivation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	activationCode, err := s.codeService.RegenerateEmployerActivationCode(ctx, uow, employer)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.notificationService.SendEmployerActivationCode(ctx, employer, activationCode); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.get_new_activation_code.success")
+
+	return &pb.GetNewEmployerActivationCodeResponse{}, nil
+}
+
+func (s *service) ActivateEmployer(ctx context.Context, req *pb.ActivateEmployerRequest) (*pb.ActivateEmployerResponse, error) {
+	l := s.log.With("op", "activate_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAndCheckEmployerForActivation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.activate_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	account := strconv.FormatInt(employer.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerActivation, account); err != nil {
+		return nil, err
+	}
+
+	valid, err := s.codeService.CheckEmployerActivationCode(ctx, uow, employer, req.Code)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerActivation, account,
+				status.Errorf(codes.InvalidArgument, "%s", err.Error()))
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerActivation, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	employer, err = s.userService.ActivateEmployer(ctx, employer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tokenService.InvalidateAllEmployer(ctx, uow, employer.Id); err != nil {
+		l.Errorw("auth.activate_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if _, err := s.tokenService.BumpEmployerVersion(ctx, uow, employer.Id); err != nil {
+		l.Errorw("auth.activate_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.activate_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerActivation, account)
+
+	l.Infow("auth.activate_employer.success")
+	return &pb.ActivateEmployerResponse{Employer: employer}, nil
+}
+
+func (s *service) LoginEmployer(ctx context.Context, req *pb.LoginEmployerRequest) (*pb.LoginEmployerResponse, error) {
+	l := s.log.With("op", "login_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerLogin, req.Email); err != nil {
+		return nil, err
+	}
+
+	employer, err := s.userService.GetEmployerByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerLogin, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or password"))
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	valid, err := s.passwordService.CheckEmployerPassword(ctx, uow, employer, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerLogin, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or password"))
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerLogin, req.Email)
+
+	mfaEnabled, err := s.mfaService.IsEmployerEnabled(ctx, uow, employer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if mfaEnabled {
+		// no tokens until the second factor is verified with VerifyEmployerMfa
+		mfaToken, err := s.mfaService.CreateEmployerChallenge(ctx, employer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+
+		l.Infow("auth.login_employer.mfa_required")
+		return &pb.LoginEmployerResponse{MfaRequired: true, MfaToken: mfaToken}, nil
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+		return nil, err
+	}
+
+	l.Infow("auth.login_employer.success")
+	return &pb.LoginEmployerResponse{Employer: employer}, nil
+}
+
+func (s *service) RequestEmployerLoginCode(ctx context.Context, req *pb.RequestEmployerLoginCodeRequest) (*pb.RequestEmployerLoginCodeResponse, error) {
+	l := s.log.With("op", "request_employer_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.userService.GetEmployerByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return &pb.RequestEmployerLoginCodeResponse{}, nil
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	// Every outcome past this point answers like an unknown email does, so the
+	// endpoint cannot be used to find out which addresses have accounts.
+	loginCode, err := s.codeService.RegenerateEmployerLoginCode(ctx, uow, employer)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if !errors.As(err, &cve) {
+			l.Errorw("auth.request_employer_login_code_failed", "err", err)
+		}
+		return &pb.RequestEmployerLoginCodeResponse{}, nil
+	}
+
+	s.notificationService.QueueEmployerLoginCode(ctx, employer, loginCode)
+
+	l.Infow("auth.request_employer_login_code.success")
+	return &pb.RequestEmployerLoginCodeResponse{}, nil
+}
+
+// LoginEmployerWithCode is the passwordless login. Failed codes are counted with
+// the activation attempts, both being six digit codes sent by email. The attempts
+// are keyed by the email and every failure answers alike, so known and unknown
+// addresses cannot be told apart.
+func (s *service) LoginEmployerWithCode(ctx context.Context, req *pb.LoginEmployerWithCodeRequest) (*pb.LoginEmployerWithCodeResponse, error) {
+	l := s.log.With("op", "login_employer_with_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerActivation, req.Email); err != nil {
+		return nil, err
+	}
+
+	employer, err := s.userService.GetEmployerByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerActivation, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or code"))
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.login_employer_with_code_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.codeService.CheckEmployerLoginCode(ctx, uow, employer, req.Code)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerActivation, req.Email,
+				status.Errorf(codes.Unauthenticated, "invalid email or code"))
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerActivation, req.Email,
+			status.Errorf(codes.Unauthenticated, "invalid email or code"))
+	}
+
+	mfaEnabled, err := s.mfaService.IsEmployerEnabled(ctx, uow, employer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !mfaEnabled {
+		if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.login_employer_with_code_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerActivation, req.Email)
+
+	if mfaEnabled {
+		// the code replaces the password only, the second factor is still required
+		mfaToken, err := s.mfaService.CreateEmployerChallenge(ctx, employer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+
+		l.Infow("auth.login_employer_with_code.mfa_required")
+		return &pb.LoginEmployerWithCodeResponse{MfaRequired: true, MfaToken: mfaToken}, nil
+	}
+
+	l.Infow("auth.login_employer_with_code.success")
+	return &pb.LoginEmployerWithCodeResponse{Employer: employer}, nil
+}
+
+func (s *service) RefreshEmployer(ctx context.Context, req *pb.RefreshEmployerRequest) (*pb.RefreshEmployerResponse, error) {
+	l := s.log.With("op", "refresh_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	refreshTokenStr := md.Get("x-refresh-token")
+	if len(refreshTokenStr) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	refreshToken, err := s.tokenService.ValidateEmployerRefreshToken(ctx, uow, refreshTokenStr[0])
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrTokenReused) {
+			s.clearTokens(ctx)
+		}
+		if errors.Is(err, claims.ErrInvalidToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	employer, err := s.userService.GetEmployerById(ctx, refreshToken.UserId())
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, refreshToken); err != nil {
+		return nil, err
+	}
+
+	l.Infow("auth.refresh_employer.success")
+	return &pb.RefreshEmployerResponse{}, nil
+}
+
+func (s *service) LogoutEmployer(ctx context.Context, req *pb.LogoutEmployerRequest) (*pb.LogoutEmployerResponse, error) {
+	l := s.log.With("op", "logout_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	refreshTokenStr := md.Get("x-refresh-token")
+	if len(refreshTokenStr) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	s.tokenService.InvalidateEmployer(ctx, uow, refreshTokenStr[0])
+	if accessTokenStr := getAccessTokenFromMetadata(ctx); accessTokenStr != "" {
+		s.tokenService.RevokeEmployerAccessToken(ctx, accessTokenStr)
+	}
+
+	s.clearTokens(ctx)
+	l.Infow("auth.logout_employer.success")
+	return &pb.LogoutEmployerResponse{}, nil
+}
+
+func (s *service) GetResetEmployerPasswordCode(ctx context.Context, req *pb.GetResetEmployerPasswordCodeRequest) (*pb.GetResetEmployerPasswordCodeResponse, error) {
+	l := s.log.With("op", "get_reset_employer_password_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.userService.GetEmployerByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return &pb.GetResetEmployerPasswordCodeResponse{}, nil
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	// Every outcome past this point answers like an unknown email does, so the
+	// endpoint cannot be used to find out which addresses have accounts.
+	resetCode, err := s.codeService.RegenerateEmployerResetPasswordCode(ctx, uow, employer)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if !errors.As(err, &cve) {
+			l.Errorw("auth.get_reset_employer_password_code_failed", "err", err)
+		}
+		return &pb.GetResetEmployerPasswordCodeResponse{}, nil
+	}
+
+	s.notificationService.QueueEmployerResetPasswordCode(ctx, employer, resetCode)
+
+	l.Infow("auth.get_reset_employer_password_code.success")
+	return &pb.GetResetEmployerPasswordCodeResponse{}, nil
+}
+
+func (s *service) ResetEmployerPassword(ctx context.Context, req *pb.ResetEmployerPasswordRequest) (*pb.ResetEmployerPasswordResponse, error) {
+	l := s.log.With("op", "reset_employer_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerResetPassword, req.Email); err != nil {
+		return nil, err
+	}
+
+	employer, err := s.userService.GetEmployerByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerResetPassword, req.Email,
+			status.Errorf(codes.InvalidArgument, "invalid email or code"))
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.reset_employer_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.codeService.CheckEmployerResetPasswordCode(ctx, uow, employer, req.Code)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerResetPassword, req.Email,
+				status.Errorf(codes.InvalidArgument, "%s", err.Error()))
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerResetPassword, req.Email,
+			status.Errorf(codes.InvalidArgument, "invalid email or code"))
+	}
+
+	_, err = s.passwordService.UpdateEmployerPassword(ctx, uow, employer, req.NewPassword)
+	if err != nil {
+		var pve *password.PasswordValidationError
+		if errors.As(err, &pve) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.tokenService.InvalidateAllEmployer(ctx, uow, employer.Id); err != nil {
+		l.Errorw("auth.reset_employer_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.reset_employer_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerResetPassword, req.Email)
+
+	l.Infow("auth.reset_employer_password.success")
+	return &pb.ResetEmployerPasswordResponse{Employer: employer}, nil
+}
+
+func (s *service) ChangeEmployerPassword(ctx context.Context, req *pb.ChangeEmployerPasswordRequest) (*pb.ChangeEmployerPasswordResponse, error) {
+	l := s.log.With("op", "change_employer_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	claims, _ := ctxmetadata.GetEmployerClaimsFromContext(ctx)
+	if claims == nil || claims.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	employer, err := s.userService.GetEmployerById(ctx, claims.Id)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if req.OldPassword == req.NewPassword {
+		return nil, status.Errorf(codes.InvalidArgument, "old and new passwords are the same")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.change_employer_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckEmployerPassword(ctx, uow, employer, req.OldPassword)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid old password")
+	}
+
+	_, err = s.passwordService.UpdateEmployerPassword(ctx, uow, employer, req.NewPassword)
+	if err != nil {
+		var pve *password.PasswordValidationError
+		if errors.As(err, &pve) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.tokenService.InvalidateAllEmployer(ctx, uow, employer.Id); err != nil {
+		l.Errorw("auth.change_employer_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.change_employer_password_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.change_employer_password.success")
+	return &pb.ChangeEmployerPasswordResponse{}, nil
+}
+
+func (s *service) ListEmployerSessions(ctx context.Context, req *pb.ListEmployerSessionsRequest) (*pb.ListEmployerSessionsResponse, error) {
+	l := s.log.With("op", "list_employer_sessions", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	claims, _ := ctxmetadata.GetEmployerClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	tokens, err := s.tokenService.ListEmployer(ctx, uow, claims.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	current := getRefreshTokenFromMetadata(ctx)
+	sessions := make([]*pb.Session, 0, len(tokens))
+	for _, t := range tokens {
+		sessions = append(sessions, toPbSession(t, s.tokenService.CheckToken(t, current)))
+	}
+
+	l.Infow("auth.list_employer_sessions.success", "employer_id", claims.Id)
+	return &pb.ListEmployerSessionsResponse{Sessions: sessions}, nil
+}
+
+func (s *service) RevokeEmployerSession(ctx context.Context, req *pb.RevokeEmployerSessionRequest) (*pb.RevokeEmployerSessionResponse, error) {
+	l := s.log.With("op", "revoke_employer_session", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	claims, _ := ctxmetadata.GetEmployerClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	revoked, err := s.tokenService.InvalidateEmployerById(ctx, uow, claims.Id, req.SessionId)
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrTokenNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if s.tokenService.CheckToken(revoked, getRefreshTokenFromMetadata(ctx)) {
+		s.clearTokens(ctx)
+	}
+
+	l.Infow("auth.revoke_employer_session.success", "employer_id", claims.Id, "session_id", req.SessionId)
+	return &pb.RevokeEmployerSessionResponse{}, nil
+}
+
+func (s *service) RevokeOtherEmployerSessions(ctx context.Context, req *pb.RevokeOtherEmployerSessionsRequest) (*pb.RevokeOtherEmployerSessionsResponse, error) {
+	l := s.log.With("op", "revoke_other_employer_sessions", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	cl, _ := ctxmetadata.GetEmployerClaimsFromContext(ctx)
+	if cl == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	refreshTokenStr := getRefreshTokenFromMetadata(ctx)
+	if refreshTokenStr == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	current, err := s.tokenService.ValidateEmployerRefreshToken(ctx, uow, refreshTokenStr)
+	if err != nil {
+		if errors.Is(err, claims.ErrInvalidToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if current.UserId() != cl.Id {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.tokenService.InvalidateOtherEmployer(ctx, uow, cl.Id, current.Id()); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.revoke_other_employer_sessions.success", "employer_id", cl.Id)
+	return &pb.RevokeOtherEmployerSessionsResponse{}, nil
+}
+
+func (s *service) RequestEmployerEmailChange(ctx context.Context, req *pb.RequestEmployerEmailChangeRequest) (*pb.RequestEmployerEmailChangeResponse, error) {
+	l := s.log.With("op", "request_employer_email_change", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAuthorizedEmployer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(employer.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerEmailChange, account); err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(employer.Email, req.NewEmail) {
+		return nil, status.Errorf(codes.InvalidArgument, "new email is the same as the current one")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.request_employer_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckEmployerPassword(ctx, uow, employer, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerEmailChange, account,
+			status.Errorf(codes.InvalidArgument, "invalid password"))
+	}
+
+	existed, err := s.userService.GetEmployerByEmail(ctx, req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	if existed != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "email is already in use")
+	}
+
+	emailChangeCode, err := s.codeService.CreateEmployerEmailChangeCode(ctx, uow, employer, req.NewEmail)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.request_employer_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.notificationService.SendEmployerEmailChangeCode(ctx, employer, emailChangeCode); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.request_employer_email_change.success", "employer_id", employer.Id)
+	return &pb.RequestEmployerEmailChangeResponse{}, nil
+}
+
+// ConfirmEmployerEmailChange applies the address the code was sent to. The email is
+// the login identity, so every session is revoked and only the current client
+// receives new tokens.
+func (s *service) ConfirmEmployerEmailChange(ctx context.Context, req *pb.ConfirmEmployerEmailChangeRequest) (*pb.ConfirmEmployerEmailChangeResponse, error) {
+	l := s.log.With("op", "confirm_employer_email_change", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAuthorizedEmployer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(employer.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerEmailChange, account); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.confirm_employer_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	newEmail, valid, err := s.codeService.CheckEmployerEmailChangeCode(ctx, uow, employer, req.Code)
+	if err != nil {
+		var cve *code.CodeValidationError
+		if errors.As(err, &cve) {
+			return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerEmailChange, account,
+				status.Errorf(codes.InvalidArgument, "%s", err.Error()))
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectCode(ctx, uow, bruteforceservice.EmployerEmailChange, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	employer, err = s.userService.ChangeEmployerEmail(ctx, employer, newEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tokenService.InvalidateAllEmployer(ctx, uow, employer.Id); err != nil {
+		l.Errorw("auth.confirm_employer_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.confirm_employer_email_change_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerEmailChange, account)
+
+	l.Infow("auth.confirm_employer_email_change.success", "employer_id", employer.Id)
+	return &pb.ConfirmEmployerEmailChangeResponse{Employer: employer}, nil
+}
+
+// DeleteEmployerAccount closes the account in both services. The auth data is marked
+// deleted in a transaction that is committed only after user-service deleted the employer,
+// so a failed delete leaves nothing behind. The password and the mfa are kept
+// until the grace period ends so the account can be restored. When the outcome of the delete is
+// unknown or the commit fails, the employer is restored in user-service.
+func (s *service) DeleteEmployerAccount(ctx context.Context, req *pb.DeleteEmployerAccountRequest) (*pb.DeleteEmployerAccountResponse, error) {
+	l := s.log.With("op", "delete_employer_account", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAuthorizedEmployer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a wrong password counts as a failed login, both guess the same secret
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerLogin, employer.Email); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.delete_employer_account_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckEmployerPassword(ctx, uow, employer, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerLogin, employer.Email,
+			status.Errorf(codes.InvalidArgument, "invalid password"))
+	}
+
+	if err := s.codeService.DeleteEmployerCodes(ctx, uow, employer); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	// the credentials stay until the grace period ends, see RestoreEmployerAccount
+	if err := s.retentionService.MarkEmployerDeleted(ctx, uow, employer); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	// also denies the access tokens issued so far
+	if err := s.tokenService.InvalidateAllEmployer(ctx, uow, employer.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if _, err := s.userService.DeleteEmployer(ctx, employer); err != nil {
+		if outcomeUnknown(err) {
+			s.restoreEmployer(context.WithoutCancel(ctx), employer)
+		}
+		return nil, err
+	}
+
+	// the employer is deleted already, a cancelled request must not abort the commit
+	commitCtx := context.WithoutCancel(ctx)
+	if err := uow.Commit(commitCtx); err != nil {
+		l.Errorw("auth.delete_employer_account_failed", "err", err)
+		s.restoreEmployer(commitCtx, employer)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerLogin, employer.Email)
+	s.clearTokens(ctx)
+
+	l.Infow("auth.delete_employer_account.success", "employer_id", employer.Id)
+	return &pb.DeleteEmployerAccountResponse{}, nil
+}
+
+// RestoreEmployerAccount brings back the account deleted within the grace period.
+// Several deleted accounts may share the email, the password picks the one to
+// restore. No tokens are issued, the employer logs in afterwards.
+func (s *service) RestoreEmployerAccount(ctx context.Context, req *pb.RestoreEmployerAccountRequest) (*pb.RestoreEmployerAccountResponse, error) {
+	l := s.log.With("op", "restore_employer_account", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	// a wrong password counts as a failed login, both guess the same secret
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerLogin, req.Email); err != nil {
+		return nil, err
+	}
+
+	employers, err := s.userService.GetRestorableEmployersByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, employer := range employers {
+		restored, err := s.restoreEmployerAccount(ctx, employer, req.Password)
+		if err != nil {
+			return nil, err
+		}
+		if restored != nil {
+			s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerLogin, req.Email)
+
+			l.Infow("auth.restore_employer_account.success", "employer_id", restored.Id)
+			return &pb.RestoreEmployerAccountResponse{Employer: restored}, nil
+		}
+	}
+
+	return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerLogin, req.Email,
+		status.Errorf(codes.InvalidArgument, "invalid email or password"))
+}
+
+// restoreEmployerAccount returns nil when the password does not match or the
+// credentials are purged already. The deletion mark is removed in a transaction
+// committed only after user-service restored the employer, so the purge cannot
+// remove the credentials in between.
+func (s *service) restoreEmployerAccount(ctx context.Context, employer *userv1.Employer, password string) (*userv1.Employer, error) {
+	l := s.log.With("op", "restore_employer_account", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err := uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.restore_employer_account_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.retentionService.UnmarkEmployerDeleted(ctx, uow, employer); err != nil {
+		if errors.Is(err, retentionservice.ErrCredentialsPurged) {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckEmployerPassword(ctx, uow, employer, password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, nil
+	}
+
+	restored, err := s.userService.RestoreEmployer(ctx, employer)
+	if err != nil {
+		return nil, err
+	}
+
+	// the employer is restored already, a cancelled request must not abort the commit.
+	// A failed commit leaves the mark behind, the purge keeps the credentials of
+	// the restored employer and drops the mark
+	if err := uow.Commit(context.WithoutCancel(ctx)); err != nil {
+		l.Errorw("auth.restore_employer_account_failed", "err", err)
+	}
+	return restored, nil
+}
+
+func (s *service) EnrollEmployerMfa(ctx context.Context, req *pb.EnrollEmployerMfaRequest) (*pb.EnrollEmployerMfaResponse, error) {
+	l := s.log.With("op", "enroll_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAuthorizedEmployer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	mfa, err := s.mfaService.EnrollEmployer(ctx, uow, employer)
+	if err != nil {
+		if errors.Is(err, mfaservice.ErrMfaAlreadyEnabled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "mfa is already enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.enroll_employer_mfa.success", "employer_id", employer.Id)
+	return &pb.EnrollEmployerMfaResponse{
+		Secret:     mfa.Secret(),
+		OtpauthUri: s.mfaService.ProvisioningURI(mfa, employer.Email),
+	}, nil
+}
+
+func (s *service) ConfirmEmployerMfa(ctx context.Context, req *pb.ConfirmEmployerMfaRequest) (*pb.ConfirmEmployerMfaResponse, error) {
+	l := s.log.With("op", "confirm_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAuthorizedEmployer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(employer.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerMfa, account); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	recoveryCodes, err := s.mfaService.ConfirmEmployer(ctx, uow, employer, req.Code)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	if recoveryCodes == nil {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerMfa, account)
+
+	l.Infow("auth.confirm_employer_mfa.success", "employer_id", employer.Id)
+	return &pb.ConfirmEmployerMfaResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *service) DisableEmployerMfa(ctx context.Context, req *pb.DisableEmployerMfaRequest) (*pb.DisableEmployerMfaResponse, error) {
+	l := s.log.With("op", "disable_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	employer, err := s.getAuthorizedEmployer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := strconv.FormatInt(employer.Id, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerMfa, account); err != nil {
+		return nil, err
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.disable_employer_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.passwordService.CheckEmployerPassword(ctx, uow, employer, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid password"))
+	}
+
+	disabled, err := s.mfaService.DisableEmployer(ctx, uow, employer, req.Code)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	if !disabled {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.disable_employer_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerMfa, account)
+
+	l.Infow("auth.disable_employer_mfa.success", "employer_id", employer.Id)
+	return &pb.DisableEmployerMfaResponse{}, nil
+}
+
+func (s *service) VerifyEmployerMfa(ctx context.Context, req *pb.VerifyEmployerMfaRequest) (*pb.VerifyEmployerMfaResponse, error) {
+	l := s.log.With("op", "verify_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	userId, err := s.mfaService.GetEmployerChallenge(ctx, req.MfaToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if userId == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired mfa token")
+	}
+
+	account := strconv.FormatInt(userId, 10)
+	if err := s.checkAttempts(ctx, bruteforceservice.EmployerMfa, account); err != nil {
+		return nil, err
+	}
+
+	employer, err := s.userService.GetEmployerById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	_, err = uow.BeginTransaction(ctx)
+	if err != nil {
+		l.Errorw("auth.verify_employer_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	valid, err := s.mfaService.VerifyEmployer(ctx, uow, employer, req.Code)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	if !valid {
+		return nil, s.rejectAttempt(ctx, bruteforceservice.EmployerMfa, account,
+			status.Errorf(codes.InvalidArgument, "invalid code"))
+	}
+
+	if err := s.mfaService.DeleteEmployerChallenge(ctx, req.MfaToken); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.verify_employer_mfa_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	s.bruteForceService.RegisterSuccess(ctx, bruteforceservice.EmployerMfa, account)
+
+	l.Infow("auth.verify_employer_mfa.success", "employer_id", employer.Id)
+	return &pb.VerifyEmployerMfaResponse{Employer: employer}, nil
+}
+
+func (s *service) BumpEmployerSecurityVersion(ctx context.Context, req *pb.BumpEmployerSecurityVersionRequest) (*pb.BumpEmployerSecurityVersionResponse, error) {
+	l := s.log.With("op", "bump_employer_security_version", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if req.EmployerId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid employer id")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	version, err := s.tokenService.BumpEmployerVersion(ctx, uow, req.EmployerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	l.Infow("auth.bump_employer_security_version.success", "employer_id", req.EmployerId, "version", version)
+	return &pb.BumpEmployerSecurityVersionResponse{Version: int32(version)}, nil
+}
+
+func (s *service) IntrospectToken(ctx context.Context, req *pb.IntrospectTokenRequest) (*pb.IntrospectTokenResponse, error) {
+	l := s.log.With("op", "introspect_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	inactive := &pb.IntrospectTokenResponse{Active: false}
+
+	kind, err := s.tokenService.GetAccessTokenKind(ctx, req.Token)
+	if err != nil {
+		l.Infow("auth.introspect_token.inactive")
+		return inactive, nil
+	}
+
+	var resp *pb.IntrospectTokenResponse
+	switch kind {
+	case claims.KindApplicant:
+		cl, err := s.tokenService.ValidateApplicantAccessToken(ctx, req.Token)
+		if err != nil {
+			if errors.Is(err, claims.ErrInvalidToken) {
+				l.Infow("auth.introspect_token.inactive")
+				return inactive, nil
+			}
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+		resp = introspectionResponse(pb.UserKind_USER_KIND_APPLICANT, cl.Id, cl.IsActive, &cl.RegisteredClaims)
+	case claims.KindEmployer:
+		cl, err := s.tokenService.ValidateEmployerAccessToken(ctx, req.Token)
+		if err != nil {
+			if errors.Is(err, claims.ErrInvalidToken) {
+				l.Infow("auth.introspect_token.inactive")
+				return inactive, nil
+			}
+			return nil, status.Errorf(codes.Internal, "internal server error")
+		}
+		resp = introspectionResponse(pb.UserKind_USER_KIND_EMPLOYER, cl.Id, cl.IsActive, &cl.RegisteredClaims)
+	default:
+		l.Warnw("auth.introspect_token.unknown_kind", "kind", kind)
+		return inactive, nil
+	}
+
+	l.Infow("auth.introspect_token.success", "subject", resp.Subject, "user_kind", resp.UserKind)
+	return resp, nil
+}
+
+func (s *service) generateApplicantTokens(ctx context.Context, uow *uow.UnitOfWork, applicant *userv1.Applicant, existedRefreshToken *token.Token) error {
+	access, refresh, err := s.tokenService.GenerateApplicant(ctx, uow, applicant, existedRefreshToken)
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrTokenReused) {
+			s.clearTokens(ctx)
+			return status.Errorf(codes.Unauthenticated, "unauthorized")
+		}
+		return status.Errorf(codes.Internal, "internal server error")
+	}
+
+	trailer := metadata.Pairs(
+		"x-access-token", access.Token(),
+		"x-refresh-token", refresh.Token(),
+	)
+
+	grpc.SetTrailer(ctx, trailer)
+	return nil
+}
+
+// storeApplicantCredentials completes the registration saga of the applicant created
+// in the user service. The caller compensates when an error is returned.
+func (s *service) storeApplicantCredentials(
+	ctx context.Context, saga *registration.Saga,
+	applicant *userv1.Applicant, rawPassword string,
+) (*code.Code, error) {
+	l := s.log.With("op", "register_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.registrationService.ApplicantCreated(ctx, saga, applicant); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	if _, err := uow.BeginTransaction(ctx); err != nil {
+		l.Errorw("auth.register_applicant_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if _, err := s.passwordService.CreateApplicantPassword(ctx, uow, applicant, rawPassword); err != nil {
+		var pve *password.PasswordValidationError
+		if errors.As(err, &pve) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	activationCode, err := s.codeService.CreateApplicantActivationCode(ctx, uow, applicant)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.registrationService.CompleteApplicant(ctx, uow, saga); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.register_applicant_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	return activationCode, nil
+}
+
+// storeApplicantOidcCredentials activates the applicant created in the user
+// service and completes its registration saga with a random password and the
+// linked identity. The caller compensates when an error is returned.
+func (s *service) storeApplicantOidcCredentials(
+	ctx context.Context, saga *registration.Saga,
+	applicant *userv1.Applicant, profile *oidc.Profile,
+) (*userv1.Applicant, error) {
+	l := s.log.With("op", "register_applicant_with_oidc", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.registrationService.ApplicantCreated(ctx, saga, applicant); err != nil {
+		return applicant, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	activated, err := s.userService.ActivateApplicant(ctx, applicant)
+	if err != nil {
+		return applicant, err
+	}
+	applicant = activated
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	if _, err := uow.BeginTransaction(ctx); err != nil {
+		l.Errorw("auth.register_applicant_with_oidc_failed", "err", err)
+		return applicant, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	rawPassword, err := password.GenerateRandom()
+	if err != nil {
+		l.Errorw("auth.register_applicant_with_oidc_failed", "err", err)
+		return applicant, status.Errorf(codes.Internal, "internal server error")
+	}
+	if _, err := s.passwordService.CreateApplicantPassword(ctx, uow, applicant, rawPassword); err != nil {
+		return applicant, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if _, err := s.oidcService.LinkApplicant(ctx, uow, applicant, profile); err != nil {
+		return applicant, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateApplicantTokens(ctx, uow, applicant, nil); err != nil {
+		return applicant, err
+	}
+
+	if err := s.registrationService.CompleteApplicant(ctx, uow, saga); err != nil {
+		return applicant, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.register_applicant_with_oidc_failed", "err", err)
+		return applicant, status.Errorf(codes.Internal, "internal server error")
+	}
+	return applicant, nil
+}
+
+func (s *service) getAuthorizedApplicant(ctx context.Context) (*userv1.Applicant, error) {
+	claims, _ := ctxmetadata.GetApplicantClaimsFromContext(ctx)
+	if claims == nil || claims.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	applicant, err := s.userService.GetApplicantById(ctx, claims.Id)
+	if err != nil {
+		return nil, err
+	}
+	if applicant == nil || applicant.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	return applicant, nil
+}
+
+func (s *service) getAndCheckApplicantForActivation(ctx context.Context) (*userv1.Applicant, error) {
+	claims, _ := ctxmetadata.GetApplicantClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if claims.IsActive {
+		return nil, status.Errorf(codes.AlreadyExists, "applicant is already activated")
+	}
+	if claims.IsDeleted {
+		return nil, status.Errorf(codes.PermissionDenied, "applicant is deleted")
+	}
+
+	applicant, err := s.userService.GetApplicantById(ctx, claims.Id)
+	if err != nil {
+		return nil, err
+	}
+	if applicant == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if applicant.IsActive {
 		return nil, status.Errorf(codes.AlreadyExists, "applicant is already activated")
 	}
 	if applicant.IsDeleted {
@@ -449,6 +2630,134 @@ func (s *service) getAndCheckApplicantForActivation(ctx context.Context) (*userv
 	return applicant, nil
 }
 
+func (s *service) generateEmployerTokens(ctx context.Context, uow *uow.UnitOfWork, employer *userv1.Employer, existedRefreshToken *token.Token) error {
+	access, refresh, err := s.tokenService.GenerateEmployer(ctx, uow, employer, existedRefreshToken)
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrTokenReused) {
+			s.clearTokens(ctx)
+			return status.Errorf(codes.Unauthenticated, "unauthorized")
+		}
+		return status.Errorf(codes.Internal, "internal server error")
+	}
+
+	trailer := metadata.Pairs(
+		"x-access-token", access.Token(),
+		"x-refresh-token", refresh.Token(),
+	)
+
+	grpc.SetTrailer(ctx, trailer)
+	return nil
+}
+
+// storeEmployerCredentials completes the registration saga of the employer created
+// in the user service. The caller compensates when an error is returned.
+func (s *service) storeEmployerCredentials(
+	ctx context.Context, saga *registration.Saga,
+	employer *userv1.Employer, rawPassword string,
+) (*code.Code, error) {
+	l := s.log.With("op", "register_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.registrationService.EmployerCreated(ctx, saga, employer); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	if _, err := uow.BeginTransaction(ctx); err != nil {
+		l.Errorw("auth.register_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if _, err := s.passwordService.CreateEmployerPassword(ctx, uow, employer, rawPassword); err != nil {
+		var pve *password.PasswordValidationError
+		if errors.As(err, &pve) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	activationCode, err := s.codeService.CreateEmployerActivationCode(ctx, uow, employer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := s.generateEmployerTokens(ctx, uow, employer, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.registrationService.CompleteEmployer(ctx, uow, saga); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		l.Errorw("auth.register_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	return activationCode, nil
+}
+
+func (s *service) getAuthorizedEmployer(ctx context.Context) (*userv1.Employer, error) {
+	claims, _ := ctxmetadata.GetEmployerClaimsFromContext(ctx)
+	if claims == nil || claims.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	employer, err := s.userService.GetEmployerById(ctx, claims.Id)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil || employer.IsDeleted {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	return employer, nil
+}
+
+func (s *service) getAndCheckEmployerForActivation(ctx context.Context) (*userv1.Employer, error) {
+	claims, _ := ctxmetadata.GetEmployerClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if claims.IsActive {
+		return nil, status.Errorf(codes.AlreadyExists, "employer is already activated")
+	}
+	if claims.IsDeleted {
+		return nil, status.Errorf(codes.PermissionDenied, "employer is deleted")
+	}
+
+	employer, err := s.userService.GetEmployerById(ctx, claims.Id)
+	if err != nil {
+		return nil, err
+	}
+	if employer == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if employer.IsActive {
+		return nil, status.Errorf(codes.AlreadyExists, "employer is already activated")
+	}
+	if employer.IsDeleted {
+		return nil, status.Errorf(codes.PermissionDenied, "employer is deleted")
+	}
+	return employer, nil
+}
+
+// restoreApplicant undoes the soft delete of the applicant after the account
+// deletion failed on the auth side. A failure is logged for manual repair.
+func (s *service) restoreApplicant(ctx context.Context, applicant *userv1.Applicant) {
+	if _, err := s.userService.RestoreApplicant(ctx, applicant); err != nil {
+		s.log.Errorw("auth.delete_applicant_account.compensation_failed", "err", err,
+			"applicant_id", applicant.Id, "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+	}
+}
+
+// restoreEmployer undoes the soft delete of the employer after the account
+// deletion failed on the auth side. A failure is logged for manual repair.
+func (s *service) restoreEmployer(ctx context.Context, employer *userv1.Employer) {
+	if _, err := s.userService.RestoreEmployer(ctx, employer); err != nil {
+		s.log.Errorw("auth.delete_employer_account.compensation_failed", "err", err,
+			"employer_id", employer.Id, "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+	}
+}
+
 func (s *service) clearTokens(ctx context.Context) {
 	trailer := metadata.Pairs(
 		"x-access-token", "",
@@ -456,3 +2765,176 @@ func (s *service) clearTokens(ctx context.Context) {
 	)
 	grpc.SetTrailer(ctx, trailer)
 }
+
+func (s *service) checkAttempts(ctx context.Context, action bruteforceservice.Action, account string) error {
+	if err := s.bruteForceService.Check(ctx, action, account); err != nil {
+		return attemptsError(ctx, err)
+	}
+	return nil
+}
+
+// rejectAttempt registers a failed attempt and returns rejection unless the
+// failure exhausted the attempts, then the lockout is returned instead.
+func (s *service) rejectAttempt(ctx context.Context, action bruteforceservice.Action, account string, rejection error) error {
+	if err := s.bruteForceService.RegisterFailure(ctx, action, account); err != nil {
+		return attemptsError(ctx, err)
+	}
+	return rejection
+}
+
+// rejectCode commits the failed code check so the decremented attempts of
+// the code are kept, then registers the failed attempt.
+func (s *service) rejectCode(ctx context.Context, uow *uow.UnitOfWork, action bruteforceservice.Action, account string, rejection error) error {
+	if err := uow.Commit(ctx); err != nil {
+		s.log.Errorw("auth.reject_code_failed", "err", err, "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+		return status.Errorf(codes.Internal, "internal server error")
+	}
+	return s.rejectAttempt(ctx, action, account, rejection)
+}
+
+func attemptsError(ctx context.Context, err error) error {
+	var tme *bruteforceservice.TooManyAttemptsError
+	if !errors.As(err, &tme) {
+		return status.Errorf(codes.Internal, "internal server error")
+	}
+
+	retryAfter := tme.RetryAfter()
+	grpc.SetTrailer(ctx, metadata.Pairs("retry-after", strconv.FormatInt(int64(retryAfter.Seconds()), 10)))
+
+	st, detailsErr := status.New(codes.ResourceExhausted, "too many failed attempts").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if detailsErr != nil {
+		return status.Errorf(codes.ResourceExhausted, "too many failed attempts")
+	}
+	return st.Err()
+}
+
+func mfaError(err error) error {
+	switch {
+	case errors.Is(err, mfaservice.ErrMfaAlreadyEnabled):
+		return status.Errorf(codes.FailedPrecondition, "mfa is already enabled")
+	case errors.Is(err, mfaservice.ErrMfaNotEnrolled):
+		return status.Errorf(codes.FailedPrecondition, "mfa is not enrolled")
+	case errors.Is(err, mfaservice.ErrMfaNotEnabled):
+		return status.Errorf(codes.FailedPrecondition, "mfa is not enabled")
+	default:
+		return status.Errorf(codes.Internal, "internal server error")
+	}
+}
+
+func oidcError(err error) error {
+	switch {
+	case errors.Is(err, oidcservice.ErrOidcDisabled):
+		return status.Errorf(codes.Unimplemented, "oidc login is disabled")
+	case errors.Is(err, oidcservice.ErrInvalidState):
+		return status.Errorf(codes.Unauthenticated, "invalid or expired oidc state")
+	case errors.Is(err, oidcservice.ErrInvalidAuthorization):
+		return status.Errorf(codes.Unauthenticated, "identity provider authorization failed")
+	default:
+		return status.Errorf(codes.Unavailable, "identity provider is unavailable")
+	}
+}
+
+// checkOidcProfileLinkable allows to match a provider account without an
+// identity to an applicant only by an email the provider has verified.
+func checkOidcProfileLinkable(profile *oidc.Profile) error {
+	if !profile.EmailVerified || profile.Email == "" {
+		return status.Errorf(codes.FailedPrecondition, "identity provider email is not verified")
+	}
+	return nil
+}
+
+// checkApplicantOidcLinkable checks the applicant with the email of the provider
+// account, nil when there is none and the account has to be registered.
+func checkApplicantOidcLinkable(applicant *userv1.Applicant) error {
+	if applicant == nil {
+		return nil
+	}
+	if applicant.IsDeleted {
+		return status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if !applicant.IsActive {
+		return status.Errorf(codes.FailedPrecondition, "applicant with this email is not activated")
+	}
+	return nil
+}
+
+// oidcBirthDate converts the YYYY-MM-DD birthdate claim to the user service format.
+func oidcBirthDate(birthdate string) string {
+	date, err := time.Parse("2006-01-02", birthdate)
+	if err != nil {
+		return ""
+	}
+	return date.Format("02.01.2006")
+}
+
+// outcomeUnknown reports whether a failed user-service call may
+// still have been applied, e.g. the deadline passed after the write.
+func outcomeUnknown(err error) bool {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Canceled, codes.Unavailable, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+func introspectionResponse(kind pb.UserKind, userId int64, isActive bool, rc *jwt.RegisteredClaims) *pb.IntrospectTokenResponse {
+	scopes := []string{claims.KindApplicant}
+	if kind == pb.UserKind_USER_KIND_EMPLOYER {
+		scopes = []string{claims.KindEmployer}
+	}
+	if isActive {
+		scopes = append(scopes, "activated")
+	}
+
+	resp := &pb.IntrospectTokenResponse{
+		Active:   true,
+		Subject:  strconv.FormatInt(userId, 10),
+		UserKind: kind,
+		Scopes:   scopes,
+	}
+	if rc.ExpiresAt != nil {
+		resp.ExpiresAt = timestamppb.New(rc.ExpiresAt.Time)
+	}
+	if rc.IssuedAt != nil {
+		resp.IssuedAt = timestamppb.New(rc.IssuedAt.Time)
+	}
+	return resp
+}
+
+func getRefreshTokenFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get("x-refresh-token"); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func getAccessTokenFromMetadata(ctx context.Context) string {
+	authHeader, err := ctxmetadata.GetAuthMetadataFromIncomingContext(ctx)
+	if err != nil {
+		return ""
+	}
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return ""
+	}
+	return parts[1]
+}
+
+func toPbSession(t *token.Token, current bool) *pb.Session {
+	return &pb.Session{
+		Id:         t.Id(),
+		Device:     t.Device(),
+		UserAgent:  t.UserAgent(),
+		Ip:         t.IP(),
+		Current:    current,
+		CreatedAt:  timestamppb.New(t.CreatedAt()),
+		LastUsedAt: timestamppb.New(t.LastUsedAt()),
+		ExpiresAt:  timestamppb.New(t.ExpiresAt()),
+	}
+}
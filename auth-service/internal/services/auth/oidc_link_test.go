@@ -0,0 +1,93 @@
+package authservice
+
+import (
+	"testing"
+
+	userv1 "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckOidcProfileLinkable(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile *oidc.Profile
+		want    codes.Code
+	}{
+		{
+			name:    "verified email",
+			profile: &oidc.Profile{Email: "john@example.com", EmailVerified: true},
+			want:    codes.OK,
+		},
+		{
+			name:    "unverified email",
+			profile: &oidc.Profile{Email: "john@example.com"},
+			want:    codes.FailedPrecondition,
+		},
+		{
+			name:    "no email",
+			profile: &oidc.Profile{EmailVerified: true},
+			want:    codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(checkOidcProfileLinkable(tt.profile)); got != tt.want {
+				t.Errorf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckApplicantOidcLinkable(t *testing.T) {
+	tests := []struct {
+		name      string
+		applicant *userv1.Applicant
+		want      codes.Code
+	}{
+		{
+			name:      "no applicant with the email",
+			applicant: nil,
+			want:      codes.OK,
+		},
+		{
+			name:      "active applicant",
+			applicant: &userv1.Applicant{Id: 1, IsActive: true},
+			want:      codes.OK,
+		},
+		{
+			// whoever registered it never proved to own the email
+			name:      "not activated applicant",
+			applicant: &userv1.Applicant{Id: 1},
+			want:      codes.FailedPrecondition,
+		},
+		{
+			name:      "deleted applicant",
+			applicant: &userv1.Applicant{Id: 1, IsActive: true, IsDeleted: true},
+			want:      codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(checkApplicantOidcLinkable(tt.applicant)); got != tt.want {
+				t.Errorf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOidcBirthDate(t *testing.T) {
+	for in, want := range map[string]string{
+		"1990-12-31": "31.12.1990",
+		"":           "",
+		"31.12.1990": "",
+		"1990-02-30": "",
+	} {
+		if got := oidcBirthDate(in); got != want {
+			t.Errorf("oidcBirthDate(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package bruteforceservice
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	cache "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl/redis"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/utils"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"go.uber.org/zap"
+)
+
+type Action impl.RepositoryType
+
+const (
+	ApplicantLogin         = Action(cache.ApplicantLoginAttemptsCache)
+	EmployerLogin          = Action(cache.EmployerLoginAttemptsCache)
+	ApplicantActivation    = Action(cache.ApplicantActivationAttemptsCache)
+	EmployerActivation     = Action(cache.EmployerActivationAttemptsCache)
+	ApplicantResetPassword = Action(cache.ApplicantResetPasswordAttemptsCache)
+	EmployerResetPassword  = Action(cache.EmployerResetPasswordAttemptsCache)
+	ApplicantMfa           = Action(cache.ApplicantMfaAttemptsCache)
+	EmployerMfa            = Action(cache.EmployerMfaAttemptsCache)
+	ApplicantEmailChange   = Action(cache.ApplicantEmailChangeAttemptsCache)
+	EmployerEmailChange    = Action(cache.EmployerEmailChangeAttemptsCache)
+)
+
+// BruteForceService counts failed attempts per account and per client ip and
+// locks them out with an exponentially growing delay once a limit is reached.
+type BruteForceService interface {
+	Check(ctx context.Context, action Action, account string) error
+	RegisterFailure(ctx context.Context, action Action, account string) error
+	RegisterSuccess(ctx context.Context, action Action, account string) error
+}
+
+type service struct {
+	redis *redis.RedisClient
+	cfg   settings.BruteForceSettings
+	log   *zap.SugaredLogger
+}
+
+func New(redis *redis.RedisClient, cfg settings.BruteForceSettings, log *zap.SugaredLogger) BruteForceService {
+	return &service{
+		redis: redis,
+		cfg:   cfg,
+		log:   log,
+	}
+}
+
+type subject struct {
+	key         string
+	maxAttempts int64
+}
+
+// Check returns *TooManyAttemptsError while the account or the client ip is locked out.
+func (s *service) Check(ctx context.Context, action Action, account string) error {
+	l := s.log.With("op", "check_attempts", "action", action, "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	repo := cache.NewAttemptCacheRepository(s.redis, impl.RepositoryType(action))
+
+	var lockout time.Duration
+	for _, sub := range s.subjects(ctx, account) {
+		ttl, err := repo.GetLockout(ctx, sub.key)
+		if err != nil {
+			l.Errorw("bruteforce.check_attempts_failed", "err", err)
+			return err
+		}
+		lockout = max(lockout, ttl)
+	}
+
+	if lockout > 0 {
+		l.Warnw("bruteforce.check_attempts.locked", "retry_after", lockout)
+		return NewTooManyAttemptsError(lockout)
+	}
+	return nil
+}
+
+// RegisterFailure counts a failed attempt and returns *TooManyAttemptsError
+// when it exhausted the attempts of the account or the client ip.
+func (s *service) RegisterFailure(ctx context.Context, action Action, account string) error {
+	l := s.log.With("op", "register_failed_attempt", "action", action, "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	repo := cache.NewAttemptCacheRepository(s.redis, impl.RepositoryType(action))
+
+	var lockout time.Duration
+	for _, sub := range s.subjects(ctx, account) {
+		n, err := repo.IncrementFailures(ctx, sub.key, time.Duration(s.cfg.AttemptsWindow)*time.Second)
+		if err != nil {
+			l.Errorw("bruteforce.register_failed_attempt_failed", "err", err)
+			return err
+		}
+		if n < sub.maxAttempts {
+			continue
+		}
+
+		ttl, err := repo.Lock(
+			ctx, sub.key,
+			time.Duration(s.cfg.BaseLockout)*time.Second,
+			time.Duration(s.cfg.MaxLockout)*time.Second,
+			time.Duration(s.cfg.LockoutLevelTTL)*time.Second,
+		)
+		if err != nil {
+			l.Errorw("bruteforce.register_failed_attempt_failed", "err", err)
+			return err
+		}
+		l.Warnw("bruteforce.register_failed_attempt.locked", "subject", sub.key, "lockout", ttl)
+		lockout = max(lockout, ttl)
+	}
+
+	if lockout > 0 {
+		return NewTooManyAttemptsError(lockout)
+	}
+	return nil
+}
+
+// RegisterSuccess forgets the failed attempts of the account. Client ip
+// counters are kept, a single ip may be guessing many accounts.
+func (s *service) RegisterSuccess(ctx context.Context, action Action, account string) error {
+	l := s.log.With("op", "register_successful_attempt", "action", action, "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	repo := cache.NewAttemptCacheRepository(s.redis, impl.RepositoryType(action))
+	if err := repo.Reset(ctx, accountKey(account)); err != nil {
+		l.Errorw("bruteforce.register_successful_attempt_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (s *service) subjects(ctx context.Context, account string) []subject {
+	subjects := []subject{{key: accountKey(account), maxAttempts: int64(s.cfg.AccountMaxAttempts)}}
+	if ip := utils.GetClientInfoFromContext(ctx).IP; ip != "" {
+		subjects = append(subjects, subject{key: "ip:" + ip, maxAttempts: int64(s.cfg.IPMaxAttempts)})
+	}
+	return subjects
+}
+
+func accountKey(account string) string {
+	return "account:" + strings.ToLower(account)
+}
@@ -0,0 +1,197 @@
+package bruteforceservice
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/peer"
+)
+
+var testSettings = settings.BruteForceSettings{
+	AccountMaxAttempts: 3,
+	IPMaxAttempts:      5,
+	AttemptsWindow:     900,
+	BaseLockout:        30,
+	MaxLockout:         100,
+	LockoutLevelTTL:    86400,
+}
+
+func newTestService(t *testing.T) (BruteForceService, *fakeRedis) {
+	t.Helper()
+
+	r := newFakeRedis(t)
+	return New(r.client(), testSettings, zap.NewNop().Sugar()), r
+}
+
+func fromIP(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000},
+	})
+}
+
+// fail registers failed attempts until one returns an error, which is returned
+// together with the number of attempts made.
+func fail(t *testing.T, s BruteForceService, ctx context.Context, account string, limit int) (int, error) {
+	t.Helper()
+
+	for i := 1; i <= limit; i++ {
+		if err := s.RegisterFailure(ctx, ApplicantLogin, account); err != nil {
+			return i, err
+		}
+	}
+	return limit, nil
+}
+
+func lockoutOf(t *testing.T, err error) time.Duration {
+	t.Helper()
+
+	var tme *TooManyAttemptsError
+	if !errors.As(err, &tme) {
+		t.Fatalf("err = %v, want %T", err, tme)
+	}
+	return tme.RetryAfter()
+}
+
+func TestLockoutGrowsExponentially(t *testing.T) {
+	s, r := newTestService(t)
+	ctx := context.Background()
+
+	for _, want := range []time.Duration{30 * time.Second, 60 * time.Second, 100 * time.Second, 100 * time.Second} {
+		n, err := fail(t, s, ctx, "john@example.com", 10)
+		if n != 3 {
+			t.Fatalf("locked after %d failures, want 3", n)
+		}
+		if got := lockoutOf(t, err); got != want {
+			t.Fatalf("lockout = %v, want %v", got, want)
+		}
+
+		if got := lockoutOf(t, s.Check(ctx, ApplicantLogin, "john@example.com")); got != want {
+			t.Fatalf("check while locked: retry after %v, want %v", got, want)
+		}
+		r.advance(want)
+		if err := s.Check(ctx, ApplicantLogin, "john@example.com"); err != nil {
+			t.Fatalf("check after the lockout: %v", err)
+		}
+	}
+}
+
+func TestLockoutLevelIsForgotten(t *testing.T) {
+	s, r := newTestService(t)
+	ctx := context.Background()
+
+	_, err := fail(t, s, ctx, "john@example.com", 3)
+	lockoutOf(t, err)
+
+	r.advance(time.Duration(testSettings.LockoutLevelTTL) * time.Second)
+	_, err = fail(t, s, ctx, "john@example.com", 3)
+	if got := lockoutOf(t, err); got != 30*time.Second {
+		t.Fatalf("lockout = %v, want the base lockout", got)
+	}
+}
+
+func TestFailuresOutsideTheWindowAreForgotten(t *testing.T) {
+	s, r := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := fail(t, s, ctx, "john@example.com", 2); err != nil {
+		t.Fatalf("register failure: %v", err)
+	}
+	r.advance(time.Duration(testSettings.AttemptsWindow) * time.Second)
+
+	if n, _ := fail(t, s, ctx, "john@example.com", 10); n != 3 {
+		t.Fatalf("locked after %d failures, want 3", n)
+	}
+}
+
+func TestAccountKeyIgnoresCase(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	for _, account := range []string{"john@example.com", "John@Example.com", "JOHN@EXAMPLE.COM"} {
+		s.RegisterFailure(ctx, ApplicantLogin, account)
+	}
+	if err := s.Check(ctx, ApplicantLogin, "john@example.com"); err == nil {
+		t.Fatal("spelling the email differently escaped the lockout")
+	}
+}
+
+func TestSuccessResetsTheAccount(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, err := fail(t, s, ctx, "john@example.com", 3)
+	lockoutOf(t, err)
+
+	if err := s.RegisterSuccess(ctx, ApplicantLogin, "john@example.com"); err != nil {
+		t.Fatalf("register success: %v", err)
+	}
+	if err := s.Check(ctx, ApplicantLogin, "john@example.com"); err != nil {
+		t.Fatalf("check after success: %v", err)
+	}
+}
+
+func TestActionsAreCountedSeparately(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	_, err := fail(t, s, ctx, "john@example.com", 3)
+	lockoutOf(t, err)
+
+	if err := s.Check(ctx, EmployerLogin, "john@example.com"); err != nil {
+		t.Fatalf("another action is locked: %v", err)
+	}
+}
+
+func TestIPLockoutSpansAccounts(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := fromIP("203.0.113.7")
+
+	// one guess per account stays below the account limit
+	for i, account := range []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"} {
+		if err := s.RegisterFailure(ctx, ApplicantLogin, account); err != nil {
+			t.Fatalf("failure %d: %v", i+1, err)
+		}
+	}
+	lockoutOf(t, s.RegisterFailure(ctx, ApplicantLogin, "e@example.com"))
+
+	if err := s.Check(ctx, ApplicantLogin, "f@example.com"); err == nil {
+		t.Fatal("locked ip may guess another account")
+	}
+	if err := s.Check(fromIP("198.51.100.1"), ApplicantLogin, "f@example.com"); err != nil {
+		t.Fatalf("another ip is locked: %v", err)
+	}
+}
+
+func TestSuccessKeepsTheIPCounter(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := fromIP("203.0.113.7")
+
+	for _, account := range []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"} {
+		s.RegisterFailure(ctx, ApplicantLogin, account)
+	}
+	// logging into an own account must not clear the guesses at the others
+	if err := s.RegisterSuccess(ctx, ApplicantLogin, "me@example.com"); err != nil {
+		t.Fatalf("register success: %v", err)
+	}
+	lockoutOf(t, s.RegisterFailure(ctx, ApplicantLogin, "e@example.com"))
+}
+
+func TestRetryAfterRoundsUp(t *testing.T) {
+	for _, tt := range []struct {
+		lockout time.Duration
+		want    time.Duration
+	}{
+		{lockout: 30 * time.Second, want: 30 * time.Second},
+		{lockout: 29*time.Second + time.Millisecond, want: 30 * time.Second},
+		{lockout: time.Millisecond, want: time.Second},
+	} {
+		if got := NewTooManyAttemptsError(tt.lockout).RetryAfter(); got != tt.want {
+			t.Errorf("RetryAfter(%v) = %v, want %v", tt.lockout, got, tt.want)
+		}
+	}
+}
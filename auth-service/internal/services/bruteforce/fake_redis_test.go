@@ -0,0 +1,222 @@
+package bruteforceservice
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+)
+
+// fakeRedis is a redis server implementing the commands the attempt counters use.
+// Its clock only moves on advance, so lockouts can be waited out instantly.
+type fakeRedis struct {
+	t   *testing.T
+	lis net.Listener
+
+	mu       sync.Mutex
+	now      time.Time
+	values   map[string]string
+	expireAt map[string]time.Time
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	r := &fakeRedis{
+		t:        t,
+		lis:      lis,
+		now:      time.Now(),
+		values:   make(map[string]string),
+		expireAt: make(map[string]time.Time),
+	}
+	go r.serve()
+	t.Cleanup(func() { lis.Close() })
+
+	return r
+}
+
+// client returns a client of the server, closed with the test.
+func (r *fakeRedis) client() *redis.RedisClient {
+	r.t.Helper()
+
+	cl, err := redis.New(context.Background(), settings.RedisSettings{
+		Address:     r.lis.Addr().String(),
+		MaxPoolSize: 1,
+		DialTimeout: 1,
+	})
+	if err != nil {
+		r.t.Fatalf("connect to fake redis: %v", err)
+	}
+	r.t.Cleanup(cl.Close)
+	return cl
+}
+
+func (r *fakeRedis) advance(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = r.now.Add(d)
+}
+
+func (r *fakeRedis) serve() {
+	for {
+		conn, err := r.lis.Accept()
+		if err != nil {
+			return
+		}
+		go r.handle(conn)
+	}
+}
+
+func (r *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+
+	rd := bufio.NewReader(conn)
+	var queued []string
+	inTx := false
+
+	for {
+		args, err := readCommand(rd)
+		if err != nil {
+			return
+		}
+
+		var reply string
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "MULTI":
+			inTx, queued, reply = true, nil, "+OK\r\n"
+		case cmd == "EXEC":
+			reply = fmt.Sprintf("*%d\r\n%s", len(queued), strings.Join(queued, ""))
+			inTx, queued = false, nil
+		case inTx:
+			queued = append(queued, r.exec(args))
+			reply = "+QUEUED\r\n"
+		default:
+			reply = r.exec(args)
+		}
+
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func (r *fakeRedis) exec(args []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "INCR":
+		n, _ := strconv.ParseInt(r.get(args[1]), 10, 64)
+		n++
+		r.values[args[1]] = strconv.FormatInt(n, 10)
+		return fmt.Sprintf(":%d\r\n", n)
+	case "PEXPIRE":
+		if _, ok := r.lookup(args[1]); !ok {
+			return ":0\r\n"
+		}
+		ms, _ := strconv.ParseInt(args[2], 10, 64)
+		r.expireAt[args[1]] = r.now.Add(time.Duration(ms) * time.Millisecond)
+		return ":1\r\n"
+	case "PTTL":
+		if _, ok := r.lookup(args[1]); !ok {
+			return ":-2\r\n"
+		}
+		exp, ok := r.expireAt[args[1]]
+		if !ok {
+			return ":-1\r\n"
+		}
+		return fmt.Sprintf(":%d\r\n", exp.Sub(r.now).Milliseconds())
+	case "SET":
+		r.values[args[1]] = args[2]
+		delete(r.expireAt, args[1])
+		for i := 3; i+1 < len(args); i += 2 {
+			n, _ := strconv.ParseInt(args[i+1], 10, 64)
+			switch strings.ToUpper(args[i]) {
+			case "EX":
+				r.expireAt[args[1]] = r.now.Add(time.Duration(n) * time.Second)
+			case "PX":
+				r.expireAt[args[1]] = r.now.Add(time.Duration(n) * time.Millisecond)
+			}
+		}
+		return "+OK\r\n"
+	case "DEL":
+		deleted := 0
+		for _, key := range args[1:] {
+			if _, ok := r.lookup(key); ok {
+				deleted++
+			}
+			delete(r.values, key)
+			delete(r.expireAt, key)
+		}
+		return fmt.Sprintf(":%d\r\n", deleted)
+	default:
+		// HELLO and CLIENT included, the client falls back to RESP2
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+// lookup must be called with mu held, it drops the key once expired.
+func (r *fakeRedis) lookup(key string) (string, bool) {
+	if exp, ok := r.expireAt[key]; ok && !r.now.Before(exp) {
+		delete(r.values, key)
+		delete(r.expireAt, key)
+	}
+	v, ok := r.values[key]
+	return v, ok
+}
+
+func (r *fakeRedis) get(key string) string {
+	v, _ := r.lookup(key)
+	return v
+}
+
+func readCommand(rd *bufio.Reader) ([]string, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, errors.New("inline commands are not supported")
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, n)
+	for range n {
+		line, err := rd.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(rd, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	if len(args) == 0 {
+		return nil, errors.New("empty command")
+	}
+	return args, nil
+}
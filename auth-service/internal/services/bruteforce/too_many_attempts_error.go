@@ -0,0 +1,25 @@
+package bruteforceservice
+
+import (
+	"fmt"
+	"time"
+)
+
+type TooManyAttemptsError struct {
+	retryAfter time.Duration
+}
+
+func NewTooManyAttemptsError(retryAfter time.Duration) *TooManyAttemptsError {
+	return &TooManyAttemptsError{
+		retryAfter: retryAfter,
+	}
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("too many failed attempts, retry after %s", e.RetryAfter())
+}
+
+// RetryAfter is the remaining lockout rounded up to whole seconds.
+func (e *TooManyAttemptsError) RetryAfter() time.Duration {
+	return (e.retryAfter + time.Second - 1).Truncate(time.Second)
+}
@@ -70,6 +70,54 @@ func (p *codeDataProvider) DeleteEmployerResetPasswordCode(ctx context.Context,
 	return p.delete(ctx, uow, code, repo.EmployerResetPasswordCodes, cache.EmployerResetPasswordCodesCache)
 }
 
+func (p *codeDataProvider) SaveApplicantEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.save(ctx, uow, code, repo.ApplicantEmailChangeCodes, cache.ApplicantEmailChangeCodesCache)
+}
+
+func (p *codeDataProvider) GetApplicantEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*code.Code, error) {
+	return p.get(ctx, uow, userId, repo.ApplicantEmailChangeCodes, cache.ApplicantEmailChangeCodesCache)
+}
+
+func (p *codeDataProvider) DeleteApplicantEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.delete(ctx, uow, code, repo.ApplicantEmailChangeCodes, cache.ApplicantEmailChangeCodesCache)
+}
+
+func (p *codeDataProvider) SaveEmployerEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.save(ctx, uow, code, repo.EmployerEmailChangeCodes, cache.EmployerEmailChangeCodesCache)
+}
+
+func (p *codeDataProvider) GetEmployerEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*code.Code, error) {
+	return p.get(ctx, uow, userId, repo.EmployerEmailChangeCodes, cache.EmployerEmailChangeCodesCache)
+}
+
+func (p *codeDataProvider) DeleteEmployerEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.delete(ctx, uow, code, repo.EmployerEmailChangeCodes, cache.EmployerEmailChangeCodesCache)
+}
+
+func (p *codeDataProvider) SaveApplicantLoginCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.save(ctx, uow, code, repo.ApplicantLoginCodes, cache.ApplicantLoginCodesCache)
+}
+
+func (p *codeDataProvider) GetApplicantLoginCode(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*code.Code, error) {
+	return p.get(ctx, uow, userId, repo.ApplicantLoginCodes, cache.ApplicantLoginCodesCache)
+}
+
+func (p *codeDataProvider) DeleteApplicantLoginCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.delete(ctx, uow, code, repo.ApplicantLoginCodes, cache.ApplicantLoginCodesCache)
+}
+
+func (p *codeDataProvider) SaveEmployerLoginCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.save(ctx, uow, code, repo.EmployerLoginCodes, cache.EmployerLoginCodesCache)
+}
+
+func (p *codeDataProvider) GetEmployerLoginCode(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*code.Code, error) {
+	return p.get(ctx, uow, userId, repo.EmployerLoginCodes, cache.EmployerLoginCodesCache)
+}
+
+func (p *codeDataProvider) DeleteEmployerLoginCode(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error {
+	return p.delete(ctx, uow, code, repo.EmployerLoginCodes, cache.EmployerLoginCodesCache)
+}
+
 func (p *codeDataProvider) get(
 	ctx context.Context, uow *uow.UnitOfWork,
 	userId int64,
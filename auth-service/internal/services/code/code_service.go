@@ -5,6 +5,7 @@ import (
 
 	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/code"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
 	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
@@ -22,16 +23,28 @@ type CodeService interface {
 	CheckEmployerResetPasswordCode(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawCode string) (bool, error)
 	RegenerateApplicantResetPasswordCode(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) (*code.Code, error)
 	RegenerateEmployerResetPasswordCode(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) (*code.Code, error)
+	CreateApplicantEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, newEmail string) (*code.Code, error)
+	CreateEmployerEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, newEmail string) (*code.Code, error)
+	CheckApplicantEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, rawCode string) (string, bool, error)
+	CheckEmployerEmailChangeCode(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawCode string) (string, bool, error)
+	RegenerateApplicantLoginCode(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) (*code.Code, error)
+	RegenerateEmployerLoginCode(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) (*code.Code, error)
+	CheckApplicantLoginCode(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, rawCode string) (bool, error)
+	CheckEmployerLoginCode(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawCode string) (bool, error)
+	DeleteApplicantCodes(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error
+	DeleteEmployerCodes(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error
 }
 
 type service struct {
 	dataProvider *codeDataProvider
+	hasher       *secret.Hasher
 	log          *zap.SugaredLogger
 }
 
-func New(redis *redis.RedisClient, log *zap.SugaredLogger) CodeService {
+func New(redis *redis.RedisClient, hasher *secret.Hasher, log *zap.SugaredLogger) CodeService {
 	return &service{
 		dataProvider: newCodeDataProvider(redis),
+		hasher:       hasher,
 		log:          log,
 	}
 }
@@ -42,7 +55,7 @@ func (s *service) CreateApplicantActivationCode(
 ) (*code.Code, error) {
 	l := s.log.With("op", "create_applicant_activation_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	code, err := code.New(applicant.Id)
+	code, err := code.New(applicant.Id, s.hasher)
 	if err != nil {
 		l.Errorw("code.create_activation_code_failed", "err", err)
 		return nil, err
@@ -72,7 +85,7 @@ func (s *service) CreateEmployerActivationCode(
 ) (*code.Code, error) {
 	l := s.log.With("op", "create_employer_activation_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	code, err := code.New(employer.Id)
+	code, err := code.New(employer.Id, s.hasher)
 	if err != nil {
 		l.Errorw("code.create_activation_code_failed", "err", err)
 		return nil, err
@@ -112,7 +125,14 @@ func (s *service) CheckApplicantActivationCode(
 		return false, nil
 	}
 
-	valid, err := code.CheckCode(rawCode)
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveApplicantActivationCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_activation_code_failed", "err", saveErr)
+			return false, saveErr
+		}
+	}
 	if err != nil {
 		l.Warnw("code.check_activation_code_failed", "err", err)
 		return false, err
@@ -146,7 +166,14 @@ func (s *service) CheckEmployerActivationCode(
 		return false, nil
 	}
 
-	valid, err := code.CheckCode(rawCode)
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveEmployerActivationCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_activation_code_failed", "err", saveErr)
+			return false, saveErr
+		}
+	}
 	if err != nil {
 		l.Warnw("code.check_activation_code_failed", "err", err)
 		return false, err
@@ -178,13 +205,13 @@ func (s *service) RegenerateApplicantActivationCode(
 	}
 	if existedCode != nil {
 		c = existedCode
-		err = c.GenerateCode()
+		err = c.GenerateCode(s.hasher)
 		if err != nil {
 			l.Warnw("code.regenerate_activation_code_failed", "err", err)
 			return nil, err
 		}
 	} else {
-		c, err = code.New(applicant.Id)
+		c, err = code.New(applicant.Id, s.hasher)
 		if err != nil {
 			l.Errorw("code.regenerate_activation_code_failed", "err", err)
 			return nil, err
@@ -214,13 +241,13 @@ func (s *service) RegenerateEmployerActivationCode(
 	}
 	if existedCode != nil {
 		c = existedCode
-		err = c.GenerateCode()
+		err = c.GenerateCode(s.hasher)
 		if err != nil {
 			l.Warnw("code.regenerate_activation_code_failed", "err", err)
 			return nil, err
 		}
 	} else {
-		c, err = code.New(employer.Id)
+		c, err = code.New(employer.Id, s.hasher)
 		if err != nil {
 			l.Errorw("code.regenerate_activation_code_failed", "err", err)
 			return nil, err
@@ -252,7 +279,14 @@ func (s *service) CheckApplicantResetPasswordCode(
 		return false, nil
 	}
 
-	valid, err := code.CheckCode(rawCode)
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveApplicantResetPasswordCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_reset_password_code_failed", "err", saveErr)
+			return false, saveErr
+		}
+	}
 	if err != nil {
 		l.Warnw("code.check_reset_password_code_failed", "err", err)
 		return false, err
@@ -286,7 +320,14 @@ func (s *service) CheckEmployerResetPasswordCode(
 		return false, nil
 	}
 
-	valid, err := code.CheckCode(rawCode)
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveEmployerResetPasswordCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_reset_password_code_failed", "err", saveErr)
+			return false, saveErr
+		}
+	}
 	if err != nil {
 		l.Warnw("code.check_reset_password_code_failed", "err", err)
 		return false, err
@@ -318,13 +359,13 @@ func (s *service) RegenerateApplicantResetPasswordCode(
 	}
 	if existedCode != nil {
 		c = existedCode
-		err = c.GenerateCode()
+		err = c.GenerateCode(s.hasher)
 		if err != nil {
 			l.Warnw("code.regenerate_reset_password_code_failed", "err", err)
 			return nil, err
 		}
 	} else {
-		c, err = code.New(applicant.Id)
+		c, err = code.New(applicant.Id, s.hasher)
 		if err != nil {
 			l.Errorw("code.regenerate_reset_password_code_failed", "err", err)
 			return nil, err
@@ -354,13 +395,13 @@ func (s *service) RegenerateEmployerResetPasswordCode(
 	}
 	if existedCode != nil {
 		c = existedCode
-		err = c.GenerateCode()
+		err = c.GenerateCode(s.hasher)
 		if err != nil {
 			l.Warnw("code.regenerate_reset_password_code_failed", "err", err)
 			return nil, err
 		}
 	} else {
-		c, err = code.New(employer.Id)
+		c, err = code.New(employer.Id, s.hasher)
 		if err != nil {
 			l.Errorw("code.regenerate_reset_password_code_failed", "err", err)
 			return nil, err
@@ -375,3 +416,383 @@ func (s *service) RegenerateEmployerResetPasswordCode(
 	l.Infow("code.regenerate_reset_password_code.success")
 	return c, nil
 }
+
+// CreateApplicantEmailChangeCode issues a code bound to newEmail. A pending code is
+// regenerated, so the resend limit also covers switching the address.
+func (s *service) CreateApplicantEmailChangeCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant, newEmail string,
+) (*code.Code, error) {
+	l := s.log.With("op", "create_applicant_email_change_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	var c *code.Code
+	existedCode, err := s.dataProvider.GetApplicantEmailChangeCode(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("code.create_email_change_code_failed", "err", err)
+		return nil, err
+	}
+	if existedCode != nil {
+		c = existedCode
+		err = c.GenerateCode(s.hasher)
+		if err != nil {
+			l.Warnw("code.create_email_change_code_failed", "err", err)
+			return nil, err
+		}
+	} else {
+		c, err = code.New(applicant.Id, s.hasher)
+		if err != nil {
+			l.Errorw("code.create_email_change_code_failed", "err", err)
+			return nil, err
+		}
+	}
+	c.SetNewEmail(newEmail)
+
+	if err := s.dataProvider.SaveApplicantEmailChangeCode(ctx, uow, c); err != nil {
+		l.Errorw("code.create_email_change_code_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("code.create_email_change_code.success")
+	return c, nil
+}
+
+// CheckApplicantEmailChangeCode returns the address the code was issued for.
+func (s *service) CheckApplicantEmailChangeCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant, rawCode string,
+) (string, bool, error) {
+	l := s.log.With("op", "check_applicant_email_change_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	code, err := s.dataProvider.GetApplicantEmailChangeCode(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("code.check_email_change_code_failed", "err", err)
+		return "", false, err
+	}
+	if code == nil {
+		l.Errorw("code.check_email_change_code_failed", "err", "email change code not found")
+		return "", false, nil
+	}
+
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveApplicantEmailChangeCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_email_change_code_failed", "err", saveErr)
+			return "", false, saveErr
+		}
+	}
+	if err != nil {
+		l.Warnw("code.check_email_change_code_failed", "err", err)
+		return "", false, err
+	}
+	if !valid {
+		l.Warnw("code.check_email_change_code_failed", "err", "invalid code")
+		return "", false, nil
+	}
+
+	if err := s.dataProvider.DeleteApplicantEmailChangeCode(ctx, uow, code); err != nil {
+		l.Errorw("code.check_email_change_code_failed", "err", err)
+	}
+
+	l.Infow("code.check_email_change_code.success")
+	return code.NewEmail(), true, nil
+}
+
+// CreateEmployerEmailChangeCode issues a code bound to newEmail. A pending code is
+// regenerated, so the resend limit also covers switching the address.
+func (s *service) CreateEmployerEmailChangeCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer, newEmail string,
+) (*code.Code, error) {
+	l := s.log.With("op", "create_employer_email_change_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	var c *code.Code
+	existedCode, err := s.dataProvider.GetEmployerEmailChangeCode(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("code.create_email_change_code_failed", "err", err)
+		return nil, err
+	}
+	if existedCode != nil {
+		c = existedCode
+		err = c.GenerateCode(s.hasher)
+		if err != nil {
+			l.Warnw("code.create_email_change_code_failed", "err", err)
+			return nil, err
+		}
+	} else {
+		c, err = code.New(employer.Id, s.hasher)
+		if err != nil {
+			l.Errorw("code.create_email_change_code_failed", "err", err)
+			return nil, err
+		}
+	}
+	c.SetNewEmail(newEmail)
+
+	if err := s.dataProvider.SaveEmployerEmailChangeCode(ctx, uow, c); err != nil {
+		l.Errorw("code.create_email_change_code_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("code.create_email_change_code.success")
+	return c, nil
+}
+
+// CheckEmployerEmailChangeCode returns the address the code was issued for.
+func (s *service) CheckEmployerEmailChangeCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer, rawCode string,
+) (string, bool, error) {
+	l := s.log.With("op", "check_employer_email_change_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	code, err := s.dataProvider.GetEmployerEmailChangeCode(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("code.check_email_change_code_failed", "err", err)
+		return "", false, err
+	}
+	if code == nil {
+		l.Errorw("code.check_email_change_code_failed", "err", "email change code not found")
+		return "", false, nil
+	}
+
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveEmployerEmailChangeCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_email_change_code_failed", "err", saveErr)
+			return "", false, saveErr
+		}
+	}
+	if err != nil {
+		l.Warnw("code.check_email_change_code_failed", "err", err)
+		return "", false, err
+	}
+	if !valid {
+		l.Warnw("code.check_email_change_code_failed", "err", "invalid code")
+		return "", false, nil
+	}
+
+	if err := s.dataProvider.DeleteEmployerEmailChangeCode(ctx, uow, code); err != nil {
+		l.Errorw("code.check_email_change_code_failed", "err", err)
+	}
+
+	l.Infow("code.check_email_change_code.success")
+	return code.NewEmail(), true, nil
+}
+
+func (s *service) RegenerateApplicantLoginCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant,
+) (*code.Code, error) {
+	l := s.log.With("op", "regenerate_applicant_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	var c *code.Code
+	existedCode, err := s.dataProvider.GetApplicantLoginCode(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("code.regenerate_login_code_failed", "err", err)
+		return nil, err
+	}
+	if existedCode != nil {
+		c = existedCode
+		err = c.GenerateCode(s.hasher)
+		if err != nil {
+			l.Warnw("code.regenerate_login_code_failed", "err", err)
+			return nil, err
+		}
+	} else {
+		c, err = code.New(applicant.Id, s.hasher)
+		if err != nil {
+			l.Errorw("code.regenerate_login_code_failed", "err", err)
+			return nil, err
+		}
+	}
+
+	if err := s.dataProvider.SaveApplicantLoginCode(ctx, uow, c); err != nil {
+		l.Errorw("code.regenerate_login_code_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("code.regenerate_login_code.success")
+	return c, nil
+}
+
+func (s *service) CheckApplicantLoginCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant, rawCode string,
+) (bool, error) {
+	l := s.log.With("op", "check_applicant_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	code, err := s.dataProvider.GetApplicantLoginCode(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("code.check_login_code_failed", "err", err)
+		return false, err
+	}
+	if code == nil {
+		l.Errorw("code.check_login_code_failed", "err", "login code not found")
+		return false, nil
+	}
+
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveApplicantLoginCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_login_code_failed", "err", saveErr)
+			return false, saveErr
+		}
+	}
+	if err != nil {
+		l.Warnw("code.check_login_code_failed", "err", err)
+		return false, err
+	}
+	if !valid {
+		l.Warnw("code.check_login_code_failed", "err", "invalid code")
+		return false, nil
+	}
+
+	if err := s.dataProvider.DeleteApplicantLoginCode(ctx, uow, code); err != nil {
+		l.Errorw("code.check_login_code_failed", "err", err)
+	}
+
+	l.Infow("code.check_login_code.success")
+	return true, nil
+}
+
+func (s *service) RegenerateEmployerLoginCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer,
+) (*code.Code, error) {
+	l := s.log.With("op", "regenerate_employer_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	var c *code.Code
+	existedCode, err := s.dataProvider.GetEmployerLoginCode(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("code.regenerate_login_code_failed", "err", err)
+		return nil, err
+	}
+	if existedCode != nil {
+		c = existedCode
+		err = c.GenerateCode(s.hasher)
+		if err != nil {
+			l.Warnw("code.regenerate_login_code_failed", "err", err)
+			return nil, err
+		}
+	} else {
+		c, err = code.New(employer.Id, s.hasher)
+		if err != nil {
+			l.Errorw("code.regenerate_login_code_failed", "err", err)
+			return nil, err
+		}
+	}
+
+	if err := s.dataProvider.SaveEmployerLoginCode(ctx, uow, c); err != nil {
+		l.Errorw("code.regenerate_login_code_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("code.regenerate_login_code.success")
+	return c, nil
+}
+
+func (s *service) CheckEmployerLoginCode(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer, rawCode string,
+) (bool, error) {
+	l := s.log.With("op", "check_employer_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	code, err := s.dataProvider.GetEmployerLoginCode(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("code.check_login_code_failed", "err", err)
+		return false, err
+	}
+	if code == nil {
+		l.Errorw("code.check_login_code_failed", "err", "login code not found")
+		return false, nil
+	}
+
+	valid, err := code.CheckCode(rawCode, s.hasher)
+	if err != nil || !valid {
+		// persist the failed attempt, the code may have been invalidated
+		if saveErr := s.dataProvider.SaveEmployerLoginCode(ctx, uow, code); saveErr != nil {
+			l.Errorw("code.check_login_code_failed", "err", saveErr)
+			return false, saveErr
+		}
+	}
+	if err != nil {
+		l.Warnw("code.check_login_code_failed", "err", err)
+		return false, err
+	}
+	if !valid {
+		l.Warnw("code.check_login_code_failed", "err", "invalid code")
+		return false, nil
+	}
+
+	if err := s.dataProvider.DeleteEmployerLoginCode(ctx, uow, code); err != nil {
+		l.Errorw("code.check_login_code_failed", "err", err)
+	}
+
+	l.Infow("code.check_login_code.success")
+	return true, nil
+}
+
+// codeKind reads and removes one kind of code of a user.
+type codeKind struct {
+	get func(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*code.Code, error)
+	del func(ctx context.Context, uow *uow.UnitOfWork, code *code.Code) error
+}
+
+// DeleteApplicantCodes removes every pending code of the applicant.
+func (s *service) DeleteApplicantCodes(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error {
+	l := s.log.With("op", "delete_applicant_codes", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	kinds := []codeKind{
+		{s.dataProvider.GetApplicantActivationCode, s.dataProvider.DeleteApplicantActivationCode},
+		{s.dataProvider.GetApplicantResetPasswordCode, s.dataProvider.DeleteApplicantResetPasswordCode},
+		{s.dataProvider.GetApplicantEmailChangeCode, s.dataProvider.DeleteApplicantEmailChangeCode},
+		{s.dataProvider.GetApplicantLoginCode, s.dataProvider.DeleteApplicantLoginCode},
+	}
+	for _, kind := range kinds {
+		c, err := kind.get(ctx, uow, applicant.Id)
+		if err != nil {
+			l.Errorw("code.delete_codes_failed", "err", err)
+			return err
+		}
+		if c == nil {
+			continue
+		}
+		if err := kind.del(ctx, uow, c); err != nil {
+			l.Errorw("code.delete_codes_failed", "err", err)
+			return err
+		}
+	}
+
+	l.Infow("code.delete_codes.success")
+	return nil
+}
+
+// DeleteEmployerCodes removes every pending code of the employer.
+func (s *service) DeleteEmployerCodes(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error {
+	l := s.log.With("op", "delete_employer_codes", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	kinds := []codeKind{
+		{s.dataProvider.GetEmployerActivationCode, s.dataProvider.DeleteEmployerActivationCode},
+		{s.dataProvider.GetEmployerResetPasswordCode, s.dataProvider.DeleteEmployerResetPasswordCode},
+		{s.dataProvider.GetEmployerEmailChangeCode, s.dataProvider.DeleteEmployerEmailChangeCode},
+		{s.dataProvider.GetEmployerLoginCode, s.dataProvider.DeleteEmployerLoginCode},
+	}
+	for _, kind := range kinds {
+		c, err := kind.get(ctx, uow, employer.Id)
+		if err != nil {
+			l.Errorw("code.delete_codes_failed", "err", err)
+			return err
+		}
+		if c == nil {
+			continue
+		}
+		if err := kind.del(ctx, uow, c); err != nil {
+			l.Errorw("code.delete_codes_failed", "err", err)
+			return err
+		}
+	}
+
+	l.Infow("code.delete_codes.success")
+	return nil
+}
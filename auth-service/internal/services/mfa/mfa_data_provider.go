@@ -0,0 +1,95 @@
+package mfaservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/mfa"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
+	repo "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl/postgres"
+	cache "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl/redis"
+	dal "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+)
+
+type mfaDataProvider struct {
+	redis *redis.RedisClient
+}
+
+func newMfaDataProvider(redis *redis.RedisClient) *mfaDataProvider {
+	return &mfaDataProvider{
+		redis: redis,
+	}
+}
+
+func (p *mfaDataProvider) GetApplicantMfa(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*mfa.Mfa, error) {
+	return p.get(ctx, uow, userId, repo.ApplicantMfaRepository)
+}
+
+func (p *mfaDataProvider) GetEmployerMfa(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*mfa.Mfa, error) {
+	return p.get(ctx, uow, userId, repo.EmployerMfaRepository)
+}
+
+func (p *mfaDataProvider) SaveApplicantMfa(ctx context.Context, uow *uow.UnitOfWork, mfa *mfa.Mfa) error {
+	return p.save(ctx, uow, mfa, repo.ApplicantMfaRepository)
+}
+
+func (p *mfaDataProvider) SaveEmployerMfa(ctx context.Context, uow *uow.UnitOfWork, mfa *mfa.Mfa) error {
+	return p.save(ctx, uow, mfa, repo.EmployerMfaRepository)
+}
+
+func (p *mfaDataProvider) DeleteApplicantMfa(ctx context.Context, uow *uow.UnitOfWork, mfa *mfa.Mfa) error {
+	return repo.NewMfaRepository(uow, repo.ApplicantMfaRepository).DeleteMfa(ctx, mfa)
+}
+
+func (p *mfaDataProvider) DeleteEmployerMfa(ctx context.Context, uow *uow.UnitOfWork, mfa *mfa.Mfa) error {
+	return repo.NewMfaRepository(uow, repo.EmployerMfaRepository).DeleteMfa(ctx, mfa)
+}
+
+func (p *mfaDataProvider) SetApplicantChallenge(ctx context.Context, tokenHash string, userId int64, ttl time.Duration) error {
+	return cache.NewMfaChallengeCacheRepository(p.redis, cache.ApplicantMfaChallengeCache).Set(ctx, tokenHash, userId, ttl)
+}
+
+func (p *mfaDataProvider) SetEmployerChallenge(ctx context.Context, tokenHash string, userId int64, ttl time.Duration) error {
+	return cache.NewMfaChallengeCacheRepository(p.redis, cache.EmployerMfaChallengeCache).Set(ctx, tokenHash, userId, ttl)
+}
+
+func (p *mfaDataProvider) GetApplicantChallenge(ctx context.Context, tokenHash string) (int64, error) {
+	return cache.NewMfaChallengeCacheRepository(p.redis, cache.ApplicantMfaChallengeCache).GetUserId(ctx, tokenHash)
+}
+
+func (p *mfaDataProvider) GetEmployerChallenge(ctx context.Context, tokenHash string) (int64, error) {
+	return cache.NewMfaChallengeCacheRepository(p.redis, cache.EmployerMfaChallengeCache).GetUserId(ctx, tokenHash)
+}
+
+func (p *mfaDataProvider) DeleteApplicantChallenge(ctx context.Context, tokenHash string) error {
+	return cache.NewMfaChallengeCacheRepository(p.redis, cache.ApplicantMfaChallengeCache).Del(ctx, tokenHash)
+}
+
+func (p *mfaDataProvider) DeleteEmployerChallenge(ctx context.Context, tokenHash string) error {
+	return cache.NewMfaChallengeCacheRepository(p.redis, cache.EmployerMfaChallengeCache).Del(ctx, tokenHash)
+}
+
+func (p *mfaDataProvider) get(
+	ctx context.Context, uow *uow.UnitOfWork,
+	userId int64,
+	repoType impl.RepositoryType,
+) (*mfa.Mfa, error) {
+	dbRepo := repo.NewMfaRepository(uow, repoType)
+	query := dal.NewQueryMfaDal(nil, &userId)
+	return dbRepo.QueryMfa(ctx, query)
+}
+
+func (p *mfaDataProvider) save(
+	ctx context.Context, uow *uow.UnitOfWork,
+	mfa *mfa.Mfa,
+	repoType impl.RepositoryType,
+) error {
+	dbRepo := repo.NewMfaRepository(uow, repoType)
+
+	if mfa.Id() == 0 {
+		return dbRepo.CreateMfa(ctx, mfa)
+	}
+	return dbRepo.UpdateMfa(ctx, mfa)
+}
@@ -0,0 +1,553 @@
+package mfaservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/mfa"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrMfaAlreadyEnabled = errors.New("mfa already enabled")
+	ErrMfaNotEnabled     = errors.New("mfa not enabled")
+	ErrMfaNotEnrolled    = errors.New("mfa not enrolled")
+)
+
+type MfaService interface {
+	EnrollApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) (*mfa.Mfa, error)
+	EnrollEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) (*mfa.Mfa, error)
+	ConfirmApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, rawCode string) ([]string, error)
+	ConfirmEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawCode string) ([]string, error)
+	DisableApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, rawCode string) (bool, error)
+	DisableEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawCode string) (bool, error)
+	VerifyApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, rawCode string) (bool, error)
+	VerifyEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawCode string) (bool, error)
+	IsApplicantEnabled(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) (bool, error)
+	IsEmployerEnabled(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) (bool, error)
+	DeleteApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error
+	DeleteEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error
+
+	CreateApplicantChallenge(ctx context.Context, applicant *pb.Applicant) (string, error)
+	CreateEmployerChallenge(ctx context.Context, employer *pb.Employer) (string, error)
+	GetApplicantChallenge(ctx context.Context, mfaToken string) (int64, error)
+	GetEmployerChallenge(ctx context.Context, mfaToken string) (int64, error)
+	DeleteApplicantChallenge(ctx context.Context, mfaToken string) error
+	DeleteEmployerChallenge(ctx context.Context, mfaToken string) error
+
+	ProvisioningURI(m *mfa.Mfa, account string) string
+}
+
+type service struct {
+	dataProvider       *mfaDataProvider
+	cipher             *secret.Cipher
+	hasher             *secret.Hasher
+	issuer             string
+	challengeTTL       time.Duration
+	recoveryCodesCount int
+	log                *zap.SugaredLogger
+}
+
+func New(
+	redis *redis.RedisClient,
+	cipher *secret.Cipher, hasher *secret.Hasher,
+	cfg settings.MfaSettings,
+	log *zap.SugaredLogger,
+) MfaService {
+	return &service{
+		dataProvider:       newMfaDataProvider(redis),
+		cipher:             cipher,
+		hasher:             hasher,
+		issuer:             cfg.Issuer,
+		challengeTTL:       time.Duration(cfg.ChallengeTTL) * time.Second,
+		recoveryCodesCount: int(cfg.RecoveryCodesCount),
+		log:                log,
+	}
+}
+
+func (s *service) EnrollApplicant(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant,
+) (*mfa.Mfa, error) {
+	l := s.log.With("op", "enroll_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	existedMfa, err := s.dataProvider.GetApplicantMfa(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("mfa.enroll_mfa_failed", "err", err)
+		return nil, err
+	}
+	if existedMfa != nil && existedMfa.IsConfirmed() {
+		l.Warnw("mfa.enroll_mfa_failed", "err", ErrMfaAlreadyEnabled)
+		return nil, ErrMfaAlreadyEnabled
+	}
+
+	mfa, err := mfa.New(applicant.Id, s.cipher)
+	if err != nil {
+		l.Errorw("mfa.enroll_mfa_failed", "err", err)
+		return nil, err
+	}
+	// an unconfirmed enrollment is simply restarted with a new secret
+	if existedMfa != nil {
+		mfa.SetId(existedMfa.Id())
+	}
+
+	if err := s.dataProvider.SaveApplicantMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.enroll_mfa_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("mfa.enroll_mfa.success")
+	return mfa, nil
+}
+
+// ConfirmApplicant enables the enrolled mfa when rawCode is a valid TOTP code and
+// returns the recovery codes. Nil codes mean that rawCode is invalid.
+func (s *service) ConfirmApplicant(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant, rawCode string,
+) ([]string, error) {
+	l := s.log.With("op", "confirm_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetApplicantMfa(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+	if mfa == nil {
+		l.Warnw("mfa.confirm_mfa_failed", "err", ErrMfaNotEnrolled)
+		return nil, ErrMfaNotEnrolled
+	}
+	if mfa.IsConfirmed() {
+		l.Warnw("mfa.confirm_mfa_failed", "err", ErrMfaAlreadyEnabled)
+		return nil, ErrMfaAlreadyEnabled
+	}
+
+	valid, err := mfa.CheckTOTP(rawCode, s.cipher)
+	if err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+	if !valid {
+		l.Warnw("mfa.confirm_mfa_failed", "err", "invalid code")
+		return nil, nil
+	}
+
+	recoveryCodes, err := mfa.Confirm(s.recoveryCodesCount, s.hasher)
+	if err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+
+	if err := s.dataProvider.SaveApplicantMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("mfa.confirm_mfa.success")
+	return recoveryCodes, nil
+}
+
+func (s *service) DisableApplicant(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant, rawCode string,
+) (bool, error) {
+	l := s.log.With("op", "disable_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetApplicantMfa(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("mfa.disable_mfa_failed", "err", err)
+		return false, err
+	}
+	if mfa == nil || !mfa.IsConfirmed() {
+		l.Warnw("mfa.disable_mfa_failed", "err", ErrMfaNotEnabled)
+		return false, ErrMfaNotEnabled
+	}
+
+	valid, err := mfa.Verify(rawCode, s.cipher, s.hasher)
+	if err != nil {
+		l.Errorw("mfa.disable_mfa_failed", "err", err)
+		return false, err
+	}
+	if !valid {
+		l.Warnw("mfa.disable_mfa_failed", "err", "invalid code")
+		return false, nil
+	}
+
+	if err := s.dataProvider.DeleteApplicantMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.disable_mfa_failed", "err", err)
+		return false, err
+	}
+
+	l.Infow("mfa.disable_mfa.success")
+	return true, nil
+}
+
+func (s *service) VerifyApplicant(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant, rawCode string,
+) (bool, error) {
+	l := s.log.With("op", "verify_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetApplicantMfa(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("mfa.verify_mfa_failed", "err", err)
+		return false, err
+	}
+	if mfa == nil || !mfa.IsConfirmed() {
+		l.Warnw("mfa.verify_mfa_failed", "err", ErrMfaNotEnabled)
+		return false, ErrMfaNotEnabled
+	}
+
+	valid, err := mfa.Verify(rawCode, s.cipher, s.hasher)
+	if err != nil {
+		l.Errorw("mfa.verify_mfa_failed", "err", err)
+		return false, err
+	}
+	if !valid {
+		l.Warnw("mfa.verify_mfa_failed", "err", "invalid code")
+		return false, nil
+	}
+
+	// persist the used step or the consumed recovery code
+	if err := s.dataProvider.SaveApplicantMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.verify_mfa_failed", "err", err)
+		return false, err
+	}
+
+	l.Infow("mfa.verify_mfa.success", "recovery_codes_left", len(mfa.RecoveryCodeHashes()))
+	return true, nil
+}
+
+func (s *service) IsApplicantEnabled(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) (bool, error) {
+	l := s.log.With("op", "is_applicant_mfa_enabled", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetApplicantMfa(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("mfa.is_mfa_enabled_failed", "err", err)
+		return false, err
+	}
+	return mfa != nil && mfa.IsConfirmed(), nil
+}
+
+// CreateApplicantChallenge returns the short-lived mfa token that stands for a
+// login waiting for the second factor.
+func (s *service) CreateApplicantChallenge(ctx context.Context, applicant *pb.Applicant) (string, error) {
+	l := s.log.With("op", "create_applicant_mfa_challenge", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfaToken, err := generateMfaToken()
+	if err != nil {
+		l.Errorw("mfa.create_challenge_failed", "err", err)
+		return "", err
+	}
+
+	if err := s.dataProvider.SetApplicantChallenge(ctx, s.hasher.Hash(mfaToken), applicant.Id, s.challengeTTL); err != nil {
+		l.Errorw("mfa.create_challenge_failed", "err", err)
+		return "", err
+	}
+
+	l.Infow("mfa.create_challenge.success")
+	return mfaToken, nil
+}
+
+// GetApplicantChallenge returns the id of the user behind mfaToken, zero when the token is unknown or expired.
+func (s *service) GetApplicantChallenge(ctx context.Context, mfaToken string) (int64, error) {
+	l := s.log.With("op", "get_applicant_mfa_challenge", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if mfaToken == "" {
+		return 0, nil
+	}
+
+	userId, err := s.dataProvider.GetApplicantChallenge(ctx, s.hasher.Hash(mfaToken))
+	if err != nil {
+		l.Errorw("mfa.get_challenge_failed", "err", err)
+		return 0, err
+	}
+	return userId, nil
+}
+
+func (s *service) DeleteApplicantChallenge(ctx context.Context, mfaToken string) error {
+	l := s.log.With("op", "delete_applicant_mfa_challenge", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.dataProvider.DeleteApplicantChallenge(ctx, s.hasher.Hash(mfaToken)); err != nil {
+		l.Errorw("mfa.delete_challenge_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (s *service) EnrollEmployer(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer,
+) (*mfa.Mfa, error) {
+	l := s.log.With("op", "enroll_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	existedMfa, err := s.dataProvider.GetEmployerMfa(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("mfa.enroll_mfa_failed", "err", err)
+		return nil, err
+	}
+	if existedMfa != nil && existedMfa.IsConfirmed() {
+		l.Warnw("mfa.enroll_mfa_failed", "err", ErrMfaAlreadyEnabled)
+		return nil, ErrMfaAlreadyEnabled
+	}
+
+	mfa, err := mfa.New(employer.Id, s.cipher)
+	if err != nil {
+		l.Errorw("mfa.enroll_mfa_failed", "err", err)
+		return nil, err
+	}
+	// an unconfirmed enrollment is simply restarted with a new secret
+	if existedMfa != nil {
+		mfa.SetId(existedMfa.Id())
+	}
+
+	if err := s.dataProvider.SaveEmployerMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.enroll_mfa_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("mfa.enroll_mfa.success")
+	return mfa, nil
+}
+
+// ConfirmEmployer enables the enrolled mfa when rawCode is a valid TOTP code and
+// returns the recovery codes. Nil codes mean that rawCode is invalid.
+func (s *service) ConfirmEmployer(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer, rawCode string,
+) ([]string, error) {
+	l := s.log.With("op", "confirm_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetEmployerMfa(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+	if mfa == nil {
+		l.Warnw("mfa.confirm_mfa_failed", "err", ErrMfaNotEnrolled)
+		return nil, ErrMfaNotEnrolled
+	}
+	if mfa.IsConfirmed() {
+		l.Warnw("mfa.confirm_mfa_failed", "err", ErrMfaAlreadyEnabled)
+		return nil, ErrMfaAlreadyEnabled
+	}
+
+	valid, err := mfa.CheckTOTP(rawCode, s.cipher)
+	if err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+	if !valid {
+		l.Warnw("mfa.confirm_mfa_failed", "err", "invalid code")
+		return nil, nil
+	}
+
+	recoveryCodes, err := mfa.Confirm(s.recoveryCodesCount, s.hasher)
+	if err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+
+	if err := s.dataProvider.SaveEmployerMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.confirm_mfa_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("mfa.confirm_mfa.success")
+	return recoveryCodes, nil
+}
+
+func (s *service) DisableEmployer(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer, rawCode string,
+) (bool, error) {
+	l := s.log.With("op", "disable_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetEmployerMfa(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("mfa.disable_mfa_failed", "err", err)
+		return false, err
+	}
+	if mfa == nil || !mfa.IsConfirmed() {
+		l.Warnw("mfa.disable_mfa_failed", "err", ErrMfaNotEnabled)
+		return false, ErrMfaNotEnabled
+	}
+
+	valid, err := mfa.Verify(rawCode, s.cipher, s.hasher)
+	if err != nil {
+		l.Errorw("mfa.disable_mfa_failed", "err", err)
+		return false, err
+	}
+	if !valid {
+		l.Warnw("mfa.disable_mfa_failed", "err", "invalid code")
+		return false, nil
+	}
+
+	if err := s.dataProvider.DeleteEmployerMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.disable_mfa_failed", "err", err)
+		return false, err
+	}
+
+	l.Infow("mfa.disable_mfa.success")
+	return true, nil
+}
+
+func (s *service) VerifyEmployer(
+	ctx context.Context, uow *uow.UnitOfWork,
+	employer *pb.Employer, rawCode string,
+) (bool, error) {
+	l := s.log.With("op", "verify_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetEmployerMfa(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("mfa.verify_mfa_failed", "err", err)
+		return false, err
+	}
+	if mfa == nil || !mfa.IsConfirmed() {
+		l.Warnw("mfa.verify_mfa_failed", "err", ErrMfaNotEnabled)
+		return false, ErrMfaNotEnabled
+	}
+
+	valid, err := mfa.Verify(rawCode, s.cipher, s.hasher)
+	if err != nil {
+		l.Errorw("mfa.verify_mfa_failed", "err", err)
+		return false, err
+	}
+	if !valid {
+		l.Warnw("mfa.verify_mfa_failed", "err", "invalid code")
+		return false, nil
+	}
+
+	// persist the used step or the consumed recovery code
+	if err := s.dataProvider.SaveEmployerMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.verify_mfa_failed", "err", err)
+		return false, err
+	}
+
+	l.Infow("mfa.verify_mfa.success", "recovery_codes_left", len(mfa.RecoveryCodeHashes()))
+	return true, nil
+}
+
+func (s *service) IsEmployerEnabled(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) (bool, error) {
+	l := s.log.With("op", "is_employer_mfa_enabled", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetEmployerMfa(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("mfa.is_mfa_enabled_failed", "err", err)
+		return false, err
+	}
+	return mfa != nil && mfa.IsConfirmed(), nil
+}
+
+// CreateEmployerChallenge returns the short-lived mfa token that stands for a
+// login waiting for the second factor.
+func (s *service) CreateEmployerChallenge(ctx context.Context, employer *pb.Employer) (string, error) {
+	l := s.log.With("op", "create_employer_mfa_challenge", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfaToken, err := generateMfaToken()
+	if err != nil {
+		l.Errorw("mfa.create_challenge_failed", "err", err)
+		return "", err
+	}
+
+	if err := s.dataProvider.SetEmployerChallenge(ctx, s.hasher.Hash(mfaToken), employer.Id, s.challengeTTL); err != nil {
+		l.Errorw("mfa.create_challenge_failed", "err", err)
+		return "", err
+	}
+
+	l.Infow("mfa.create_challenge.success")
+	return mfaToken, nil
+}
+
+// GetEmployerChallenge returns the id of the user behind mfaToken, zero when the token is unknown or expired.
+func (s *service) GetEmployerChallenge(ctx context.Context, mfaToken string) (int64, error) {
+	l := s.log.With("op", "get_employer_mfa_challenge", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if mfaToken == "" {
+		return 0, nil
+	}
+
+	userId, err := s.dataProvider.GetEmployerChallenge(ctx, s.hasher.Hash(mfaToken))
+	if err != nil {
+		l.Errorw("mfa.get_challenge_failed", "err", err)
+		return 0, err
+	}
+	return userId, nil
+}
+
+func (s *service) DeleteEmployerChallenge(ctx context.Context, mfaToken string) error {
+	l := s.log.With("op", "delete_employer_mfa_challenge", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.dataProvider.DeleteEmployerChallenge(ctx, s.hasher.Hash(mfaToken)); err != nil {
+		l.Errorw("mfa.delete_challenge_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteApplicant removes the mfa of the applicant without a code, the caller has
+// already verified the applicant some other way.
+func (s *service) DeleteApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error {
+	l := s.log.With("op", "delete_applicant_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetApplicantMfa(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("mfa.delete_mfa_failed", "err", err)
+		return err
+	}
+	if mfa == nil {
+		return nil
+	}
+
+	if err := s.dataProvider.DeleteApplicantMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.delete_mfa_failed", "err", err)
+		return err
+	}
+
+	l.Infow("mfa.delete_mfa.success")
+	return nil
+}
+
+// DeleteEmployer removes the mfa of the employer without a code, the caller has
+// already verified the employer some other way.
+func (s *service) DeleteEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error {
+	l := s.log.With("op", "delete_employer_mfa", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	mfa, err := s.dataProvider.GetEmployerMfa(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("mfa.delete_mfa_failed", "err", err)
+		return err
+	}
+	if mfa == nil {
+		return nil
+	}
+
+	if err := s.dataProvider.DeleteEmployerMfa(ctx, uow, mfa); err != nil {
+		l.Errorw("mfa.delete_mfa_failed", "err", err)
+		return err
+	}
+
+	l.Infow("mfa.delete_mfa.success")
+	return nil
+}
+
+func (s *service) ProvisioningURI(m *mfa.Mfa, account string) string {
+	return m.ProvisioningURI(s.issuer, account)
+}
+
+func generateMfaToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
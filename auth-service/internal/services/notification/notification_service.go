@@ -0,0 +1,242 @@
+package notificationservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/code"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/notifier"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"go.uber.org/zap"
+)
+
+type NotificationService interface {
+	SendApplicantActivationCode(ctx context.Context, applicant *pb.Applicant, code *code.Code) error
+	SendEmployerActivationCode(ctx context.Context, employer *pb.Employer, code *code.Code) error
+	// Reset password and login codes are requested by email alone, so they are sent
+	// in the background: the caller must not be able to tell from the response or
+	// its latency whether the address belongs to an account.
+	QueueApplicantResetPasswordCode(ctx context.Context, applicant *pb.Applicant, code *code.Code)
+	QueueEmployerResetPasswordCode(ctx context.Context, employer *pb.Employer, code *code.Code)
+	QueueApplicantLoginCode(ctx context.Context, applicant *pb.Applicant, code *code.Code)
+	QueueEmployerLoginCode(ctx context.Context, employer *pb.Employer, code *code.Code)
+	SendApplicantEmailChangeCode(ctx context.Context, applicant *pb.Applicant, code *code.Code) error
+	SendEmployerEmailChangeCode(ctx context.Context, employer *pb.Employer, code *code.Code) error
+}
+
+type service struct {
+	notifier     notifier.Notifier
+	retriesCount uint
+	retryDelay   time.Duration
+	sendTimeout  time.Duration
+	pending      chan struct{}
+	log          *zap.SugaredLogger
+}
+
+func New(cfg settings.NotifierSettings, n notifier.Notifier, log *zap.SugaredLogger) NotificationService {
+	return &service{
+		notifier:     n,
+		retriesCount: cfg.RetriesCount,
+		retryDelay:   time.Duration(cfg.RetryDelay) * time.Millisecond,
+		sendTimeout:  time.Duration(cfg.SendTimeout) * time.Second,
+		pending:      make(chan struct{}, max(cfg.MaxPending, 1)),
+		log:          log,
+	}
+}
+
+func (s *service) SendApplicantActivationCode(ctx context.Context, applicant *pb.Applicant, code *code.Code) error {
+	l := s.log.With("op", "send_applicant_activation_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	msg := activationCodeMessage(applicant.Email, applicantName(applicant), code)
+	if err := s.send(ctx, l, msg); err != nil {
+		l.Errorw("notification.send_activation_code_failed", "err", err)
+		return err
+	}
+
+	l.Infow("notification.send_activation_code.success")
+	return nil
+}
+
+func (s *service) SendEmployerActivationCode(ctx context.Context, employer *pb.Employer, code *code.Code) error {
+	l := s.log.With("op", "send_employer_activation_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	msg := activationCodeMessage(employer.Email, employer.CompanyName, code)
+	if err := s.send(ctx, l, msg); err != nil {
+		l.Errorw("notification.send_activation_code_failed", "err", err)
+		return err
+	}
+
+	l.Infow("notification.send_activation_code.success")
+	return nil
+}
+
+func (s *service) QueueApplicantResetPasswordCode(ctx context.Context, applicant *pb.Applicant, code *code.Code) {
+	l := s.log.With("op", "send_applicant_reset_password_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	msg := resetPasswordCodeMessage(applicant.Email, applicantName(applicant), code)
+	s.sendInBackground(ctx, l, msg, "notification.send_reset_password_code")
+}
+
+func (s *service) QueueEmployerResetPasswordCode(ctx context.Context, employer *pb.Employer, code *code.Code) {
+	l := s.log.With("op", "send_employer_reset_password_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	msg := resetPasswordCodeMessage(employer.Email, employer.CompanyName, code)
+	s.sendInBackground(ctx, l, msg, "notification.send_reset_password_code")
+}
+
+func (s *service) SendApplicantEmailChangeCode(ctx context.Context, applicant *pb.Applicant, code *code.Code) error {
+	l := s.log.With("op", "send_applicant_email_change_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	msg := emailChangeCodeMessage(code.NewEmail(), applicantName(applicant), code)
+	if err := s.send(ctx, l, msg); err != nil {
+		l.Errorw("notification.send_email_change_code_failed", "err", err)
+		return err
+	}
+
+	l.Infow("notification.send_email_change_code.success")
+	return nil
+}
+
+func (s *service) SendEmployerEmailChangeCode(ctx context.Context, employer *pb.Employer, code *code.Code) error {
+	l := s.log.With("op", "send_employer_email_change_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	msg := emailChangeCodeMessage(code.NewEmail(), employer.CompanyName, code)
+	if err := s.send(ctx, l, msg); err != nil {
+		l.Errorw("notification.send_email_change_code_failed", "err", err)
+		return err
+	}
+
+	l.Infow("notification.send_email_change_code.success")
+	return nil
+}
+
+func (s *service) QueueApplicantLoginCode(ctx context.Context, applicant *pb.Applicant, code *code.Code) {
+	l := s.log.With("op", "send_applicant_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	msg := loginCodeMessage(applicant.Email, applicantName(applicant), code)
+	s.sendInBackground(ctx, l, msg, "notification.send_login_code")
+}
+
+func (s *service) QueueEmployerLoginCode(ctx context.Context, employer *pb.Employer, code *code.Code) {
+	l := s.log.With("op", "send_employer_login_code", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	msg := loginCodeMessage(employer.Email, employer.CompanyName, code)
+	s.sendInBackground(ctx, l, msg, "notification.send_login_code")
+}
+
+func (s *service) send(ctx context.Context, l *zap.SugaredLogger, msg *notifier.Message) error {
+	var err error
+	delay := s.retryDelay
+
+	for attempt := uint(0); attempt <= s.retriesCount; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, s.sendTimeout)
+		err = s.notifier.Send(sendCtx, msg)
+		cancel()
+		if err == nil {
+			return nil
+		}
+
+		l.Warnw("notification.send_attempt_failed", "attempt", attempt+1, "err", err)
+	}
+
+	return err
+}
+
+// sendInBackground sends msg with retries after the request has returned. The
+// request's cancellation is dropped but its values are kept for logging. When
+// too many messages are already in flight the message is dropped; the user can
+// request a new code.
+func (s *service) sendInBackground(ctx context.Context, l *zap.SugaredLogger, msg *notifier.Message, event string) {
+	select {
+	case s.pending <- struct{}{}:
+	default:
+		l.Errorw(event+"_failed", "err", "too many pending notifications")
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-s.pending }()
+
+		if err := s.send(ctx, l, msg); err != nil {
+			l.Errorw(event+"_failed", "err", err)
+			return
+		}
+		l.Infow(event + ".success")
+	}()
+}
+
+func activationCodeMessage(email, name string, c *code.Code) *notifier.Message {
+	return &notifier.Message{
+		To:      email,
+		ToName:  name,
+		Subject: "Account activation code",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nYour account activation code: %s\nThe code is valid for %d minutes.\n\nIf you did not register, just ignore this email.",
+			name, c.Code(), minutesLeft(c),
+		),
+	}
+}
+
+func resetPasswordCodeMessage(email, name string, c *code.Code) *notifier.Message {
+	return &notifier.Message{
+		To:      email,
+		ToName:  name,
+		Subject: "Password reset code",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nYour password reset code: %s\nThe code is valid for %d minutes.\n\nIf you did not request a password reset, just ignore this email.",
+			name, c.Code(), minutesLeft(c),
+		),
+	}
+}
+
+func loginCodeMessage(email, name string, c *code.Code) *notifier.Message {
+	return &notifier.Message{
+		To:      email,
+		ToName:  name,
+		Subject: "Login code",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nYour login code: %s\nThe code is valid for %d minutes.\n\nIf you did not try to log in, just ignore this email.",
+			name, c.Code(), minutesLeft(c),
+		),
+	}
+}
+
+// emailChangeCodeMessage is sent to the new address, so receiving it proves the
+// user owns the mailbox.
+func emailChangeCodeMessage(email, name string, c *code.Code) *notifier.Message {
+	return &notifier.Message{
+		To:      email,
+		ToName:  name,
+		Subject: "Email change code",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nYour email change confirmation code: %s\nThe code is valid for %d minutes.\n\nIf you did not request an email change, just ignore this email.",
+			name, c.Code(), minutesLeft(c),
+		),
+	}
+}
+
+func applicantName(applicant *pb.Applicant) string {
+	parts := []string{applicant.FirstName}
+	if applicant.Patronymic != nil && *applicant.Patronymic != "" {
+		parts = append(parts, *applicant.Patronymic)
+	}
+	return strings.Join(parts, " ")
+}
+
+func minutesLeft(c *code.Code) int {
+	return int(time.Until(c.ExpiresAt()).Round(time.Minute).Minutes())
+}
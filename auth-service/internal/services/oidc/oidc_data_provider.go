@@ -0,0 +1,56 @@
+package oidcservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+	repo "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl/postgres"
+	cache "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl/redis"
+	dal "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+)
+
+type oidcDataProvider struct {
+	redis *redis.RedisClient
+}
+
+func newOidcDataProvider(redis *redis.RedisClient) *oidcDataProvider {
+	return &oidcDataProvider{
+		redis: redis,
+	}
+}
+
+func (p *oidcDataProvider) GetApplicantIdentity(ctx context.Context, uow *uow.UnitOfWork, provider, subject string) (*oidc.Identity, error) {
+	query := dal.NewQueryIdentityDal(nil, nil, &provider, &subject)
+	return repo.NewIdentityRepository(uow, repo.ApplicantIdentities).QueryIdentity(ctx, query)
+}
+
+func (p *oidcDataProvider) CreateApplicantIdentity(ctx context.Context, uow *uow.UnitOfWork, identity *oidc.Identity) error {
+	return repo.NewIdentityRepository(uow, repo.ApplicantIdentities).CreateIdentity(ctx, identity)
+}
+
+func (p *oidcDataProvider) DeleteApplicantIdentities(ctx context.Context, uow *uow.UnitOfWork, userId int64) error {
+	return repo.NewIdentityRepository(uow, repo.ApplicantIdentities).DeleteIdentitiesByUserId(ctx, userId)
+}
+
+func (p *oidcDataProvider) SetApplicantAuthRequest(ctx context.Context, stateHash string, req *oidc.AuthRequest, ttl time.Duration) error {
+	return cache.NewOidcAuthRequestCacheRepository(p.redis, cache.ApplicantOidcAuthRequestCache).Set(ctx, stateHash, req, ttl)
+}
+
+func (p *oidcDataProvider) PopApplicantAuthRequest(ctx context.Context, stateHash string) (*oidc.AuthRequest, error) {
+	return cache.NewOidcAuthRequestCacheRepository(p.redis, cache.ApplicantOidcAuthRequestCache).Pop(ctx, stateHash)
+}
+
+func (p *oidcDataProvider) SetApplicantRegistration(ctx context.Context, tokenHash string, profile *oidc.Profile, ttl time.Duration) error {
+	return cache.NewOidcRegistrationCacheRepository(p.redis, cache.ApplicantOidcRegistrationCache).Set(ctx, tokenHash, profile, ttl)
+}
+
+func (p *oidcDataProvider) GetApplicantRegistration(ctx context.Context, tokenHash string) (*oidc.Profile, error) {
+	return cache.NewOidcRegistrationCacheRepository(p.redis, cache.ApplicantOidcRegistrationCache).Get(ctx, tokenHash)
+}
+
+func (p *oidcDataProvider) DeleteApplicantRegistration(ctx context.Context, tokenHash string) error {
+	return cache.NewOidcRegistrationCacheRepository(p.redis, cache.ApplicantOidcRegistrationCache).Del(ctx, tokenHash)
+}
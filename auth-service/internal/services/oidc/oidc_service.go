@@ -0,0 +1,241 @@
+package oidcservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+	oidcclient "github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/oidc"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrOidcDisabled         = errors.New("oidc login disabled")
+	ErrInvalidState         = errors.New("invalid or expired state")
+	ErrInvalidAuthorization = errors.New("invalid authorization")
+)
+
+type OidcService interface {
+	StartApplicant(ctx context.Context) (string, error)
+	CompleteApplicant(ctx context.Context, state, code string) (*oidc.Profile, error)
+	GetApplicantIdentity(ctx context.Context, uow *uow.UnitOfWork, profile *oidc.Profile) (*oidc.Identity, error)
+	LinkApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, profile *oidc.Profile) (*oidc.Identity, error)
+	UnlinkApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error
+
+	CreateApplicantRegistration(ctx context.Context, profile *oidc.Profile) (string, error)
+	GetApplicantRegistration(ctx context.Context, registrationToken string) (*oidc.Profile, error)
+	DeleteApplicantRegistration(ctx context.Context, registrationToken string) error
+}
+
+type service struct {
+	dataProvider    *oidcDataProvider
+	client          *oidcclient.Client // nil when oidc login is disabled
+	hasher          *secret.Hasher
+	provider        string
+	stateTTL        time.Duration
+	registrationTTL time.Duration
+	log             *zap.SugaredLogger
+}
+
+func New(
+	redis *redis.RedisClient,
+	client *oidcclient.Client, hasher *secret.Hasher,
+	cfg settings.OidcSettings,
+	log *zap.SugaredLogger,
+) OidcService {
+	return &service{
+		dataProvider:    newOidcDataProvider(redis),
+		client:          client,
+		hasher:          hasher,
+		provider:        cfg.Provider,
+		stateTTL:        time.Duration(cfg.StateTTL) * time.Second,
+		registrationTTL: time.Duration(cfg.RegistrationTTL) * time.Second,
+		log:             log,
+	}
+}
+
+// StartApplicant returns the provider url the applicant has to be redirected to.
+func (s *service) StartApplicant(ctx context.Context) (string, error) {
+	l := s.log.With("op", "start_applicant_oidc", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if s.client == nil {
+		return "", ErrOidcDisabled
+	}
+
+	req, err := oidc.NewAuthRequest()
+	if err != nil {
+		l.Errorw("oidc.start_failed", "err", err)
+		return "", err
+	}
+
+	authURL, err := s.client.AuthCodeURL(ctx, req.State, req.Nonce, req.CodeChallenge())
+	if err != nil {
+		l.Errorw("oidc.start_failed", "err", err)
+		return "", err
+	}
+
+	if err := s.dataProvider.SetApplicantAuthRequest(ctx, s.hasher.Hash(req.State), req, s.stateTTL); err != nil {
+		l.Errorw("oidc.start_failed", "err", err)
+		return "", err
+	}
+
+	l.Infow("oidc.start.success")
+	return authURL, nil
+}
+
+// CompleteApplicant redeems the code of the authorization request started
+// with state and returns the profile of the verified id token.
+func (s *service) CompleteApplicant(ctx context.Context, state, code string) (*oidc.Profile, error) {
+	l := s.log.With("op", "complete_applicant_oidc", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if s.client == nil {
+		return nil, ErrOidcDisabled
+	}
+	if state == "" || code == "" {
+		return nil, ErrInvalidState
+	}
+
+	req, err := s.dataProvider.PopApplicantAuthRequest(ctx, s.hasher.Hash(state))
+	if err != nil {
+		l.Errorw("oidc.complete_failed", "err", err)
+		return nil, err
+	}
+	if req == nil {
+		l.Warnw("oidc.complete_failed", "err", ErrInvalidState)
+		return nil, ErrInvalidState
+	}
+
+	rawIdToken, err := s.client.Exchange(ctx, code, req.CodeVerifier)
+	if err != nil {
+		if errors.Is(err, oidcclient.ErrCodeRejected) {
+			l.Warnw("oidc.complete_failed", "err", err)
+			return nil, ErrInvalidAuthorization
+		}
+		l.Errorw("oidc.complete_failed", "err", err)
+		return nil, err
+	}
+
+	idToken, err := s.client.VerifyIdToken(ctx, rawIdToken, req.Nonce)
+	if err != nil {
+		if errors.Is(err, oidcclient.ErrInvalidIdToken) {
+			l.Warnw("oidc.complete_failed", "err", err)
+			return nil, ErrInvalidAuthorization
+		}
+		l.Errorw("oidc.complete_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("oidc.complete.success")
+	return &oidc.Profile{
+		Provider:      s.provider,
+		Subject:       idToken.Subject,
+		Email:         idToken.Email,
+		EmailVerified: bool(idToken.EmailVerified),
+		GivenName:     idToken.GivenName,
+		FamilyName:    idToken.FamilyName,
+		Birthdate:     idToken.Birthdate,
+	}, nil
+}
+
+func (s *service) GetApplicantIdentity(ctx context.Context, uow *uow.UnitOfWork, profile *oidc.Profile) (*oidc.Identity, error) {
+	l := s.log.With("op", "get_applicant_identity", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	identity, err := s.dataProvider.GetApplicantIdentity(ctx, uow, profile.Provider, profile.Subject)
+	if err != nil {
+		l.Errorw("oidc.get_identity_failed", "err", err)
+		return nil, err
+	}
+	return identity, nil
+}
+
+func (s *service) LinkApplicant(
+	ctx context.Context, uow *uow.UnitOfWork,
+	applicant *pb.Applicant, profile *oidc.Profile,
+) (*oidc.Identity, error) {
+	l := s.log.With("op", "link_applicant_identity", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	identity := oidc.New(applicant.Id, profile)
+	if err := s.dataProvider.CreateApplicantIdentity(ctx, uow, identity); err != nil {
+		l.Errorw("oidc.link_identity_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("oidc.link_identity.success", "provider", profile.Provider)
+	return identity, nil
+}
+
+// UnlinkApplicant removes every provider account linked to the applicant.
+func (s *service) UnlinkApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error {
+	l := s.log.With("op", "unlink_applicant_identities", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	if err := s.dataProvider.DeleteApplicantIdentities(ctx, uow, applicant.Id); err != nil {
+		l.Errorw("oidc.unlink_identities_failed", "err", err)
+		return err
+	}
+
+	l.Infow("oidc.unlink_identities.success")
+	return nil
+}
+
+// CreateApplicantRegistration keeps the profile of a provider account without
+// an applicant and returns the token completing the registration.
+func (s *service) CreateApplicantRegistration(ctx context.Context, profile *oidc.Profile) (string, error) {
+	l := s.log.With("op", "create_applicant_oidc_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	registrationToken, err := generateRegistrationToken()
+	if err != nil {
+		l.Errorw("oidc.create_registration_failed", "err", err)
+		return "", err
+	}
+
+	if err := s.dataProvider.SetApplicantRegistration(ctx, s.hasher.Hash(registrationToken), profile, s.registrationTTL); err != nil {
+		l.Errorw("oidc.create_registration_failed", "err", err)
+		return "", err
+	}
+
+	l.Infow("oidc.create_registration.success")
+	return registrationToken, nil
+}
+
+// GetApplicantRegistration returns nil when the registration does not exist or has expired.
+func (s *service) GetApplicantRegistration(ctx context.Context, registrationToken string) (*oidc.Profile, error) {
+	l := s.log.With("op", "get_applicant_oidc_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if registrationToken == "" {
+		return nil, nil
+	}
+
+	profile, err := s.dataProvider.GetApplicantRegistration(ctx, s.hasher.Hash(registrationToken))
+	if err != nil {
+		l.Errorw("oidc.get_registration_failed", "err", err)
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (s *service) DeleteApplicantRegistration(ctx context.Context, registrationToken string) error {
+	l := s.log.With("op", "delete_applicant_oidc_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if err := s.dataProvider.DeleteApplicantRegistration(ctx, s.hasher.Hash(registrationToken)); err != nil {
+		l.Errorw("oidc.delete_registration_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+func generateRegistrationToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
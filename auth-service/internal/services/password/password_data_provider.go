@@ -38,6 +38,46 @@ func (p *passwordDataProvider) GetEmployerPasswordByUserId(ctx context.Context,
 	return p.get(ctx, uow, userId, repo.EmployerPasswordRepository, cache.EmployerPasswordCache)
 }
 
+func (p *passwordDataProvider) GetStoredApplicantPasswordByUserId(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*password.Password, error) {
+	return p.getStored(ctx, uow, userId, repo.ApplicantPasswordRepository, cache.ApplicantPasswordCache)
+}
+
+func (p *passwordDataProvider) GetStoredEmployerPasswordByUserId(ctx context.Context, uow *uow.UnitOfWork, userId int64) (*password.Password, error) {
+	return p.getStored(ctx, uow, userId, repo.EmployerPasswordRepository, cache.EmployerPasswordCache)
+}
+
+func (p *passwordDataProvider) GetApplicantPasswordHistory(ctx context.Context, uow *uow.UnitOfWork, userId int64, limit int) ([]*password.HistoryEntry, error) {
+	return p.getHistory(ctx, uow, userId, limit, repo.ApplicantPasswordHistoryRepository)
+}
+
+func (p *passwordDataProvider) GetEmployerPasswordHistory(ctx context.Context, uow *uow.UnitOfWork, userId int64, limit int) ([]*password.HistoryEntry, error) {
+	return p.getHistory(ctx, uow, userId, limit, repo.EmployerPasswordHistoryRepository)
+}
+
+func (p *passwordDataProvider) AddApplicantPasswordHistoryEntry(ctx context.Context, uow *uow.UnitOfWork, entry *password.HistoryEntry, keep int) error {
+	return p.addHistoryEntry(ctx, uow, entry, keep, repo.ApplicantPasswordHistoryRepository)
+}
+
+func (p *passwordDataProvider) AddEmployerPasswordHistoryEntry(ctx context.Context, uow *uow.UnitOfWork, entry *password.HistoryEntry, keep int) error {
+	return p.addHistoryEntry(ctx, uow, entry, keep, repo.EmployerPasswordHistoryRepository)
+}
+
+func (p *passwordDataProvider) DeleteApplicantPassword(ctx context.Context, uow *uow.UnitOfWork, password *password.Password) error {
+	return p.delete(ctx, uow, password, repo.ApplicantPasswordRepository, cache.ApplicantPasswordCache)
+}
+
+func (p *passwordDataProvider) DeleteApplicantPasswordHistory(ctx context.Context, uow *uow.UnitOfWork, userId int64) error {
+	return p.deleteHistory(ctx, uow, userId, repo.ApplicantPasswordHistoryRepository)
+}
+
+func (p *passwordDataProvider) DeleteEmployerPassword(ctx context.Context, uow *uow.UnitOfWork, password *password.Password) error {
+	return p.delete(ctx, uow, password, repo.EmployerPasswordRepository, cache.EmployerPasswordCache)
+}
+
+func (p *passwordDataProvider) DeleteEmployerPasswordHistory(ctx context.Context, uow *uow.UnitOfWork, userId int64) error {
+	return p.deleteHistory(ctx, uow, userId, repo.EmployerPasswordHistoryRepository)
+}
+
 func (p *passwordDataProvider) get(
 	ctx context.Context, uow *uow.UnitOfWork,
 	userId int64,
@@ -63,6 +103,29 @@ func (p *passwordDataProvider) get(
 	return password, nil
 }
 
+// getStored reads the database only. The cache is filled before the commit,
+// so a cached password without a row is left by a rolled back transaction
+// and is dropped.
+func (p *passwordDataProvider) getStored(
+	ctx context.Context, uow *uow.UnitOfWork,
+	userId int64,
+	repoType impl.RepositoryType, cacheType impl.RepositoryType,
+) (*password.Password, error) {
+	dbRepo := repo.NewPasswordRepository(uow, repoType)
+	query := dal.NewQueryPasswordDal(nil, &userId)
+	password, err := dbRepo.QueryPassword(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if password == nil {
+		cacheRepo := cache.NewPasswordCacheRepository(p.redis, cacheType)
+		if err := cacheRepo.DelByUserId(ctx, userId); err != nil {
+			return nil, err
+		}
+	}
+	return password, nil
+}
+
 func (p *passwordDataProvider) save(
 	ctx context.Context, uow *uow.UnitOfWork,
 	password *password.Password,
@@ -87,3 +150,50 @@ func (p *passwordDataProvider) save(
 
 	return nil
 }
+
+func (p *passwordDataProvider) getHistory(
+	ctx context.Context, uow *uow.UnitOfWork,
+	userId int64, limit int,
+	repoType impl.RepositoryType,
+) ([]*password.HistoryEntry, error) {
+	dbRepo := repo.NewPasswordHistoryRepository(uow, repoType)
+	query := dal.NewQueryPasswordHistoryDal(&userId, limit)
+	return dbRepo.QueryEntries(ctx, query)
+}
+
+// addHistoryEntry stores the entry and forgets everything but the keep latest entries of the user.
+func (p *passwordDataProvider) addHistoryEntry(
+	ctx context.Context, uow *uow.UnitOfWork,
+	entry *password.HistoryEntry, keep int,
+	repoType impl.RepositoryType,
+) error {
+	dbRepo := repo.NewPasswordHistoryRepository(uow, repoType)
+	if err := dbRepo.CreateEntry(ctx, entry); err != nil {
+		return err
+	}
+	return dbRepo.DeleteEntriesExceptLatest(ctx, entry.UserId(), keep)
+}
+
+func (p *passwordDataProvider) delete(
+	ctx context.Context, uow *uow.UnitOfWork,
+	password *password.Password,
+	repoType impl.RepositoryType, cacheType impl.RepositoryType,
+) error {
+	cacheRepo := cache.NewPasswordCacheRepository(p.redis, cacheType)
+	if err := cacheRepo.DelByUserId(ctx, password.UserId()); err != nil {
+		return err
+	}
+
+	dbRepo := repo.NewPasswordRepository(uow, repoType)
+	return dbRepo.DeletePassword(ctx, password)
+}
+
+// deleteHistory forgets every history entry of the user.
+func (p *passwordDataProvider) deleteHistory(
+	ctx context.Context, uow *uow.UnitOfWork,
+	userId int64,
+	repoType impl.RepositoryType,
+) error {
+	dbRepo := repo.NewPasswordHistoryRepository(uow, repoType)
+	return dbRepo.DeleteEntriesExceptLatest(ctx, userId, 0)
+}
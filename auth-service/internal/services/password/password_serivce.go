@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/password"
 	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
@@ -19,16 +20,28 @@ type PasswordService interface {
 	CheckEmployerPassword(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawPassword string) (bool, error)
 	UpdateApplicantPassword(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, rawPassword string) (*password.Password, error)
 	UpdateEmployerPassword(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, rawPassword string) (*password.Password, error)
+	DeleteApplicantPassword(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error
+	DeleteEmployerPassword(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error
+	HasApplicantPassword(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) (bool, error)
+	HasEmployerPassword(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) (bool, error)
 }
 
 type service struct {
 	dataProvider *passwordDataProvider
+	hasher       *password.Hasher
+	historySize  int
 	log          *zap.SugaredLogger
 }
 
-func New(redis *redis.RedisClient, log *zap.SugaredLogger) PasswordService {
+func New(
+	redis *redis.RedisClient, hasher *password.Hasher,
+	policy settings.PasswordPolicySettings,
+	log *zap.SugaredLogger,
+) PasswordService {
 	return &service{
 		dataProvider: newPasswordDataProvider(redis),
+		hasher:       hasher,
+		historySize:  int(policy.HistorySize),
 		log:          log,
 	}
 }
@@ -39,7 +52,7 @@ func (s *service) CreateApplicantPassword(
 ) (*password.Password, error) {
 	l := s.log.With("op", "create_applicant_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	password, err := password.New(applicant.Id, rawPassword)
+	password, err := password.New(applicant.Id, rawPassword, s.hasher)
 	if err != nil {
 		l.Warnw("password.create_password_failed.validation_error", "err", err)
 		return nil, err
@@ -69,7 +82,7 @@ func (s *service) CreateEmployerPassword(
 ) (*password.Password, error) {
 	l := s.log.With("op", "create_employer_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	password, err := password.New(employer.Id, rawPassword)
+	password, err := password.New(employer.Id, rawPassword, s.hasher)
 	if err != nil {
 		l.Warnw("password.create_password_failed.validation_error", "err", err)
 		return nil, err
@@ -109,7 +122,24 @@ func (s *service) CheckApplicantPassword(
 		return false, fmt.Errorf("password not found")
 	}
 
-	correct := password.Check(rawPassword)
+	correct, needsRehash, err := password.Check(rawPassword, s.hasher)
+	if err != nil {
+		l.Errorw("password.check_password_failed", "err", err)
+		return false, err
+	}
+
+	if correct && needsRehash {
+		if err := password.Rehash(rawPassword, s.hasher); err != nil {
+			l.Errorw("password.rehash_password_failed", "err", err)
+			return false, err
+		}
+		if err := s.dataProvider.SaveApplicantPassword(ctx, uow, password); err != nil {
+			l.Errorw("password.rehash_password_failed", "err", err)
+			return false, err
+		}
+		l.Infow("password.rehash_password.success")
+	}
+
 	l.Infow("password.check_password.success")
 	return correct, nil
 }
@@ -130,7 +160,24 @@ func (s *service) CheckEmployerPassword(
 		return false, fmt.Errorf("password not found")
 	}
 
-	correct := password.Check(rawPassword)
+	correct, needsRehash, err := password.Check(rawPassword, s.hasher)
+	if err != nil {
+		l.Errorw("password.check_password_failed", "err", err)
+		return false, err
+	}
+
+	if correct && needsRehash {
+		if err := password.Rehash(rawPassword, s.hasher); err != nil {
+			l.Errorw("password.rehash_password_failed", "err", err)
+			return false, err
+		}
+		if err := s.dataProvider.SaveEmployerPassword(ctx, uow, password); err != nil {
+			l.Errorw("password.rehash_password_failed", "err", err)
+			return false, err
+		}
+		l.Infow("password.rehash_password.success")
+	}
+
 	l.Infow("password.check_password.success")
 	return correct, nil
 }
@@ -151,11 +198,31 @@ func (s *service) UpdateApplicantPassword(
 		return nil, fmt.Errorf("password not found")
 	}
 
-	if err := password.SetPassword(rawPassword); err != nil {
+	previous := password.ToHistoryEntry()
+	if s.historySize > 0 {
+		history, err := s.dataProvider.GetApplicantPasswordHistory(ctx, uow, applicant.Id, s.historySize)
+		if err != nil {
+			l.Errorw("password.update_password_failed", "err", err)
+			return nil, err
+		}
+		if err := password.CheckReuse(rawPassword, history, s.hasher); err != nil {
+			l.Warnw("password.update_password_failed.validation_error", "err", err)
+			return nil, err
+		}
+	}
+
+	if err := password.SetPassword(rawPassword, s.hasher); err != nil {
 		l.Warnw("password.update_password_failed.validation_error", "err", err)
 		return nil, err
 	}
 
+	if s.historySize > 0 {
+		if err := s.dataProvider.AddApplicantPasswordHistoryEntry(ctx, uow, previous, s.historySize); err != nil {
+			l.Errorw("password.update_password_failed", "err", err)
+			return nil, err
+		}
+	}
+
 	if err := s.dataProvider.SaveApplicantPassword(ctx, uow, password); err != nil {
 		l.Errorw("password.update_password_failed", "err", err)
 		return nil, err
@@ -181,11 +248,31 @@ func (s *service) UpdateEmployerPassword(
 		return nil, fmt.Errorf("password not found")
 	}
 
-	if err := password.SetPassword(rawPassword); err != nil {
+	previous := password.ToHistoryEntry()
+	if s.historySize > 0 {
+		history, err := s.dataProvider.GetEmployerPasswordHistory(ctx, uow, employer.Id, s.historySize)
+		if err != nil {
+			l.Errorw("password.update_password_failed", "err", err)
+			return nil, err
+		}
+		if err := password.CheckReuse(rawPassword, history, s.hasher); err != nil {
+			l.Warnw("password.update_password_failed.validation_error", "err", err)
+			return nil, err
+		}
+	}
+
+	if err := password.SetPassword(rawPassword, s.hasher); err != nil {
 		l.Warnw("password.update_password_failed.validation_error", "err", err)
 		return nil, err
 	}
 
+	if s.historySize > 0 {
+		if err := s.dataProvider.AddEmployerPasswordHistoryEntry(ctx, uow, previous, s.historySize); err != nil {
+			l.Errorw("password.update_password_failed", "err", err)
+			return nil, err
+		}
+	}
+
 	if err := s.dataProvider.SaveEmployerPassword(ctx, uow, password); err != nil {
 		l.Errorw("password.update_password_failed", "err", err)
 		return nil, err
@@ -194,3 +281,77 @@ func (s *service) UpdateEmployerPassword(
 	l.Infow("password.update_password.success")
 	return password, nil
 }
+
+// DeleteApplicantPassword removes the password together with its history.
+func (s *service) DeleteApplicantPassword(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error {
+	l := s.log.With("op", "delete_applicant_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	password, err := s.dataProvider.GetApplicantPasswordByUserId(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("password.delete_password_failed", "err", err)
+		return err
+	}
+	if password != nil {
+		if err := s.dataProvider.DeleteApplicantPassword(ctx, uow, password); err != nil {
+			l.Errorw("password.delete_password_failed", "err", err)
+			return err
+		}
+	}
+
+	if err := s.dataProvider.DeleteApplicantPasswordHistory(ctx, uow, applicant.Id); err != nil {
+		l.Errorw("password.delete_password_failed", "err", err)
+		return err
+	}
+
+	l.Infow("password.delete_password.success")
+	return nil
+}
+
+// DeleteEmployerPassword removes the password together with its history.
+func (s *service) DeleteEmployerPassword(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error {
+	l := s.log.With("op", "delete_employer_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	password, err := s.dataProvider.GetEmployerPasswordByUserId(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("password.delete_password_failed", "err", err)
+		return err
+	}
+	if password != nil {
+		if err := s.dataProvider.DeleteEmployerPassword(ctx, uow, password); err != nil {
+			l.Errorw("password.delete_password_failed", "err", err)
+			return err
+		}
+	}
+
+	if err := s.dataProvider.DeleteEmployerPasswordHistory(ctx, uow, employer.Id); err != nil {
+		l.Errorw("password.delete_password_failed", "err", err)
+		return err
+	}
+
+	l.Infow("password.delete_password.success")
+	return nil
+}
+
+// HasApplicantPassword reports whether the applicant has a committed password.
+func (s *service) HasApplicantPassword(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) (bool, error) {
+	l := s.log.With("op", "has_applicant_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	password, err := s.dataProvider.GetStoredApplicantPasswordByUserId(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("password.has_password_failed", "err", err)
+		return false, err
+	}
+	return password != nil, nil
+}
+
+// HasEmployerPassword reports whether the employer has a committed password.
+func (s *service) HasEmployerPassword(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) (bool, error) {
+	l := s.log.With("op", "has_employer_password", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	password, err := s.dataProvider.GetStoredEmployerPasswordByUserId(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("password.has_password_failed", "err", err)
+		return false, err
+	}
+	return password != nil, nil
+}
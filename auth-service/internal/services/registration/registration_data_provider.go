@@ -0,0 +1,40 @@
+package registrationservice
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/registration"
+	repo "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl/postgres"
+	dal "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+type registrationDataProvider struct{}
+
+func newRegistrationDataProvider() *registrationDataProvider {
+	return &registrationDataProvider{}
+}
+
+func (p *registrationDataProvider) CreateApplicantSaga(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	return repo.NewRegistrationSagaRepository(uow, repo.ApplicantRegistrationSagas).CreateSaga(ctx, saga)
+}
+
+func (p *registrationDataProvider) CreateEmployerSaga(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	return repo.NewRegistrationSagaRepository(uow, repo.EmployerRegistrationSagas).CreateSaga(ctx, saga)
+}
+
+func (p *registrationDataProvider) UpdateApplicantSaga(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	return repo.NewRegistrationSagaRepository(uow, repo.ApplicantRegistrationSagas).UpdateSaga(ctx, saga)
+}
+
+func (p *registrationDataProvider) UpdateEmployerSaga(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	return repo.NewRegistrationSagaRepository(uow, repo.EmployerRegistrationSagas).UpdateSaga(ctx, saga)
+}
+
+func (p *registrationDataProvider) QueryApplicantSagas(ctx context.Context, uow *uow.UnitOfWork, query *dal.QueryRegistrationSagaDal) ([]*registration.Saga, error) {
+	return repo.NewRegistrationSagaRepository(uow, repo.ApplicantRegistrationSagas).QuerySagas(ctx, query)
+}
+
+func (p *registrationDataProvider) QueryEmployerSagas(ctx context.Context, uow *uow.UnitOfWork, query *dal.QueryRegistrationSagaDal) ([]*registration.Saga, error) {
+	return repo.NewRegistrationSagaRepository(uow, repo.EmployerRegistrationSagas).QuerySagas(ctx, query)
+}
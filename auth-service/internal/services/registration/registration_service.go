@@ -0,0 +1,402 @@
+package registrationservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/registration"
+	dal "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/models"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+	passwordservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/password"
+	userservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/user_service"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/postgres"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"go.uber.org/zap"
+)
+
+var (
+	errUserNotFound   = errors.New("user not found")
+	errHasCredentials = errors.New("user has credentials")
+	errSuperseded     = errors.New("superseded by a newer registration")
+)
+
+// RegistrationService runs the registration saga: the user is created in the
+// user service first and deleted again when the local credentials cannot be
+// stored. Sagas left open by a crash are repaired by Reconcile.
+type RegistrationService interface {
+	StartApplicant(ctx context.Context, email string) (*registration.Saga, error)
+	ApplicantCreated(ctx context.Context, saga *registration.Saga, applicant *pb.Applicant) error
+	CompleteApplicant(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error
+	AbortApplicant(ctx context.Context, saga *registration.Saga, reason error)
+	CompensateApplicant(ctx context.Context, saga *registration.Saga, applicant *pb.Applicant)
+
+	StartEmployer(ctx context.Context, email string) (*registration.Saga, error)
+	EmployerCreated(ctx context.Context, saga *registration.Saga, employer *pb.Employer) error
+	CompleteEmployer(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error
+	AbortEmployer(ctx context.Context, saga *registration.Saga, reason error)
+	CompensateEmployer(ctx context.Context, saga *registration.Saga, employer *pb.Employer)
+
+	Reconcile(ctx context.Context)
+}
+
+type service struct {
+	dataProvider    *registrationDataProvider
+	postgresClient  *postgres.PostgresClient
+	userService     userservice.UserService
+	passwordService passwordservice.PasswordService
+	staleAfter      time.Duration
+	batchSize       int
+	log             *zap.SugaredLogger
+}
+
+func New(
+	postgresClient *postgres.PostgresClient,
+	userSvc userservice.UserService, passwordSvc passwordservice.PasswordService,
+	cfg settings.RegistrationSettings,
+	log *zap.SugaredLogger,
+) RegistrationService {
+	return &service{
+		dataProvider:    newRegistrationDataProvider(),
+		postgresClient:  postgresClient,
+		userService:     userSvc,
+		passwordService: passwordSvc,
+		staleAfter:      time.Duration(cfg.StaleAfter) * time.Second,
+		batchSize:       int(cfg.ReconcileBatchSize),
+		log:             log,
+	}
+}
+
+// StartApplicant stores the saga before the applicant is created, so an
+// applicant created by a request that crashed can still be found by email.
+func (s *service) StartApplicant(ctx context.Context, email string) (*registration.Saga, error) {
+	l := s.log.With("op", "start_applicant_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	saga := registration.New(email)
+	if err := s.dataProvider.CreateApplicantSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.start_failed", "err", err)
+		return nil, err
+	}
+	return saga, nil
+}
+
+func (s *service) ApplicantCreated(ctx context.Context, saga *registration.Saga, applicant *pb.Applicant) error {
+	l := s.log.With("op", "applicant_registration_user_created", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	saga.SetUserCreated(applicant.Id)
+	if err := s.dataProvider.UpdateApplicantSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.user_created_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// CompleteApplicant has to run in the transaction storing the credentials.
+func (s *service) CompleteApplicant(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	l := s.log.With("op", "complete_applicant_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	saga.SetCompleted()
+	if err := s.dataProvider.UpdateApplicantSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.complete_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// AbortApplicant closes the saga after the user service rejected the applicant.
+// A failure is only logged, the open saga is closed by Reconcile.
+func (s *service) AbortApplicant(ctx context.Context, saga *registration.Saga, reason error) {
+	l := s.log.With("op", "abort_applicant_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	saga.SetAborted(reason.Error())
+	if err := s.dataProvider.UpdateApplicantSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.abort_failed", "err", err)
+	}
+}
+
+// CompensateApplicant deletes the applicant after the credentials could not
+// be stored. A failure is recorded on the saga and retried by Reconcile.
+func (s *service) CompensateApplicant(ctx context.Context, saga *registration.Saga, applicant *pb.Applicant) {
+	l := s.log.With("op", "compensate_applicant_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	// the saga may have been completed in the rolled back transaction
+	saga.SetUserCreated(applicant.Id)
+	if err := s.compensateApplicant(ctx, uow, saga, applicant); err != nil {
+		l.Errorw("registration.compensate_failed", "err", err)
+		s.recordApplicantFailure(ctx, uow, saga, err)
+		return
+	}
+	l.Infow("registration.compensate.success", "state", saga.State())
+}
+
+func (s *service) StartEmployer(ctx context.Context, email string) (*registration.Saga, error) {
+	l := s.log.With("op", "start_employer_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	saga := registration.New(email)
+	if err := s.dataProvider.CreateEmployerSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.start_failed", "err", err)
+		return nil, err
+	}
+	return saga, nil
+}
+
+func (s *service) EmployerCreated(ctx context.Context, saga *registration.Saga, employer *pb.Employer) error {
+	l := s.log.With("op", "employer_registration_user_created", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	saga.SetUserCreated(employer.Id)
+	if err := s.dataProvider.UpdateEmployerSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.user_created_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// CompleteEmployer has to run in the transaction storing the credentials.
+func (s *service) CompleteEmployer(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	l := s.log.With("op", "complete_employer_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	saga.SetCompleted()
+	if err := s.dataProvider.UpdateEmployerSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.complete_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// AbortEmployer closes the saga after the user service rejected the employer.
+// A failure is only logged, the open saga is closed by Reconcile.
+func (s *service) AbortEmployer(ctx context.Context, saga *registration.Saga, reason error) {
+	l := s.log.With("op", "abort_employer_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	saga.SetAborted(reason.Error())
+	if err := s.dataProvider.UpdateEmployerSaga(ctx, uow, saga); err != nil {
+		l.Errorw("registration.abort_failed", "err", err)
+	}
+}
+
+// CompensateEmployer deletes the employer after the credentials could not
+// be stored. A failure is recorded on the saga and retried by Reconcile.
+func (s *service) CompensateEmployer(ctx context.Context, saga *registration.Saga, employer *pb.Employer) {
+	l := s.log.With("op", "compensate_employer_registration", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "saga_id", saga.Id())
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	// the saga may have been completed in the rolled back transaction
+	saga.SetUserCreated(employer.Id)
+	if err := s.compensateEmployer(ctx, uow, saga, employer); err != nil {
+		l.Errorw("registration.compensate_failed", "err", err)
+		s.recordEmployerFailure(ctx, uow, saga, err)
+		return
+	}
+	l.Infow("registration.compensate.success", "state", saga.State())
+}
+
+// Reconcile repairs the sagas that stayed open longer than staleAfter: the
+// user left without credentials is deleted, otherwise the saga is closed.
+func (s *service) Reconcile(ctx context.Context) {
+	s.reconcileApplicants(ctx)
+	s.reconcileEmployers(ctx)
+}
+
+func (s *service) reconcileApplicants(ctx context.Context) {
+	l := s.log.With("op", "reconcile_applicant_registrations")
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	sagas, err := s.dataProvider.QueryApplicantSagas(ctx, uow, s.staleSagasQuery())
+	if err != nil {
+		l.Errorw("registration.reconcile_failed", "err", err)
+		return
+	}
+
+	for _, saga := range sagas {
+		if err := s.reconcileApplicant(ctx, uow, saga); err != nil {
+			l.Errorw("registration.reconcile_failed", "err", err, "saga_id", saga.Id())
+			s.recordApplicantFailure(ctx, uow, saga, err)
+			continue
+		}
+		l.Infow("registration.reconcile.success", "saga_id", saga.Id(), "state", saga.State())
+	}
+}
+
+func (s *service) reconcileApplicant(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	email := saga.Email()
+	newer, err := s.dataProvider.QueryApplicantSagas(ctx, uow, dal.NewQueryRegistrationSagaDal(nil, &email, nil, nil, 0))
+	if err != nil {
+		return err
+	}
+	if superseded(saga, newer) {
+		saga.SetAborted(errSuperseded.Error())
+		return s.dataProvider.UpdateApplicantSaga(ctx, uow, saga)
+	}
+
+	var applicant *pb.Applicant
+	if saga.UserId() != 0 {
+		applicant, err = s.userService.GetApplicantById(ctx, saga.UserId())
+	} else {
+		// the request crashed before the id was stored
+		applicant, err = s.userService.GetApplicantByEmail(ctx, saga.Email())
+	}
+	if err != nil {
+		return err
+	}
+	if applicant == nil {
+		saga.SetAborted(errUserNotFound.Error())
+		return s.dataProvider.UpdateApplicantSaga(ctx, uow, saga)
+	}
+
+	return s.compensateApplicant(ctx, uow, saga, applicant)
+}
+
+// compensateApplicant deletes the applicant unless it has a password, which
+// is the case when the registration reused an inactive applicant.
+func (s *service) compensateApplicant(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga, applicant *pb.Applicant) error {
+	hasPassword, err := s.passwordService.HasApplicantPassword(ctx, uow, applicant)
+	if err != nil {
+		return err
+	}
+	if hasPassword {
+		saga.SetAborted(errHasCredentials.Error())
+		return s.dataProvider.UpdateApplicantSaga(ctx, uow, saga)
+	}
+
+	if !applicant.IsDeleted {
+		if _, err := s.userService.DeleteApplicant(ctx, applicant); err != nil {
+			return err
+		}
+	}
+
+	saga.SetCompensated()
+	return s.dataProvider.UpdateApplicantSaga(ctx, uow, saga)
+}
+
+func (s *service) recordApplicantFailure(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga, cause error) {
+	saga.RecordFailure(cause)
+	if err := s.dataProvider.UpdateApplicantSaga(ctx, uow, saga); err != nil {
+		s.log.Errorw("registration.record_failure_failed", "err", err, "saga_id", saga.Id())
+	}
+}
+
+func (s *service) reconcileEmployers(ctx context.Context) {
+	l := s.log.With("op", "reconcile_employer_registrations")
+
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+
+	sagas, err := s.dataProvider.QueryEmployerSagas(ctx, uow, s.staleSagasQuery())
+	if err != nil {
+		l.Errorw("registration.reconcile_failed", "err", err)
+		return
+	}
+
+	for _, saga := range sagas {
+		if err := s.reconcileEmployer(ctx, uow, saga); err != nil {
+			l.Errorw("registration.reconcile_failed", "err", err, "saga_id", saga.Id())
+			s.recordEmployerFailure(ctx, uow, saga, err)
+			continue
+		}
+		l.Infow("registration.reconcile.success", "saga_id", saga.Id(), "state", saga.State())
+	}
+}
+
+func (s *service) reconcileEmployer(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga) error {
+	email := saga.Email()
+	newer, err := s.dataProvider.QueryEmployerSagas(ctx, uow, dal.NewQueryRegistrationSagaDal(nil, &email, nil, nil, 0))
+	if err != nil {
+		return err
+	}
+	if superseded(saga, newer) {
+		saga.SetAborted(errSuperseded.Error())
+		return s.dataProvider.UpdateEmployerSaga(ctx, uow, saga)
+	}
+
+	var employer *pb.Employer
+	if saga.UserId() != 0 {
+		employer, err = s.userService.GetEmployerById(ctx, saga.UserId())
+	} else {
+		// the request crashed before the id was stored
+		employer, err = s.userService.GetEmployerByEmail(ctx, saga.Email())
+	}
+	if err != nil {
+		return err
+	}
+	if employer == nil {
+		saga.SetAborted(errUserNotFound.Error())
+		return s.dataProvider.UpdateEmployerSaga(ctx, uow, saga)
+	}
+
+	return s.compensateEmployer(ctx, uow, saga, employer)
+}
+
+// compensateEmployer deletes the employer unless it has a password, which
+// is the case when the registration reused an inactive employer.
+func (s *service) compensateEmployer(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga, employer *pb.Employer) error {
+	hasPassword, err := s.passwordService.HasEmployerPassword(ctx, uow, employer)
+	if err != nil {
+		return err
+	}
+	if hasPassword {
+		saga.SetAborted(errHasCredentials.Error())
+		return s.dataProvider.UpdateEmployerSaga(ctx, uow, saga)
+	}
+
+	if !employer.IsDeleted {
+		if _, err := s.userService.DeleteEmployer(ctx, employer); err != nil {
+			return err
+		}
+	}
+
+	saga.SetCompensated()
+	return s.dataProvider.UpdateEmployerSaga(ctx, uow, saga)
+}
+
+func (s *service) recordEmployerFailure(ctx context.Context, uow *uow.UnitOfWork, saga *registration.Saga, cause error) {
+	saga.RecordFailure(cause)
+	if err := s.dataProvider.UpdateEmployerSaga(ctx, uow, saga); err != nil {
+		s.log.Errorw("registration.record_failure_failed", "err", err, "saga_id", saga.Id())
+	}
+}
+
+// staleSagasQuery selects the open sagas without progress for staleAfter,
+// the least recently attempted first.
+func (s *service) staleSagasQuery() *dal.QueryRegistrationSagaDal {
+	updatedBefore := time.Now().Add(-s.staleAfter)
+	states := []string{string(registration.StatePending), string(registration.StateUserCreated)}
+	return dal.NewQueryRegistrationSagaDal(nil, nil, states, &updatedBefore, s.batchSize)
+}
+
+// superseded reports whether a later registration for the same email took
+// over the user, which must not be deleted by the older saga then.
+func superseded(saga *registration.Saga, sagas []*registration.Saga) bool {
+	for _, other := range sagas {
+		if other.Id() > saga.Id() && other.State() != registration.StateAborted {
+			return true
+		}
+	}
+	return false
+}
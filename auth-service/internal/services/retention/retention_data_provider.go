@@ -0,0 +1,39 @@
+package retentionservice
+
+import (
+	"context"
+	"time"
+
+	repo "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl/postgres"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+)
+
+type retentionDataProvider struct{}
+
+func newRetentionDataProvider() *retentionDataProvider {
+	return &retentionDataProvider{}
+}
+
+func (p *retentionDataProvider) MarkApplicantDeleted(ctx context.Context, uow *uow.UnitOfWork, applicantId int64, deletedAt time.Time) error {
+	return repo.NewAccountDeletionRepository(uow, repo.ApplicantAccountDeletions).MarkDeleted(ctx, applicantId, deletedAt)
+}
+
+func (p *retentionDataProvider) MarkEmployerDeleted(ctx context.Context, uow *uow.UnitOfWork, employerId int64, deletedAt time.Time) error {
+	return repo.NewAccountDeletionRepository(uow, repo.EmployerAccountDeletions).MarkDeleted(ctx, employerId, deletedAt)
+}
+
+func (p *retentionDataProvider) UnmarkApplicantDeleted(ctx context.Context, uow *uow.UnitOfWork, applicantId int64) (bool, error) {
+	return repo.NewAccountDeletionRepository(uow, repo.ApplicantAccountDeletions).UnmarkDeleted(ctx, applicantId)
+}
+
+func (p *retentionDataProvider) UnmarkEmployerDeleted(ctx context.Context, uow *uow.UnitOfWork, employerId int64) (bool, error) {
+	return repo.NewAccountDeletionRepository(uow, repo.EmployerAccountDeletions).UnmarkDeleted(ctx, employerId)
+}
+
+func (p *retentionDataProvider) ListApplicantsDeletedBefore(ctx context.Context, uow *uow.UnitOfWork, deletedBefore time.Time, limit int) ([]int64, error) {
+	return repo.NewAccountDeletionRepository(uow, repo.ApplicantAccountDeletions).ListDeletedBefore(ctx, deletedBefore, limit)
+}
+
+func (p *retentionDataProvider) ListEmployersDeletedBefore(ctx context.Context, uow *uow.UnitOfWork, deletedBefore time.Time, limit int) ([]int64, error) {
+	return repo.NewAccountDeletionRepository(uow, repo.EmployerAccountDeletions).ListDeletedBefore(ctx, deletedBefore, limit)
+}
@@ -0,0 +1,241 @@
+package retentionservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
+	mfaservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/mfa"
+	oidcservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/oidc"
+	passwordservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/password"
+	userservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/user_service"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/postgres"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"go.uber.org/zap"
+)
+
+var ErrCredentialsPurged = errors.New("credentials of the deleted account are purged")
+
+// RetentionService keeps the password, the mfa and the linked provider accounts
+// of a deleted account while the user service allows restoring it, so the
+// restored account can log in again. PurgeExpired removes them afterwards.
+type RetentionService interface {
+	MarkApplicantDeleted(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error
+	UnmarkApplicantDeleted(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error
+
+	MarkEmployerDeleted(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error
+	UnmarkEmployerDeleted(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error
+
+	PurgeExpired(ctx context.Context)
+}
+
+type service struct {
+	dataProvider    *retentionDataProvider
+	postgresClient  *postgres.PostgresClient
+	userService     userservice.UserService
+	passwordService passwordservice.PasswordService
+	mfaService      mfaservice.MfaService
+	oidcService     oidcservice.OidcService
+	gracePeriod     time.Duration
+	batchSize       int
+	log             *zap.SugaredLogger
+}
+
+func New(
+	postgresClient *postgres.PostgresClient,
+	userSvc userservice.UserService,
+	passwordSvc passwordservice.PasswordService, mfaSvc mfaservice.MfaService, oidcSvc oidcservice.OidcService,
+	cfg settings.RetentionSettings,
+	log *zap.SugaredLogger,
+) RetentionService {
+	return &service{
+		dataProvider:    newRetentionDataProvider(),
+		postgresClient:  postgresClient,
+		userService:     userSvc,
+		passwordService: passwordSvc,
+		mfaService:      mfaSvc,
+		oidcService:     oidcSvc,
+		gracePeriod:     time.Duration(cfg.GracePeriod) * time.Second,
+		batchSize:       int(cfg.PurgeBatchSize),
+		log:             log,
+	}
+}
+
+func (s *service) MarkApplicantDeleted(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error {
+	l := s.log.With("op", "mark_applicant_deleted", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	if err := s.dataProvider.MarkApplicantDeleted(ctx, uow, applicant.Id, time.Now()); err != nil {
+		l.Errorw("retention.mark_deleted_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// UnmarkApplicantDeleted fails with ErrCredentialsPurged once the purge has
+// removed the credentials, the account cannot be restored then.
+func (s *service) UnmarkApplicantDeleted(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant) error {
+	l := s.log.With("op", "unmark_applicant_deleted", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
+
+	marked, err := s.dataProvider.UnmarkApplicantDeleted(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("retention.unmark_deleted_failed", "err", err)
+		return err
+	}
+	if !marked {
+		l.Warnw("retention.unmark_deleted_failed", "err", ErrCredentialsPurged)
+		return ErrCredentialsPurged
+	}
+	return nil
+}
+
+func (s *service) MarkEmployerDeleted(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error {
+	l := s.log.With("op", "mark_employer_deleted", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	if err := s.dataProvider.MarkEmployerDeleted(ctx, uow, employer.Id, time.Now()); err != nil {
+		l.Errorw("retention.mark_deleted_failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// UnmarkEmployerDeleted fails with ErrCredentialsPurged once the purge has
+// removed the credentials, the account cannot be restored then.
+func (s *service) UnmarkEmployerDeleted(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer) error {
+	l := s.log.With("op", "unmark_employer_deleted", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
+
+	marked, err := s.dataProvider.UnmarkEmployerDeleted(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("retention.unmark_deleted_failed", "err", err)
+		return err
+	}
+	if !marked {
+		l.Warnw("retention.unmark_deleted_failed", "err", ErrCredentialsPurged)
+		return ErrCredentialsPurged
+	}
+	return nil
+}
+
+// PurgeExpired removes the credentials of the accounts deleted longer than the
+// grace period ago.
+func (s *service) PurgeExpired(ctx context.Context) {
+	s.purgeApplicants(ctx)
+	s.purgeEmployers(ctx)
+}
+
+func (s *service) purgeApplicants(ctx context.Context) {
+	l := s.log.With("op", "purge_deleted_applicants")
+
+	for {
+		ids, err := s.purgeApplicantBatch(ctx)
+		if err != nil {
+			l.Errorw("retention.purge_failed", "err", err)
+			return
+		}
+		if len(ids) > 0 {
+			l.Infow("retention.purge.success", "ids", ids)
+		}
+		if len(ids) < s.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (s *service) purgeApplicantBatch(ctx context.Context) ([]int64, error) {
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	if _, err := uow.BeginTransaction(ctx); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.dataProvider.ListApplicantsDeletedBefore(ctx, uow, time.Now().Add(-s.gracePeriod), s.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		applicant, err := s.userService.GetApplicantById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		// a restore whose commit failed on this side leaves the mark behind
+		if applicant == nil || applicant.IsDeleted {
+			applicant = &pb.Applicant{Id: id}
+			if err := s.passwordService.DeleteApplicantPassword(ctx, uow, applicant); err != nil {
+				return nil, err
+			}
+			if err := s.mfaService.DeleteApplicant(ctx, uow, applicant); err != nil {
+				return nil, err
+			}
+			if err := s.oidcService.UnlinkApplicant(ctx, uow, applicant); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := s.dataProvider.UnmarkApplicantDeleted(ctx, uow, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (s *service) purgeEmployers(ctx context.Context) {
+	l := s.log.With("op", "purge_deleted_employers")
+
+	for {
+		ids, err := s.purgeEmployerBatch(ctx)
+		if err != nil {
+			l.Errorw("retention.purge_failed", "err", err)
+			return
+		}
+		if len(ids) > 0 {
+			l.Infow("retention.purge.success", "ids", ids)
+		}
+		if len(ids) < s.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (s *service) purgeEmployerBatch(ctx context.Context) ([]int64, error) {
+	uow := uow.New(s.postgresClient)
+	defer uow.Close()
+	if _, err := uow.BeginTransaction(ctx); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.dataProvider.ListEmployersDeletedBefore(ctx, uow, time.Now().Add(-s.gracePeriod), s.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		employer, err := s.userService.GetEmployerById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		// a restore whose commit failed on this side leaves the mark behind
+		if employer == nil || employer.IsDeleted {
+			employer = &pb.Employer{Id: id}
+			if err := s.passwordService.DeleteEmployerPassword(ctx, uow, employer); err != nil {
+				return nil, err
+			}
+			if err := s.mfaService.DeleteEmployer(ctx, uow, employer); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := s.dataProvider.UnmarkEmployerDeleted(ctx, uow, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
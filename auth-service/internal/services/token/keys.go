@@ -0,0 +1,205 @@
+package tokenservice
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+const refreshKeyId = "refresh"
+
+type signingKey struct {
+	id     string
+	method jwt.SigningMethod
+	key    any
+}
+
+// Keys holds the key material of the service: asymmetric keys for access tokens,
+// which are verified by other services through the JWKS document, and a shared
+// secret for refresh tokens, which are only ever verified by the auth service.
+type Keys struct {
+	access        *signingKey
+	refresh       *signingKey
+	accessKeySet  *claims.KeySet
+	refreshKeySet *claims.KeySet
+}
+
+func LoadKeys(cfg settings.JWTSettings, log *zap.SugaredLogger) (*Keys, error) {
+	signingKeys, err := loadSigningKeys(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(signingKeys) == 0 {
+		if !cfg.AllowEphemeralKey {
+			return nil, fmt.Errorf("no signing keys configured: set signing_keys or signing_keys_dir")
+		}
+		key, err := generateEphemeralKey()
+		if err != nil {
+			return nil, err
+		}
+		log.Warnw("token.signing_keys_not_configured", "generated_key_id", key.id)
+		signingKeys = append(signingKeys, key)
+	}
+
+	active, err := selectActiveKey(signingKeys, cfg.ActiveKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]*claims.Key, 0, len(signingKeys))
+	for _, k := range signingKeys {
+		signer, ok := k.key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("signing key %q: not a signer", k.id)
+		}
+		publicKeys = append(publicKeys, &claims.Key{Id: k.id, Algorithm: k.method.Alg(), Key: signer.Public()})
+	}
+	accessKeySet, err := claims.NewKeySet(publicKeys...)
+	if err != nil {
+		return nil, fmt.Errorf("access key set: %w", err)
+	}
+
+	if cfg.RefreshTokenSecret == "" {
+		return nil, fmt.Errorf("refresh_token_secret is required")
+	}
+	refresh := &signingKey{id: refreshKeyId, method: jwt.SigningMethodHS256, key: []byte(cfg.RefreshTokenSecret)}
+	refreshKeySet, err := claims.NewKeySet(&claims.Key{Id: refresh.id, Algorithm: claims.AlgorithmHS256, Key: refresh.key})
+	if err != nil {
+		return nil, fmt.Errorf("refresh key set: %w", err)
+	}
+
+	return &Keys{
+		access:        active,
+		refresh:       refresh,
+		accessKeySet:  accessKeySet,
+		refreshKeySet: refreshKeySet,
+	}, nil
+}
+
+// AccessKeySet returns the public keys access tokens can be verified with.
+func (k *Keys) AccessKeySet() *claims.KeySet {
+	return k.accessKeySet
+}
+
+func loadSigningKeys(cfg *settings.JWTSettings) ([]*signingKey, error) {
+	var keys []*signingKey
+
+	for _, kc := range cfg.SigningKeys {
+		data := []byte(kc.PrivateKey)
+		if len(data) == 0 {
+			if kc.PrivateKeyFile == "" {
+				return nil, fmt.Errorf("signing key %q: private_key or private_key_file is required", kc.Id)
+			}
+			var err error
+			if data, err = os.ReadFile(kc.PrivateKeyFile); err != nil {
+				return nil, fmt.Errorf("signing key %q: %w", kc.Id, err)
+			}
+		}
+		key, err := parseSigningKey(kc.Id, data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if cfg.SigningKeysDir != "" {
+		files, err := filepath.Glob(filepath.Join(cfg.SigningKeysDir, "*.pem"))
+		if err != nil {
+			return nil, fmt.Errorf("signing keys dir: %w", err)
+		}
+		sort.Strings(files)
+		for _, f := range files {
+			data, err := os.ReadFile(f)
+			if err != nil {
+				return nil, fmt.Errorf("signing keys dir: %w", err)
+			}
+			key, err := parseSigningKey(strings.TrimSuffix(filepath.Base(f), ".pem"), data)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+func parseSigningKey(id string, data []byte) (*signingKey, error) {
+	if id == "" {
+		return nil, fmt.Errorf("signing key id is required")
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing key %q: invalid pem", id)
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("signing key %q: unsupported pem block %q", id, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("signing key %q: %w", id, err)
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return &signingKey{id: id, method: jwt.SigningMethodRS256, key: k}, nil
+	case ed25519.PrivateKey:
+		return &signingKey{id: id, method: jwt.SigningMethodEdDSA, key: k}, nil
+	default:
+		return nil, fmt.Errorf("signing key %q: unsupported key type %T", id, key)
+	}
+}
+
+func selectActiveKey(keys []*signingKey, activeId string) (*signingKey, error) {
+	if activeId == "" {
+		if len(keys) > 1 {
+			return nil, fmt.Errorf("active_key_id is required when several signing keys are configured")
+		}
+		return keys[0], nil
+	}
+	for _, k := range keys {
+		if k.id == activeId {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("active signing key %q not found", activeId)
+}
+
+// generateEphemeralKey creates a key that lives until restart, so that a
+// service without configured keys can still run in development. Every restart
+// invalidates the issued tokens, so it is only used with allow_ephemeral_key.
+func generateEphemeralKey() (*signingKey, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate signing key: %w", err)
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("generate signing key id: %w", err)
+	}
+	return &signingKey{id: "ephemeral-" + hex.EncodeToString(suffix), method: jwt.SigningMethodEdDSA, key: priv}, nil
+}
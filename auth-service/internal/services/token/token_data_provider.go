@@ -30,57 +30,173 @@ func (p *tokenDataProvider) SaveEmployerToken(ctx context.Context, uow *postgres
 	return p.save(ctx, uow, t, repo.EmployerRefreshTokenRepository, cache.EmployerRefreshTokenCache)
 }
 
+func (p *tokenDataProvider) RotateApplicantToken(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	t *token.Token, rotated *token.RotatedToken,
+) error {
+	return p.rotate(ctx, uow, t, rotated, repo.ApplicantRefreshTokenRepository, repo.ApplicantRotatedRefreshTokenRepository, cache.ApplicantRefreshTokenCache)
+}
+
+func (p *tokenDataProvider) GetApplicantRotatedToken(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	tokenHash string,
+) (*token.RotatedToken, error) {
+	return p.getRotated(ctx, uow, tokenHash, repo.ApplicantRotatedRefreshTokenRepository)
+}
+
+func (p *tokenDataProvider) RotateEmployerToken(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	t *token.Token, rotated *token.RotatedToken,
+) error {
+	return p.rotate(ctx, uow, t, rotated, repo.EmployerRefreshTokenRepository, repo.EmployerRotatedRefreshTokenRepository, cache.EmployerRefreshTokenCache)
+}
+
+func (p *tokenDataProvider) GetEmployerRotatedToken(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	tokenHash string,
+) (*token.RotatedToken, error) {
+	return p.getRotated(ctx, uow, tokenHash, repo.EmployerRotatedRefreshTokenRepository)
+}
+
 func (p *tokenDataProvider) GetApplicantToken(
 	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
-	token string,
+	tokenHash string,
 ) (*token.Token, error) {
-	return p.get(ctx, uow, token, repo.ApplicantRefreshTokenRepository, cache.ApplicantRefreshTokenCache)
+	return p.get(ctx, uow, tokenHash, repo.ApplicantRefreshTokenRepository, cache.ApplicantRefreshTokenCache)
 }
 
 func (p *tokenDataProvider) GetEmployerToken(
 	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
-	token string,
+	tokenHash string,
 ) (*token.Token, error) {
-	return p.get(ctx, uow, token, repo.EmployerRefreshTokenRepository, cache.EmployerRefreshTokenCache)
+	return p.get(ctx, uow, tokenHash, repo.EmployerRefreshTokenRepository, cache.EmployerRefreshTokenCache)
 }
 
 func (p *tokenDataProvider) DeleteApplicantToken(
 	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
-	token string,
+	tokenHash string,
 ) error {
-	return p.delete(ctx, uow, token, repo.ApplicantRefreshTokenRepository, cache.ApplicantRefreshTokenCache)
+	return p.delete(ctx, uow, tokenHash, repo.ApplicantRefreshTokenRepository, cache.ApplicantRefreshTokenCache)
 }
 
-func (p *tokenDataProvider) DeleteApplicantTokenFromCache(ctx context.Context, token string) error {
+func (p *tokenDataProvider) DeleteApplicantTokenFromCache(ctx context.Context, tokenHash string) error {
 	cacheRepo := cache.NewTokenCacheRepository(p.redis, cache.ApplicantRefreshTokenCache)
-	return cacheRepo.Del(ctx, token)
+	return cacheRepo.Del(ctx, tokenHash)
 }
 
 func (p *tokenDataProvider) DeleteEmployerToken(
 	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
-	token string,
+	tokenHash string,
 ) error {
-	return p.delete(ctx, uow, token, repo.EmployerRefreshTokenRepository, cache.EmployerRefreshTokenCache)
+	return p.delete(ctx, uow, tokenHash, repo.EmployerRefreshTokenRepository, cache.EmployerRefreshTokenCache)
 }
 
-func (p *tokenDataProvider) DeleteEmployerTokenFromCache(ctx context.Context, token string) error {
+func (p *tokenDataProvider) DeleteEmployerTokenFromCache(ctx context.Context, tokenHash string) error {
 	cacheRepo := cache.NewTokenCacheRepository(p.redis, cache.EmployerRefreshTokenCache)
-	return cacheRepo.Del(ctx, token)
+	return cacheRepo.Del(ctx, tokenHash)
+}
+
+func (p *tokenDataProvider) DeleteApplicantTokensByUserId(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) error {
+	return p.deleteByUserId(ctx, uow, userId, repo.ApplicantRefreshTokenRepository, cache.ApplicantRefreshTokenCache)
+}
+
+func (p *tokenDataProvider) DeleteEmployerTokensByUserId(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) error {
+	return p.deleteByUserId(ctx, uow, userId, repo.EmployerRefreshTokenRepository, cache.EmployerRefreshTokenCache)
+}
+
+func (p *tokenDataProvider) DeleteApplicantTokensByUserIdExceptId(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64, exceptId int64,
+) error {
+	return p.deleteByUserIdExceptId(ctx, uow, userId, exceptId, repo.ApplicantRefreshTokenRepository, cache.ApplicantRefreshTokenCache)
+}
+
+func (p *tokenDataProvider) DeleteEmployerTokensByUserIdExceptId(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64, exceptId int64,
+) error {
+	return p.deleteByUserIdExceptId(ctx, uow, userId, exceptId, repo.EmployerRefreshTokenRepository, cache.EmployerRefreshTokenCache)
+}
+
+func (p *tokenDataProvider) GetApplicantTokenById(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	id int64, userId int64,
+) (*token.Token, error) {
+	return p.getById(ctx, uow, id, userId, repo.ApplicantRefreshTokenRepository)
+}
+
+func (p *tokenDataProvider) GetEmployerTokenById(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	id int64, userId int64,
+) (*token.Token, error) {
+	return p.getById(ctx, uow, id, userId, repo.EmployerRefreshTokenRepository)
+}
+
+func (p *tokenDataProvider) ListApplicantTokens(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) ([]*token.Token, error) {
+	return p.list(ctx, uow, userId, repo.ApplicantRefreshTokenRepository)
+}
+
+func (p *tokenDataProvider) ListEmployerTokens(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) ([]*token.Token, error) {
+	return p.list(ctx, uow, userId, repo.EmployerRefreshTokenRepository)
+}
+
+func (p *tokenDataProvider) GetApplicantSecurityVersion(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) (int, error) {
+	dbRepo := repo.NewSecurityVersionRepository(uow, repo.ApplicantSecurityVersionRepository)
+	return dbRepo.GetVersion(ctx, userId)
+}
+
+func (p *tokenDataProvider) IncrementApplicantSecurityVersion(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) (int, error) {
+	dbRepo := repo.NewSecurityVersionRepository(uow, repo.ApplicantSecurityVersionRepository)
+	return dbRepo.IncrementVersion(ctx, userId)
+}
+
+func (p *tokenDataProvider) GetEmployerSecurityVersion(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) (int, error) {
+	dbRepo := repo.NewSecurityVersionRepository(uow, repo.EmployerSecurityVersionRepository)
+	return dbRepo.GetVersion(ctx, userId)
+}
+
+func (p *tokenDataProvider) IncrementEmployerSecurityVersion(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+) (int, error) {
+	dbRepo := repo.NewSecurityVersionRepository(uow, repo.EmployerSecurityVersionRepository)
+	return dbRepo.IncrementVersion(ctx, userId)
 }
 
 func (p *tokenDataProvider) get(
 	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
-	token string,
+	tokenHash string,
 	repoType impl.RepositoryType, cacheType impl.RepositoryType,
 ) (*token.Token, error) {
 	cacheRepo := cache.NewTokenCacheRepository(p.redis, cacheType)
-	t, err := cacheRepo.Get(ctx, token)
+	t, err := cacheRepo.Get(ctx, tokenHash)
 	if err == nil && t != nil {
 		return t, nil
 	}
 
 	dbRepo := repo.NewTokenRepository(uow, repoType)
-	query := dal.NewQueryTokenDal(nil, nil, &token)
+	query := dal.NewQueryTokenDal(nil, nil, &tokenHash)
 	t, err = dbRepo.QueryToken(ctx, query)
 	if err != nil {
 		return nil, err
@@ -93,22 +209,115 @@ func (p *tokenDataProvider) get(
 	return t, nil
 }
 
+func (p *tokenDataProvider) getById(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	id int64, userId int64,
+	repoType impl.RepositoryType,
+) (*token.Token, error) {
+	dbRepo := repo.NewTokenRepository(uow, repoType)
+	query := dal.NewQueryTokenDal(&id, &userId, nil)
+	return dbRepo.QueryToken(ctx, query)
+}
+
+func (p *tokenDataProvider) list(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+	repoType impl.RepositoryType,
+) ([]*token.Token, error) {
+	dbRepo := repo.NewTokenRepository(uow, repoType)
+	query := dal.NewQueryTokenDal(nil, &userId, nil)
+	return dbRepo.QueryTokens(ctx, query)
+}
+
 func (p *tokenDataProvider) delete(
 	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
-	token string,
+	tokenHash string,
+	repoType impl.RepositoryType, cacheType impl.RepositoryType,
+) error {
+	dbRepo := repo.NewTokenRepository(uow, repoType)
+	err := dbRepo.DeleteToken(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	cacheRepo := cache.NewTokenCacheRepository(p.redis, cacheType)
+	cacheRepo.Del(ctx, tokenHash)
+	return nil
+}
+
+func (p *tokenDataProvider) deleteByUserId(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64,
+	repoType impl.RepositoryType, cacheType impl.RepositoryType,
+) error {
+	dbRepo := repo.NewTokenRepository(uow, repoType)
+	tokens, err := dbRepo.DeleteTokensByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	cacheRepo := cache.NewTokenCacheRepository(p.redis, cacheType)
+	for _, t := range tokens {
+		if err := cacheRepo.Del(ctx, t.TokenHash()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *tokenDataProvider) deleteByUserIdExceptId(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	userId int64, exceptId int64,
 	repoType impl.RepositoryType, cacheType impl.RepositoryType,
 ) error {
 	dbRepo := repo.NewTokenRepository(uow, repoType)
-	err := dbRepo.DeleteToken(ctx, token)
+	tokens, err := dbRepo.DeleteTokensByUserIdExceptId(ctx, userId, exceptId)
 	if err != nil {
 		return err
 	}
 
 	cacheRepo := cache.NewTokenCacheRepository(p.redis, cacheType)
-	cacheRepo.Del(ctx, token)
+	for _, t := range tokens {
+		if err := cacheRepo.Del(ctx, t.TokenHash()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rotate stores the new value of t only if the rotated token is still the
+// current token of the family, then remembers the rotated token to detect its reuse.
+func (p *tokenDataProvider) rotate(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	t *token.Token, rotated *token.RotatedToken,
+	repoType impl.RepositoryType, rotatedRepoType impl.RepositoryType, cacheType impl.RepositoryType,
+) error {
+	dbRepo := repo.NewTokenRepository(uow, repoType)
+	if err := dbRepo.RotateToken(ctx, t, rotated.TokenHash()); err != nil {
+		return err
+	}
+
+	rotatedRepo := repo.NewRotatedTokenRepository(uow, rotatedRepoType)
+	if err := rotatedRepo.CreateRotatedToken(ctx, rotated); err != nil {
+		return err
+	}
+
+	cacheRepo := cache.NewTokenCacheRepository(p.redis, cacheType)
+	cacheRepo.Set(ctx, t)
+
 	return nil
 }
 
+func (p *tokenDataProvider) getRotated(
+	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
+	tokenHash string,
+	rotatedRepoType impl.RepositoryType,
+) (*token.RotatedToken, error) {
+	rotatedRepo := repo.NewRotatedTokenRepository(uow, rotatedRepoType)
+	query := dal.NewQueryRotatedTokenDal(nil, &tokenHash)
+	return rotatedRepo.QueryRotatedToken(ctx, query)
+}
+
 func (p *tokenDataProvider) save(
 	ctx context.Context, uow *postgresunitofwork.UnitOfWork,
 	t *token.Token,
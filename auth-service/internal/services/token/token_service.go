@@ -2,20 +2,31 @@ package tokenservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/user_service/v1"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/token"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/impl"
 	uow "github.com/ZaiiiRan/job_search_service/auth-service/internal/repositories/unitofwork/postgres"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/transport/redis"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/utils"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
 	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt/denylist"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+var (
+	ErrTokenNotFound = errors.New("token not found")
+	ErrTokenReused   = fmt.Errorf("%w: refresh token reuse detected", claims.ErrInvalidToken)
+)
+
 type TokenService interface {
 	GenerateApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, existedRefreshToken *token.Token) (access *token.Token, refresh *token.Token, err error)
 	GenerateEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, existedRefreshToken *token.Token) (access *token.Token, refresh *token.Token, err error)
@@ -23,20 +34,44 @@ type TokenService interface {
 	ValidateEmployerRefreshToken(ctx context.Context, uow *uow.UnitOfWork, tokenStr string) (*token.Token, error)
 	ValidateApplicantAccessToken(ctx context.Context, tokenStr string) (*claims.ApplicantClaims, error)
 	ValidateEmployerAccessToken(ctx context.Context, tokenStr string) (*claims.EmployerClaims, error)
+	GetAccessTokenKind(ctx context.Context, tokenStr string) (string, error)
 	InvalidateApplicant(ctx context.Context, uow *uow.UnitOfWork, refreshStr string) error
 	InvalidateEmployer(ctx context.Context, uow *uow.UnitOfWork, refreshStr string) error
+	InvalidateAllApplicant(ctx context.Context, uow *uow.UnitOfWork, applicantId int64) error
+	InvalidateAllEmployer(ctx context.Context, uow *uow.UnitOfWork, employerId int64) error
+	BumpApplicantVersion(ctx context.Context, uow *uow.UnitOfWork, applicantId int64) (int, error)
+	BumpEmployerVersion(ctx context.Context, uow *uow.UnitOfWork, employerId int64) (int, error)
+	RevokeApplicantAccessToken(ctx context.Context, accessStr string) error
+	RevokeEmployerAccessToken(ctx context.Context, accessStr string) error
+	ListApplicant(ctx context.Context, uow *uow.UnitOfWork, applicantId int64) ([]*token.Token, error)
+	ListEmployer(ctx context.Context, uow *uow.UnitOfWork, employerId int64) ([]*token.Token, error)
+	InvalidateApplicantById(ctx context.Context, uow *uow.UnitOfWork, applicantId int64, tokenId int64) (*token.Token, error)
+	InvalidateEmployerById(ctx context.Context, uow *uow.UnitOfWork, employerId int64, tokenId int64) (*token.Token, error)
+	InvalidateOtherApplicant(ctx context.Context, uow *uow.UnitOfWork, applicantId int64, currentTokenId int64) error
+	InvalidateOtherEmployer(ctx context.Context, uow *uow.UnitOfWork, employerId int64, currentTokenId int64) error
+	CheckToken(t *token.Token, tokenStr string) bool
 }
 
 type service struct {
 	dataProvider *tokenDataProvider
 	jwtSettings  *settings.JWTSettings
+	keys         *Keys
+	hasher       *secret.Hasher
+	denylist     denylist.Denylist
 	log          *zap.SugaredLogger
 }
 
-func New(jwtSettings settings.JWTSettings, redis *redis.RedisClient, log *zap.SugaredLogger) TokenService {
+func New(
+	jwtSettings settings.JWTSettings, keys *Keys, hasher *secret.Hasher,
+	denylist denylist.Denylist, redis *redis.RedisClient,
+	log *zap.SugaredLogger,
+) TokenService {
 	return &service{
 		dataProvider: newTokenDataProvider(redis),
 		jwtSettings:  &jwtSettings,
+		keys:         keys,
+		hasher:       hasher,
+		denylist:     denylist,
 		log:          log,
 	}
 }
@@ -44,6 +79,16 @@ func New(jwtSettings settings.JWTSettings, redis *redis.RedisClient, log *zap.Su
 func (s *service) GenerateApplicant(ctx context.Context, uow *uow.UnitOfWork, applicant *pb.Applicant, existedRefreshToken *token.Token) (*token.Token, *token.Token, error) {
 	l := s.log.With("op", "generate_tokens_for_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicant.Id)
 
+	version, err := s.dataProvider.GetApplicantSecurityVersion(ctx, uow, applicant.Id)
+	if err != nil {
+		l.Errorw("token.get_security_version_failed", "err", err)
+		return nil, nil, err
+	}
+	if err := s.denylist.SetUserVersion(ctx, denylist.Applicant, applicant.Id, version); err != nil {
+		l.Errorw("token.set_security_version_failed", "err", err)
+		return nil, nil, err
+	}
+
 	c := &claims.ApplicantClaims{
 		Id:         applicant.Id,
 		FirstName:  applicant.FirstName,
@@ -52,35 +97,56 @@ func (s *service) GenerateApplicant(ctx context.Context, uow *uow.UnitOfWork, ap
 		Email:      applicant.Email,
 		IsActive:   applicant.IsActive,
 		IsDeleted:  applicant.IsDeleted,
+		Version:    version,
 	}
 
-	access, accessExp, err := signToken(c, []byte(s.jwtSettings.AccessTokenSecret), time.Duration(s.jwtSettings.AccessTokenTTL)*time.Second)
+	access, accessExp, err := signToken(c, s.keys.access, time.Duration(s.jwtSettings.AccessTokenTTL)*time.Second)
 	if err != nil {
 		l.Errorw("token.sign_access_failed", "err", err)
 		return nil, nil, err
 	}
 
-	refresh, refreshExp, err := signToken(c, []byte(s.jwtSettings.RefreshTokenSecret), time.Duration(s.jwtSettings.RefreshTokenTTL)*time.Second)
+	refresh, refreshExp, err := signToken(c, s.keys.refresh, time.Duration(s.jwtSettings.RefreshTokenTTL)*time.Second)
 	if err != nil {
 		l.Errorw("token.sign_refresh_failed", "err", err)
 		return nil, nil, err
 	}
 
-	accessToken := token.New(applicant.Id, access, token.AccessTokenType, accessExp)
+	accessToken := token.New(applicant.Id, access, token.AccessTokenType, accessExp, s.hasher)
 
-	var refreshToken *token.Token
+	var (
+		refreshToken *token.Token
+		rotated      *token.RotatedToken
+	)
 	if existedRefreshToken != nil {
-		if err := s.dataProvider.DeleteApplicantTokenFromCache(ctx, existedRefreshToken.Token()); err != nil {
+		if err := s.dataProvider.DeleteApplicantTokenFromCache(ctx, existedRefreshToken.TokenHash()); err != nil {
 			l.Errorw("token.delete_existed_from_cache", "err", err)
 			return nil, nil, err
 		}
+		rotated = token.NewRotatedToken(existedRefreshToken)
 		refreshToken = existedRefreshToken
-		refreshToken.SetToken(refresh, refreshExp)
+		refreshToken.SetToken(refresh, refreshExp, s.hasher)
 	} else {
-		refreshToken = token.New(applicant.Id, refresh, token.RefreshTokenType, refreshExp)
+		refreshToken = token.New(applicant.Id, refresh, token.RefreshTokenType, refreshExp, s.hasher)
 	}
 
-	if err := s.dataProvider.SaveApplicantToken(ctx, uow, refreshToken); err != nil {
+	clientInfo := utils.GetClientInfoFromContext(ctx)
+	refreshToken.SetClientInfo(clientInfo.Device, clientInfo.UserAgent, clientInfo.IP)
+
+	if rotated != nil {
+		if err := s.dataProvider.RotateApplicantToken(ctx, uow, refreshToken, rotated); err != nil {
+			if errors.Is(err, impl.ErrConflict) {
+				// the token was rotated concurrently, so one of the callers replays it
+				l.Warnw("token.refresh_token_reuse_detected", "family_id", rotated.FamilyId())
+				if _, err := s.InvalidateApplicantById(ctx, uow, applicant.Id, rotated.FamilyId()); err != nil && !errors.Is(err, ErrTokenNotFound) {
+					return nil, nil, err
+				}
+				return nil, nil, ErrTokenReused
+			}
+			l.Errorw("token.rotate_token_failed", "err", err)
+			return nil, nil, err
+		}
+	} else if err := s.dataProvider.SaveApplicantToken(ctx, uow, refreshToken); err != nil {
 		l.Errorw("token.save_token_failed", "err", err)
 		return nil, nil, err
 	}
@@ -92,41 +158,72 @@ func (s *service) GenerateApplicant(ctx context.Context, uow *uow.UnitOfWork, ap
 func (s *service) GenerateEmployer(ctx context.Context, uow *uow.UnitOfWork, employer *pb.Employer, existedRefreshToken *token.Token) (*token.Token, *token.Token, error) {
 	l := s.log.With("op", "generate_tokens_for_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employer.Id)
 
+	version, err := s.dataProvider.GetEmployerSecurityVersion(ctx, uow, employer.Id)
+	if err != nil {
+		l.Errorw("token.get_security_version_failed", "err", err)
+		return nil, nil, err
+	}
+	if err := s.denylist.SetUserVersion(ctx, denylist.Employer, employer.Id, version); err != nil {
+		l.Errorw("token.set_security_version_failed", "err", err)
+		return nil, nil, err
+	}
+
 	c := &claims.EmployerClaims{
 		Id:          employer.Id,
 		CompanyName: employer.CompanyName,
 		Email:       employer.Email,
 		IsActive:    employer.IsActive,
 		IsDeleted:   employer.IsDeleted,
+		Version:     version,
 	}
 
-	access, accessExp, err := signToken(c, []byte(s.jwtSettings.AccessTokenSecret), time.Duration(s.jwtSettings.AccessTokenTTL)*time.Second)
+	access, accessExp, err := signToken(c, s.keys.access, time.Duration(s.jwtSettings.AccessTokenTTL)*time.Second)
 	if err != nil {
 		l.Errorw("token.sign_access_failed", "err", err)
 		return nil, nil, err
 	}
 
-	refresh, refreshExp, err := signToken(c, []byte(s.jwtSettings.RefreshTokenSecret), time.Duration(s.jwtSettings.RefreshTokenTTL)*time.Second)
+	refresh, refreshExp, err := signToken(c, s.keys.refresh, time.Duration(s.jwtSettings.RefreshTokenTTL)*time.Second)
 	if err != nil {
 		l.Errorw("token.sign_refresh_failed", "err", err)
 		return nil, nil, err
 	}
 
-	accessToken := token.New(employer.Id, access, token.AccessTokenType, accessExp)
+	accessToken := token.New(employer.Id, access, token.AccessTokenType, accessExp, s.hasher)
 
-	var refreshToken *token.Token
+	var (
+		refreshToken *token.Token
+		rotated      *token.RotatedToken
+	)
 	if existedRefreshToken != nil {
-		if err := s.dataProvider.DeleteEmployerTokenFromCache(ctx, existedRefreshToken.Token()); err != nil {
+		if err := s.dataProvider.DeleteEmployerTokenFromCache(ctx, existedRefreshToken.TokenHash()); err != nil {
 			l.Errorw("token.delete_existed_from_cache", "err", err)
 			return nil, nil, err
 		}
+		rotated = token.NewRotatedToken(existedRefreshToken)
 		refreshToken = existedRefreshToken
-		refreshToken.SetToken(refresh, refreshExp)
+		refreshToken.SetToken(refresh, refreshExp, s.hasher)
 	} else {
-		refreshToken = token.New(employer.Id, refresh, token.RefreshTokenType, refreshExp)
+		refreshToken = token.New(employer.Id, refresh, token.RefreshTokenType, refreshExp, s.hasher)
 	}
 
-	if err := s.dataProvider.SaveEmployerToken(ctx, uow, refreshToken); err != nil {
+	clientInfo := utils.GetClientInfoFromContext(ctx)
+	refreshToken.SetClientInfo(clientInfo.Device, clientInfo.UserAgent, clientInfo.IP)
+
+	if rotated != nil {
+		if err := s.dataProvider.RotateEmployerToken(ctx, uow, refreshToken, rotated); err != nil {
+			if errors.Is(err, impl.ErrConflict) {
+				// the token was rotated concurrently, so one of the callers replays it
+				l.Warnw("token.refresh_token_reuse_detected", "family_id", rotated.FamilyId())
+				if _, err := s.InvalidateEmployerById(ctx, uow, employer.Id, rotated.FamilyId()); err != nil && !errors.Is(err, ErrTokenNotFound) {
+					return nil, nil, err
+				}
+				return nil, nil, ErrTokenReused
+			}
+			l.Errorw("token.rotate_token_failed", "err", err)
+			return nil, nil, err
+		}
+	} else if err := s.dataProvider.SaveEmployerToken(ctx, uow, refreshToken); err != nil {
 		l.Errorw("token.save_token_failed", "err", err)
 		return nil, nil, err
 	}
@@ -138,17 +235,31 @@ func (s *service) GenerateEmployer(ctx context.Context, uow *uow.UnitOfWork, emp
 func (s *service) ValidateApplicantRefreshToken(ctx context.Context, uow *uow.UnitOfWork, tokenStr string) (*token.Token, error) {
 	l := s.log.With("op", "validate_applicant_refresh_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	cl, err := claims.ParseApplicantToken(tokenStr, []byte(s.jwtSettings.RefreshTokenSecret))
+	cl, err := claims.ParseApplicantToken(tokenStr, s.keys.refreshKeySet)
 	if err != nil {
 		l.Warnw("token.refresh_token_parse_failed", "err", err)
 		return nil, claims.ErrInvalidToken
 	}
 
-	t, err := s.dataProvider.GetApplicantToken(ctx, uow, tokenStr)
+	t, err := s.dataProvider.GetApplicantToken(ctx, uow, s.hasher.Hash(tokenStr))
 	if err != nil {
 		l.Errorw("token.get_token_failed", "err", err)
 		return nil, err
 	}
+	if t == nil {
+		rotated, err := s.dataProvider.GetApplicantRotatedToken(ctx, uow, s.hasher.Hash(tokenStr))
+		if err != nil {
+			l.Errorw("token.get_rotated_token_failed", "err", err)
+			return nil, err
+		}
+		if rotated != nil && rotated.UserId() == cl.Id {
+			l.Warnw("token.refresh_token_reuse_detected", "applicant_id", cl.Id, "family_id", rotated.FamilyId())
+			if _, err := s.InvalidateApplicantById(ctx, uow, cl.Id, rotated.FamilyId()); err != nil && !errors.Is(err, ErrTokenNotFound) {
+				return nil, err
+			}
+			return nil, ErrTokenReused
+		}
+	}
 	if t == nil || cl.Id != t.UserId() {
 		l.Warnw("token.refresh_token_invalid")
 		return nil, claims.ErrInvalidToken
@@ -161,17 +272,31 @@ func (s *service) ValidateApplicantRefreshToken(ctx context.Context, uow *uow.Un
 func (s *service) ValidateEmployerRefreshToken(ctx context.Context, uow *uow.UnitOfWork, tokenStr string) (*token.Token, error) {
 	l := s.log.With("op", "validate_employer_refresh_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	cl, err := claims.ParseEmployerToken(tokenStr, []byte(s.jwtSettings.RefreshTokenSecret))
+	cl, err := claims.ParseEmployerToken(tokenStr, s.keys.refreshKeySet)
 	if err != nil {
 		l.Warnw("token.refresh_token_parse_failed", "err", err)
 		return nil, claims.ErrInvalidToken
 	}
 
-	t, err := s.dataProvider.GetEmployerToken(ctx, uow, tokenStr)
+	t, err := s.dataProvider.GetEmployerToken(ctx, uow, s.hasher.Hash(tokenStr))
 	if err != nil {
 		l.Errorw("token.get_token_failed", "err", err)
 		return nil, err
 	}
+	if t == nil {
+		rotated, err := s.dataProvider.GetEmployerRotatedToken(ctx, uow, s.hasher.Hash(tokenStr))
+		if err != nil {
+			l.Errorw("token.get_rotated_token_failed", "err", err)
+			return nil, err
+		}
+		if rotated != nil && rotated.UserId() == cl.Id {
+			l.Warnw("token.refresh_token_reuse_detected", "employer_id", cl.Id, "family_id", rotated.FamilyId())
+			if _, err := s.InvalidateEmployerById(ctx, uow, cl.Id, rotated.FamilyId()); err != nil && !errors.Is(err, ErrTokenNotFound) {
+				return nil, err
+			}
+			return nil, ErrTokenReused
+		}
+	}
 	if t == nil || cl.Id != t.UserId() {
 		l.Warnw("token.refresh_token_invalid")
 		return nil, claims.ErrInvalidToken
@@ -184,12 +309,28 @@ func (s *service) ValidateEmployerRefreshToken(ctx context.Context, uow *uow.Uni
 func (s *service) ValidateApplicantAccessToken(ctx context.Context, tokenStr string) (*claims.ApplicantClaims, error) {
 	l := s.log.With("op", "validate_applicant_access_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	cl, err := claims.ParseApplicantToken(tokenStr, []byte(s.jwtSettings.AccessTokenSecret))
+	cl, err := claims.ParseApplicantToken(tokenStr, s.keys.accessKeySet)
 	if err != nil {
 		l.Warnw("token.access_token_parse_failed", "err", err)
 		return nil, claims.ErrInvalidToken
 	}
 
+	revoked, err := s.denylist.IsRevoked(ctx, denylist.TokenInfo{
+		Subject:  denylist.Applicant,
+		UserId:   cl.Id,
+		Jti:      cl.ID,
+		IssuedAt: issuedAt(&cl.RegisteredClaims),
+		Version:  cl.Version,
+	})
+	if err != nil {
+		l.Errorw("token.denylist_check_failed", "err", err)
+		return nil, err
+	}
+	if revoked {
+		l.Warnw("token.access_token_revoked", "applicant_id", cl.Id)
+		return nil, claims.ErrInvalidToken
+	}
+
 	l.Infow("token.access_token_valid", "applicant_id", cl.Id)
 	return cl, nil
 }
@@ -197,19 +338,48 @@ func (s *service) ValidateApplicantAccessToken(ctx context.Context, tokenStr str
 func (s *service) ValidateEmployerAccessToken(ctx context.Context, tokenStr string) (*claims.EmployerClaims, error) {
 	l := s.log.With("op", "validate_employer_access_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
 
-	cl, err := claims.ParseEmployerToken(tokenStr, []byte(s.jwtSettings.AccessTokenSecret))
+	cl, err := claims.ParseEmployerToken(tokenStr, s.keys.accessKeySet)
 	if err != nil {
 		l.Warnw("token.access_token_parse_failed", "err", err)
 		return nil, claims.ErrInvalidToken
 	}
 
+	revoked, err := s.denylist.IsRevoked(ctx, denylist.TokenInfo{
+		Subject:  denylist.Employer,
+		UserId:   cl.Id,
+		Jti:      cl.ID,
+		IssuedAt: issuedAt(&cl.RegisteredClaims),
+		Version:  cl.Version,
+	})
+	if err != nil {
+		l.Errorw("token.denylist_check_failed", "err", err)
+		return nil, err
+	}
+	if revoked {
+		l.Warnw("token.access_token_revoked", "employer_id", cl.Id)
+		return nil, claims.ErrInvalidToken
+	}
+
 	l.Infow("token.access_token_valid", "employer_id", cl.Id)
 	return cl, nil
 }
 
+// GetAccessTokenKind returns the kind of a correctly signed access token,
+// the token still has to be validated by the validation of its kind.
+func (s *service) GetAccessTokenKind(ctx context.Context, tokenStr string) (string, error) {
+	l := s.log.With("op", "get_access_token_kind", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	kind, err := claims.ParseTokenKind(tokenStr, s.keys.accessKeySet)
+	if err != nil {
+		l.Warnw("token.access_token_parse_failed", "err", err)
+		return "", claims.ErrInvalidToken
+	}
+	return kind, nil
+}
+
 func (s *service) InvalidateApplicant(ctx context.Context, uow *uow.UnitOfWork, refreshStr string) error {
 	l := s.log.With("op", "invalidate_applicant_refresh_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
-	err := s.dataProvider.DeleteApplicantToken(ctx, uow, refreshStr)
+	err := s.dataProvider.DeleteApplicantToken(ctx, uow, s.hasher.Hash(refreshStr))
 	if err != nil {
 		l.Errorw("token.delete_refresh_token_failed", "err", err)
 		return err
@@ -221,7 +391,7 @@ func (s *service) InvalidateApplicant(ctx context.Context, uow *uow.UnitOfWork,
 
 func (s *service) InvalidateEmployer(ctx context.Context, uow *uow.UnitOfWork, refreshStr string) error {
 	l := s.log.With("op", "invalidate_employer_refresh_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
-	err := s.dataProvider.DeleteEmployerToken(ctx, uow, refreshStr)
+	err := s.dataProvider.DeleteEmployerToken(ctx, uow, s.hasher.Hash(refreshStr))
 	if err != nil {
 		l.Errorw("token.delete_refresh_token_failed", "err", err)
 		return err
@@ -231,19 +401,224 @@ func (s *service) InvalidateEmployer(ctx context.Context, uow *uow.UnitOfWork, r
 	return nil
 }
 
-func signToken[T jwt.Claims](c T, key []byte, ttl time.Duration) (string, time.Time, error) {
+func (s *service) InvalidateAllApplicant(ctx context.Context, uow *uow.UnitOfWork, applicantId int64) error {
+	l := s.log.With("op", "invalidate_all_applicant_refresh_tokens", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicantId)
+	err := s.dataProvider.DeleteApplicantTokensByUserId(ctx, uow, applicantId)
+	if err != nil {
+		l.Errorw("token.delete_refresh_tokens_failed", "err", err)
+		return err
+	}
+
+	accessTTL := time.Duration(s.jwtSettings.AccessTokenTTL) * time.Second
+	if err := s.denylist.RevokeUserTokens(ctx, denylist.Applicant, applicantId, time.Now(), accessTTL); err != nil {
+		l.Errorw("token.revoke_access_tokens_failed", "err", err)
+		return err
+	}
+
+	l.Infow("token.invalidate_all_refresh_tokens.success")
+	return nil
+}
+
+func (s *service) InvalidateAllEmployer(ctx context.Context, uow *uow.UnitOfWork, employerId int64) error {
+	l := s.log.With("op", "invalidate_all_employer_refresh_tokens", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employerId)
+	err := s.dataProvider.DeleteEmployerTokensByUserId(ctx, uow, employerId)
+	if err != nil {
+		l.Errorw("token.delete_refresh_tokens_failed", "err", err)
+		return err
+	}
+
+	accessTTL := time.Duration(s.jwtSettings.AccessTokenTTL) * time.Second
+	if err := s.denylist.RevokeUserTokens(ctx, denylist.Employer, employerId, time.Now(), accessTTL); err != nil {
+		l.Errorw("token.revoke_access_tokens_failed", "err", err)
+		return err
+	}
+
+	l.Infow("token.invalidate_all_refresh_tokens.success")
+	return nil
+}
+
+func (s *service) BumpApplicantVersion(ctx context.Context, uow *uow.UnitOfWork, applicantId int64) (int, error) {
+	l := s.log.With("op", "bump_applicant_security_version", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicantId)
+
+	version, err := s.dataProvider.IncrementApplicantSecurityVersion(ctx, uow, applicantId)
+	if err != nil {
+		l.Errorw("token.increment_security_version_failed", "err", err)
+		return 0, err
+	}
+	if err := s.denylist.SetUserVersion(ctx, denylist.Applicant, applicantId, version); err != nil {
+		l.Errorw("token.set_security_version_failed", "err", err)
+		return 0, err
+	}
+
+	l.Infow("token.bump_security_version.success", "version", version)
+	return version, nil
+}
+
+func (s *service) BumpEmployerVersion(ctx context.Context, uow *uow.UnitOfWork, employerId int64) (int, error) {
+	l := s.log.With("op", "bump_employer_security_version", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employerId)
+
+	version, err := s.dataProvider.IncrementEmployerSecurityVersion(ctx, uow, employerId)
+	if err != nil {
+		l.Errorw("token.increment_security_version_failed", "err", err)
+		return 0, err
+	}
+	if err := s.denylist.SetUserVersion(ctx, denylist.Employer, employerId, version); err != nil {
+		l.Errorw("token.set_security_version_failed", "err", err)
+		return 0, err
+	}
+
+	l.Infow("token.bump_security_version.success", "version", version)
+	return version, nil
+}
+
+func (s *service) RevokeApplicantAccessToken(ctx context.Context, accessStr string) error {
+	l := s.log.With("op", "revoke_applicant_access_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	cl, err := claims.ParseApplicantToken(accessStr, s.keys.accessKeySet)
+	if err != nil {
+		// an invalid or expired token cannot be used anyway
+		return nil
+	}
+
+	if err := s.denylist.RevokeToken(ctx, cl.ID, cl.ExpiresAt.Time); err != nil {
+		l.Errorw("token.revoke_access_token_failed", "err", err)
+		return err
+	}
+
+	l.Infow("token.revoke_access_token.success", "applicant_id", cl.Id)
+	return nil
+}
+
+func (s *service) RevokeEmployerAccessToken(ctx context.Context, accessStr string) error {
+	l := s.log.With("op", "revoke_employer_access_token", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	cl, err := claims.ParseEmployerToken(accessStr, s.keys.accessKeySet)
+	if err != nil {
+		// an invalid or expired token cannot be used anyway
+		return nil
+	}
+
+	if err := s.denylist.RevokeToken(ctx, cl.ID, cl.ExpiresAt.Time); err != nil {
+		l.Errorw("token.revoke_access_token_failed", "err", err)
+		return err
+	}
+
+	l.Infow("token.revoke_access_token.success", "employer_id", cl.Id)
+	return nil
+}
+
+func (s *service) ListApplicant(ctx context.Context, uow *uow.UnitOfWork, applicantId int64) ([]*token.Token, error) {
+	l := s.log.With("op", "list_applicant_refresh_tokens", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicantId)
+	tokens, err := s.dataProvider.ListApplicantTokens(ctx, uow, applicantId)
+	if err != nil {
+		l.Errorw("token.list_refresh_tokens_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("token.list_refresh_tokens.success", "count", len(tokens))
+	return tokens, nil
+}
+
+func (s *service) ListEmployer(ctx context.Context, uow *uow.UnitOfWork, employerId int64) ([]*token.Token, error) {
+	l := s.log.With("op", "list_employer_refresh_tokens", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employerId)
+	tokens, err := s.dataProvider.ListEmployerTokens(ctx, uow, employerId)
+	if err != nil {
+		l.Errorw("token.list_refresh_tokens_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("token.list_refresh_tokens.success", "count", len(tokens))
+	return tokens, nil
+}
+
+func (s *service) InvalidateApplicantById(ctx context.Context, uow *uow.UnitOfWork, applicantId int64, tokenId int64) (*token.Token, error) {
+	l := s.log.With("op", "invalidate_applicant_refresh_token_by_id", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicantId, "token_id", tokenId)
+	t, err := s.dataProvider.GetApplicantTokenById(ctx, uow, tokenId, applicantId)
+	if err != nil {
+		l.Errorw("token.get_token_failed", "err", err)
+		return nil, err
+	}
+	if t == nil {
+		l.Warnw("token.refresh_token_not_found")
+		return nil, ErrTokenNotFound
+	}
+
+	if err := s.dataProvider.DeleteApplicantToken(ctx, uow, t.TokenHash()); err != nil {
+		l.Errorw("token.delete_refresh_token_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("token.invalidate_refresh_token.success")
+	return t, nil
+}
+
+func (s *service) InvalidateEmployerById(ctx context.Context, uow *uow.UnitOfWork, employerId int64, tokenId int64) (*token.Token, error) {
+	l := s.log.With("op", "invalidate_employer_refresh_token_by_id", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employerId, "token_id", tokenId)
+	t, err := s.dataProvider.GetEmployerTokenById(ctx, uow, tokenId, employerId)
+	if err != nil {
+		l.Errorw("token.get_token_failed", "err", err)
+		return nil, err
+	}
+	if t == nil {
+		l.Warnw("token.refresh_token_not_found")
+		return nil, ErrTokenNotFound
+	}
+
+	if err := s.dataProvider.DeleteEmployerToken(ctx, uow, t.TokenHash()); err != nil {
+		l.Errorw("token.delete_refresh_token_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("token.invalidate_refresh_token.success")
+	return t, nil
+}
+
+func (s *service) InvalidateOtherApplicant(ctx context.Context, uow *uow.UnitOfWork, applicantId int64, currentTokenId int64) error {
+	l := s.log.With("op", "invalidate_other_applicant_refresh_tokens", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicantId)
+	err := s.dataProvider.DeleteApplicantTokensByUserIdExceptId(ctx, uow, applicantId, currentTokenId)
+	if err != nil {
+		l.Errorw("token.delete_refresh_tokens_failed", "err", err)
+		return err
+	}
+
+	l.Infow("token.invalidate_other_refresh_tokens.success")
+	return nil
+}
+
+func (s *service) InvalidateOtherEmployer(ctx context.Context, uow *uow.UnitOfWork, employerId int64, currentTokenId int64) error {
+	l := s.log.With("op", "invalidate_other_employer_refresh_tokens", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employerId)
+	err := s.dataProvider.DeleteEmployerTokensByUserIdExceptId(ctx, uow, employerId, currentTokenId)
+	if err != nil {
+		l.Errorw("token.delete_refresh_tokens_failed", "err", err)
+		return err
+	}
+
+	l.Infow("token.invalidate_other_refresh_tokens.success")
+	return nil
+}
+
+// CheckToken reports whether tokenStr is the raw value of the stored token t.
+func (s *service) CheckToken(t *token.Token, tokenStr string) bool {
+	return t.CheckToken(tokenStr, s.hasher)
+}
+
+func signToken[T jwt.Claims](c T, key *signingKey, ttl time.Duration) (string, time.Time, error) {
 	now := time.Now()
 	expiresAt := now.Add(ttl)
 	safeNbf := now.Add(-10 * time.Second)
 	switch v := any(c).(type) {
 	case *claims.ApplicantClaims:
+		v.Kind = claims.KindApplicant
 		v.RegisteredClaims = jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(safeNbf),
 		}
 	case *claims.EmployerClaims:
+		v.Kind = claims.KindEmployer
 		v.RegisteredClaims = jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(safeNbf),
@@ -252,12 +627,20 @@ func signToken[T jwt.Claims](c T, key []byte, ttl time.Duration) (string, time.T
 		return "", expiresAt, fmt.Errorf("unknown claims type")
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	token := jwt.NewWithClaims(key.method, c)
+	token.Header["kid"] = key.id
 
-	str, err := token.SignedString(key)
+	str, err := token.SignedString(key.key)
 	if err != nil {
 		return "", expiresAt, err
 	}
 
 	return str, expiresAt, nil
 }
+
+func issuedAt(rc *jwt.RegisteredClaims) time.Time {
+	if rc.IssuedAt == nil {
+		return time.Time{}
+	}
+	return rc.IssuedAt.Time
+}
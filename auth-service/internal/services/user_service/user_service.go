@@ -20,6 +20,14 @@ type UserService interface {
 	GetEmployerById(ctx context.Context, id int64) (*pb.Employer, error)
 	ActivateApplicant(ctx context.Context, applicant *pb.Applicant) (*pb.Applicant, error)
 	ActivateEmployer(ctx context.Context, employer *pb.Employer) (*pb.Employer, error)
+	ChangeApplicantEmail(ctx context.Context, applicant *pb.Applicant, email string) (*pb.Applicant, error)
+	ChangeEmployerEmail(ctx context.Context, employer *pb.Employer, email string) (*pb.Employer, error)
+	DeleteApplicant(ctx context.Context, applicant *pb.Applicant) (*pb.Applicant, error)
+	DeleteEmployer(ctx context.Context, employer *pb.Employer) (*pb.Employer, error)
+	RestoreApplicant(ctx context.Context, applicant *pb.Applicant) (*pb.Applicant, error)
+	RestoreEmployer(ctx context.Context, employer *pb.Employer) (*pb.Employer, error)
+	GetRestorableApplicantsByEmail(ctx context.Context, email string) ([]*pb.Applicant, error)
+	GetRestorableEmployersByEmail(ctx context.Context, email string) ([]*pb.Employer, error)
 }
 
 type service struct {
@@ -153,3 +161,107 @@ func (s *service) ActivateEmployer(ctx context.Context, employer *pb.Employer) (
 	l.Infow("user.activate_employer.success")
 	return resp.Employer, nil
 }
+
+func (s *service) ChangeApplicantEmail(ctx context.Context, applicant *pb.Applicant, email string) (*pb.Applicant, error) {
+	l := s.log.With("op", "change_applicant_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().ChangeApplicantEmail(ctx, &pb.ChangeApplicantEmailRequest{Id: applicant.Id, Email: email})
+	if err != nil {
+		l.Errorw("user.change_applicant_email_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.change_applicant_email.success")
+	return resp.Applicant, nil
+}
+
+func (s *service) ChangeEmployerEmail(ctx context.Context, employer *pb.Employer, email string) (*pb.Employer, error) {
+	l := s.log.With("op", "change_employer_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().ChangeEmployerEmail(ctx, &pb.ChangeEmployerEmailRequest{Id: employer.Id, Email: email})
+	if err != nil {
+		l.Errorw("user.change_employer_email_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.change_employer_email.success")
+	return resp.Employer, nil
+}
+
+func (s *service) DeleteApplicant(ctx context.Context, applicant *pb.Applicant) (*pb.Applicant, error) {
+	l := s.log.With("op", "delete_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().DeleteApplicant(ctx, &pb.DeleteApplicantRequest{Id: applicant.Id})
+	if err != nil {
+		l.Errorw("user.delete_applicant_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.delete_applicant.success")
+	return resp.Applicant, nil
+}
+
+func (s *service) DeleteEmployer(ctx context.Context, employer *pb.Employer) (*pb.Employer, error) {
+	l := s.log.With("op", "delete_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().DeleteEmployer(ctx, &pb.DeleteEmployerRequest{Id: employer.Id})
+	if err != nil {
+		l.Errorw("user.delete_employer_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.delete_employer.success")
+	return resp.Employer, nil
+}
+
+func (s *service) RestoreApplicant(ctx context.Context, applicant *pb.Applicant) (*pb.Applicant, error) {
+	l := s.log.With("op", "restore_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().RestoreApplicant(ctx, &pb.RestoreApplicantRequest{Id: applicant.Id})
+	if err != nil {
+		l.Errorw("user.restore_applicant_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.restore_applicant.success")
+	return resp.Applicant, nil
+}
+
+func (s *service) RestoreEmployer(ctx context.Context, employer *pb.Employer) (*pb.Employer, error) {
+	l := s.log.With("op", "restore_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().RestoreEmployer(ctx, &pb.RestoreEmployerRequest{Id: employer.Id})
+	if err != nil {
+		l.Errorw("user.restore_employer_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.restore_employer.success")
+	return resp.Employer, nil
+}
+
+func (s *service) GetRestorableApplicantsByEmail(ctx context.Context, email string) ([]*pb.Applicant, error) {
+	l := s.log.With("op", "get_restorable_applicants_by_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().GetRestorableApplicantsByEmail(ctx, &pb.GetRestorableApplicantsByEmailRequest{Email: email})
+	if err != nil {
+		l.Errorw("user.get_restorable_applicants_by_email_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.get_restorable_applicants_by_email.success")
+	return resp.Applicants, nil
+}
+
+func (s *service) GetRestorableEmployersByEmail(ctx context.Context, email string) ([]*pb.Employer, error) {
+	l := s.log.With("op", "get_restorable_employers_by_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	resp, err := s.userClient.UserClient().GetRestorableEmployersByEmail(ctx, &pb.GetRestorableEmployersByEmailRequest{Email: email})
+	if err != nil {
+		l.Errorw("user.get_restorable_employers_by_email_failed", "err", err)
+		return nil, err
+	}
+
+	l.Infow("user.get_restorable_employers_by_email.success")
+	return resp.Employers, nil
+}
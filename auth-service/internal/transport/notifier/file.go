@@ -0,0 +1,38 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Send(ctx context.Context, msg *Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open notifications file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f,
+		"--- %s\nTo: %s <%s>\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC3339), msg.ToName, msg.To, msg.Subject, msg.Body,
+	)
+	if err != nil {
+		return fmt.Errorf("write notifications file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"go.uber.org/zap"
+)
+
+type Message struct {
+	To      string
+	ToName  string
+	Subject string
+	Body    string
+}
+
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+func New(cfg settings.NotifierSettings, log *zap.SugaredLogger) (Notifier, error) {
+	switch cfg.Type {
+	case "smtp":
+		return NewSMTPNotifier(cfg.SMTP), nil
+	case "file":
+		return NewFileNotifier(cfg.FilePath), nil
+	case "log", "":
+		return NewLogNotifier(log, cfg.LogBody), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type: %s", cfg.Type)
+	}
+}
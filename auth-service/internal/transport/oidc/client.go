@@ -0,0 +1,299 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidIdToken = errors.New("invalid id token")
+	ErrCodeRejected   = errors.New("authorization code rejected")
+
+	errJwksUnavailable = errors.New("provider keys unavailable")
+)
+
+// providerMetadata is the part of the discovery document the client needs.
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IdToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// IdTokenClaims are the claims read from the id token.
+type IdTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string    `json:"nonce"`
+	Email         string    `json:"email"`
+	EmailVerified boolClaim `json:"email_verified"`
+	GivenName     string    `json:"given_name"`
+	FamilyName    string    `json:"family_name"`
+	Birthdate     string    `json:"birthdate"`
+}
+
+// boolClaim accepts "true" as well, some providers send email_verified as a string.
+type boolClaim bool
+
+func (b *boolClaim) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case bool:
+		*b = boolClaim(v)
+	case string:
+		*b = boolClaim(v == "true")
+	}
+	return nil
+}
+
+// Client is an OpenID Connect relying party using the authorization code flow.
+// The discovery document and the provider keys are fetched lazily, so the
+// service starts even while the provider is unreachable.
+type Client struct {
+	issuer       string
+	clientId     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	jwksCacheTTL time.Duration
+	httpClient   *http.Client
+
+	mu            sync.Mutex
+	metadata      *providerMetadata
+	keys          *claims.KeySet
+	keysFetchedAt time.Time
+}
+
+func New(cfg settings.OidcSettings) (*Client, error) {
+	if cfg.Issuer == "" || cfg.ClientId == "" || cfg.RedirectURL == "" {
+		return nil, fmt.Errorf("oidc issuer, client id and redirect url are required")
+	}
+	return &Client{
+		issuer:       strings.TrimSuffix(cfg.Issuer, "/"),
+		clientId:     cfg.ClientId,
+		clientSecret: cfg.ClientSecret,
+		redirectURL:  cfg.RedirectURL,
+		scopes:       cfg.Scopes,
+		jwksCacheTTL: time.Duration(cfg.JwksCacheTTL) * time.Second,
+		httpClient:   &http.Client{Timeout: time.Duration(cfg.RequestTimeout) * time.Second},
+	}, nil
+}
+
+// AuthCodeURL returns the url of the provider login page.
+func (c *Client) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	md, err := c.getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("parse authorization endpoint: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.clientId)
+	q.Set("redirect_uri", c.redirectURL)
+	q.Set("scope", strings.Join(c.scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange redeems the authorization code and returns the raw id token.
+func (c *Client) Exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	md, err := c.getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.redirectURL)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("new token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.clientId), url.QueryEscape(c.clientSecret))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tr tokenResponse
+	decodeErr := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&tr)
+	if resp.StatusCode == http.StatusBadRequest {
+		// invalid_grant: the code is expired, already used or the verifier does not match
+		return "", fmt.Errorf("%w: %s %s", ErrCodeRejected, tr.Error, tr.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request: status %d: %s %s", resp.StatusCode, tr.Error, tr.ErrorDescription)
+	}
+	if decodeErr != nil {
+		return "", fmt.Errorf("decode token response: %w", decodeErr)
+	}
+	if tr.IdToken == "" {
+		return "", fmt.Errorf("token response has no id token")
+	}
+	return tr.IdToken, nil
+}
+
+// VerifyIdToken checks the signature against the provider keys, the issuer,
+// the audience, the expiration and the nonce of the authorization request.
+func (c *Client) VerifyIdToken(ctx context.Context, rawIdToken, nonce string) (*IdTokenClaims, error) {
+	md, err := c.getMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := &IdTokenClaims{}
+	token, err := jwt.ParseWithClaims(rawIdToken, parsed, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := c.getKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, ErrInvalidIdToken
+		}
+		return key.Key, nil
+	},
+		// symmetric algorithms are not accepted, the client secret is not a signing key here
+		jwt.WithValidMethods([]string{claims.AlgorithmRS256, claims.AlgorithmEdDSA}),
+		jwt.WithIssuer(md.Issuer),
+		jwt.WithAudience(c.clientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if errors.Is(err, errJwksUnavailable) {
+		return nil, err
+	}
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIdToken, err)
+	}
+	if parsed.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIdToken)
+	}
+	if parsed.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIdToken)
+	}
+
+	return parsed, nil
+}
+
+func (c *Client) getMetadata(ctx context.Context) (*providerMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.metadata != nil {
+		return c.metadata, nil
+	}
+
+	var md providerMetadata
+	if err := c.getJSON(ctx, c.issuer+"/.well-known/openid-configuration", &md); err != nil {
+		return nil, fmt.Errorf("discover provider: %w", err)
+	}
+	if strings.TrimSuffix(md.Issuer, "/") != c.issuer {
+		return nil, fmt.Errorf("discover provider: issuer %q does not match %q", md.Issuer, c.issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JwksURI == "" {
+		return nil, fmt.Errorf("discover provider: incomplete metadata")
+	}
+
+	c.metadata = &md
+	return c.metadata, nil
+}
+
+// getKey returns the provider key with the given id. The keys are fetched
+// again when they are stale or the key is unknown, providers rotate them.
+func (c *Client) getKey(ctx context.Context, kid string) (*claims.Key, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keys != nil && time.Since(c.keysFetchedAt) < c.jwksCacheTTL {
+		if key, ok := c.keys.Key(kid); ok {
+			return key, nil
+		}
+		// do not let tokens with random kids hammer the provider
+		if time.Since(c.keysFetchedAt) < time.Minute {
+			return nil, ErrInvalidIdToken
+		}
+	}
+
+	data, err := c.getBody(ctx, c.metadata.JwksURI)
+	if err != nil {
+		return nil, fmt.Errorf("%w: fetch jwks: %v", errJwksUnavailable, err)
+	}
+	keys, err := claims.ParseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse jwks: %v", errJwksUnavailable, err)
+	}
+	c.keys = keys
+	c.keysFetchedAt = time.Now()
+
+	key, ok := c.keys.Key(kid)
+	if !ok {
+		return nil, ErrInvalidIdToken
+	}
+	return key, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, url string, dst any) error {
+	data, err := c.getBody(ctx, url)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+func (c *Client) getBody(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: status %d", url, resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
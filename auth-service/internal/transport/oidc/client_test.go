@@ -0,0 +1,350 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/oidc"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func settingsFor(issuer string, jwksCacheTTL uint) settings.OidcSettings {
+	return settings.OidcSettings{
+		Issuer:         issuer,
+		ClientId:       mockClientId,
+		ClientSecret:   mockClientSecret,
+		RedirectURL:    mockRedirectURL,
+		Scopes:         []string{"openid", "email", "profile"},
+		JwksCacheTTL:   jwksCacheTTL,
+		RequestTimeout: 5,
+	}
+}
+
+// authorize starts an authorization request and returns it together with the
+// code the provider redirected back with.
+func authorize(t *testing.T, idp *mockIdp, c *Client) (*oidc.AuthRequest, string) {
+	t.Helper()
+
+	req, err := oidc.NewAuthRequest()
+	if err != nil {
+		t.Fatalf("new auth request: %v", err)
+	}
+	authURL, err := c.AuthCodeURL(context.Background(), req.State, req.Nonce, req.CodeChallenge())
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+
+	code, state := idp.login(authURL)
+	if state != req.State {
+		t.Fatalf("state = %q, want %q", state, req.State)
+	}
+	return req, code
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(3600)
+
+	authURL, err := c.AuthCodeURL(context.Background(), "state", "nonce", "challenge")
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parse auth code url: %v", err)
+	}
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             mockClientId,
+		"redirect_uri":          mockRedirectURL,
+		"scope":                 "openid email profile",
+		"state":                 "state",
+		"nonce":                 "nonce",
+		"code_challenge":        "challenge",
+		"code_challenge_method": "S256",
+	}
+	for k, v := range want {
+		if got := u.Query().Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+}
+
+func TestDiscoveryRejectsIssuerMismatch(t *testing.T) {
+	idp := newMockIdp(t)
+	idp.discoveryIssuer = "https://evil.example.com"
+	c := idp.newClient(3600)
+
+	if _, err := c.AuthCodeURL(context.Background(), "state", "nonce", "challenge"); err == nil {
+		t.Fatal("metadata of another issuer accepted")
+	}
+}
+
+func TestLogin(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(3600)
+	ctx := context.Background()
+
+	req, code := authorize(t, idp, c)
+
+	rawIdToken, err := c.Exchange(ctx, code, req.CodeVerifier)
+	if err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+	idToken, err := c.VerifyIdToken(ctx, rawIdToken, req.Nonce)
+	if err != nil {
+		t.Fatalf("verify id token: %v", err)
+	}
+
+	if idToken.Subject != "subject-1" || idToken.Email != "john@example.com" || !bool(idToken.EmailVerified) || idToken.GivenName != "John" {
+		t.Errorf("unexpected claims: %+v", idToken)
+	}
+}
+
+func TestExchangeEnforcesPkce(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(3600)
+	ctx := context.Background()
+
+	_, code := authorize(t, idp, c)
+	other, err := oidc.NewAuthRequest()
+	if err != nil {
+		t.Fatalf("new auth request: %v", err)
+	}
+
+	// a code intercepted on the redirect is useless without the verifier
+	if _, err := c.Exchange(ctx, code, other.CodeVerifier); !errors.Is(err, ErrCodeRejected) {
+		t.Fatalf("exchange with another verifier: err = %v, want %v", err, ErrCodeRejected)
+	}
+}
+
+func TestExchangeRejectsRedeemedCode(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(3600)
+	ctx := context.Background()
+
+	req, code := authorize(t, idp, c)
+	if _, err := c.Exchange(ctx, code, req.CodeVerifier); err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+	if _, err := c.Exchange(ctx, code, req.CodeVerifier); !errors.Is(err, ErrCodeRejected) {
+		t.Fatalf("second exchange: err = %v, want %v", err, ErrCodeRejected)
+	}
+}
+
+func TestVerifyIdToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		nonce      string
+		editClaims func(c jwt.MapClaims)
+		wantErr    bool
+	}{
+		{
+			name:  "valid",
+			nonce: "nonce",
+		},
+		{
+			name:    "nonce of another authorization request",
+			nonce:   "another nonce",
+			wantErr: true,
+		},
+		{
+			name:  "no nonce",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				delete(c, "nonce")
+			},
+			wantErr: true,
+		},
+		{
+			name:  "issued for another client",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				c["aud"] = "another-client"
+			},
+			wantErr: true,
+		},
+		{
+			name:  "one of several audiences",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				c["aud"] = []string{"another-client", mockClientId}
+			},
+		},
+		{
+			name:  "another issuer",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				c["iss"] = "https://evil.example.com"
+			},
+			wantErr: true,
+		},
+		{
+			name:  "expired",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				c["exp"] = time.Now().Add(-time.Hour).Unix()
+			},
+			wantErr: true,
+		},
+		{
+			name:  "no expiration",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				delete(c, "exp")
+			},
+			wantErr: true,
+		},
+		{
+			name:  "issued in the future",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				c["iat"] = time.Now().Add(time.Hour).Unix()
+			},
+			wantErr: true,
+		},
+		{
+			name:  "no subject",
+			nonce: "nonce",
+			editClaims: func(c jwt.MapClaims) {
+				delete(c, "sub")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := newMockIdp(t)
+			idp.editClaims = tt.editClaims
+			c := idp.newClient(3600)
+
+			_, err := c.VerifyIdToken(context.Background(), idp.idToken("nonce"), tt.nonce)
+			if tt.wantErr && !errors.Is(err, ErrInvalidIdToken) {
+				t.Fatalf("err = %v, want %v", err, ErrInvalidIdToken)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("err = %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyIdTokenEmailVerifiedAsString(t *testing.T) {
+	for _, tt := range []struct {
+		claim any
+		want  bool
+	}{
+		{claim: "true", want: true},
+		{claim: "false", want: false},
+		{claim: false, want: false},
+	} {
+		idp := newMockIdp(t)
+		idp.user.emailVerified = tt.claim
+		c := idp.newClient(3600)
+
+		idToken, err := c.VerifyIdToken(context.Background(), idp.idToken("nonce"), "nonce")
+		if err != nil {
+			t.Fatalf("email_verified %v: %v", tt.claim, err)
+		}
+		if bool(idToken.EmailVerified) != tt.want {
+			t.Errorf("email_verified %v: got %v, want %v", tt.claim, idToken.EmailVerified, tt.want)
+		}
+	}
+}
+
+func TestVerifyIdTokenRejectsKeyNotPublished(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(3600)
+	ctx := context.Background()
+
+	if _, err := c.VerifyIdToken(ctx, idp.idToken("nonce"), "nonce"); err != nil {
+		t.Fatalf("verify id token: %v", err)
+	}
+
+	// signed with a key of the same id that the provider never published
+	forged := newMockIdp(t)
+	forged.URL, forged.kid = idp.URL, idp.kid
+	if _, err := c.VerifyIdToken(ctx, forged.idToken("nonce"), "nonce"); !errors.Is(err, ErrInvalidIdToken) {
+		t.Fatalf("err = %v, want %v", err, ErrInvalidIdToken)
+	}
+}
+
+func TestVerifyIdTokenRejectsClientSecretSignature(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(3600)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":   idp.URL,
+		"sub":   "subject-1",
+		"aud":   mockClientId,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": "nonce",
+	})
+	token.Header["kid"] = idp.kid
+	signed, err := token.SignedString([]byte(mockClientSecret))
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	if _, err := c.VerifyIdToken(context.Background(), signed, "nonce"); !errors.Is(err, ErrInvalidIdToken) {
+		t.Fatalf("err = %v, want %v", err, ErrInvalidIdToken)
+	}
+}
+
+func TestVerifyIdTokenFollowsKeyRotation(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(0)
+	ctx := context.Background()
+
+	if _, err := c.VerifyIdToken(ctx, idp.idToken("nonce"), "nonce"); err != nil {
+		t.Fatalf("verify id token: %v", err)
+	}
+
+	idp.rotateKey("key-2")
+	if _, err := c.VerifyIdToken(ctx, idp.idToken("nonce"), "nonce"); err != nil {
+		t.Fatalf("verify id token signed with the new key: %v", err)
+	}
+}
+
+func TestVerifyIdTokenCachesKeys(t *testing.T) {
+	idp := newMockIdp(t)
+	c := idp.newClient(3600)
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := c.VerifyIdToken(ctx, idp.idToken("nonce"), "nonce"); err != nil {
+			t.Fatalf("verify id token: %v", err)
+		}
+	}
+
+	// unknown key ids do not refetch the keys within a minute of the last fetch
+	idp.rotateKey("key-2")
+	if _, err := c.VerifyIdToken(ctx, idp.idToken("nonce"), "nonce"); !errors.Is(err, ErrInvalidIdToken) {
+		t.Fatalf("err = %v, want %v", err, ErrInvalidIdToken)
+	}
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	if idp.jwksRequests != 1 {
+		t.Errorf("keys fetched %d times, want 1", idp.jwksRequests)
+	}
+}
+
+func TestVerifyIdTokenKeysUnavailable(t *testing.T) {
+	idp := newMockIdp(t)
+	idp.jwksDown = true
+	c := idp.newClient(3600)
+
+	// an unreachable provider is not reported as an invalid token
+	_, err := c.VerifyIdToken(context.Background(), idp.idToken("nonce"), "nonce")
+	if !errors.Is(err, errJwksUnavailable) || errors.Is(err, ErrInvalidIdToken) {
+		t.Fatalf("err = %v, want %v", err, errJwksUnavailable)
+	}
+}
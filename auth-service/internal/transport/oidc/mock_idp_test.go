@@ -0,0 +1,274 @@
+package oidc
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	mockClientId     = "job-search"
+	mockClientSecret = "client secret"
+	mockRedirectURL  = "https://app.example.com/oidc/callback"
+)
+
+// mockIdp is an identity provider implementing the parts of OpenID Connect the
+// client uses: discovery, an authorization endpoint approving every request,
+// a token endpoint enforcing PKCE and the published keys.
+type mockIdp struct {
+	*httptest.Server
+	t *testing.T
+
+	mu           sync.Mutex
+	kid          string
+	key          ed25519.PrivateKey
+	codes        map[string]mockAuthorization
+	jwksDown     bool
+	jwksRequests int
+
+	// discoveryIssuer replaces the issuer in the discovery document when set.
+	discoveryIssuer string
+
+	// user is put into every id token, editClaims changes the token before it is signed.
+	user       mockUser
+	editClaims func(c jwt.MapClaims)
+}
+
+type mockUser struct {
+	subject       string
+	email         string
+	emailVerified any
+	givenName     string
+}
+
+type mockAuthorization struct {
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+}
+
+func newMockIdp(t *testing.T) *mockIdp {
+	t.Helper()
+
+	idp := &mockIdp{
+		t:     t,
+		codes: make(map[string]mockAuthorization),
+		user: mockUser{
+			subject:       "subject-1",
+			email:         "john@example.com",
+			emailVerified: true,
+			givenName:     "John",
+		},
+	}
+	idp.rotateKey("key-1")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("GET /authorize", idp.authorize)
+	mux.HandleFunc("POST /token", idp.token)
+	mux.HandleFunc("GET /jwks", idp.jwks)
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+// newClient returns a client of the provider, a zero jwksCacheTTL refetches
+// the keys for every token.
+func (idp *mockIdp) newClient(jwksCacheTTL uint) *Client {
+	idp.t.Helper()
+
+	c, err := New(settingsFor(idp.URL, jwksCacheTTL))
+	if err != nil {
+		idp.t.Fatalf("new client: %v", err)
+	}
+	return c
+}
+
+// rotateKey replaces the signing key, the old one is no longer published.
+func (idp *mockIdp) rotateKey(kid string) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		idp.t.Fatalf("generate key: %v", err)
+	}
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.kid = kid
+	idp.key = key
+}
+
+// login walks through the authorization endpoint the way a browser does and
+// returns the code and the state it redirected back with.
+func (idp *mockIdp) login(authURL string) (code, state string) {
+	idp.t.Helper()
+
+	noRedirect := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	resp, err := noRedirect.Get(authURL)
+	if err != nil {
+		idp.t.Fatalf("authorize: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		idp.t.Fatalf("authorize: status %d", resp.StatusCode)
+	}
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		idp.t.Fatalf("authorize: parse redirect: %v", err)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+// idToken signs an id token for the user without an authorization request.
+func (idp *mockIdp) idToken(nonce string) string {
+	idp.t.Helper()
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	signed, err := idp.signIdToken(nonce)
+	if err != nil {
+		idp.t.Fatalf("sign id token: %v", err)
+	}
+	return signed
+}
+
+func (idp *mockIdp) discovery(w http.ResponseWriter, r *http.Request) {
+	issuer := idp.URL
+	if idp.discoveryIssuer != "" {
+		issuer = idp.discoveryIssuer
+	}
+	writeJSON(w, http.StatusOK, providerMetadata{
+		Issuer:                issuer,
+		AuthorizationEndpoint: idp.URL + "/authorize",
+		TokenEndpoint:         idp.URL + "/token",
+		JwksURI:               idp.URL + "/jwks",
+	})
+}
+
+func (idp *mockIdp) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("response_type") != "code" || q.Get("client_id") != mockClientId ||
+		q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+	idp.mu.Lock()
+	idp.codes[code] = mockAuthorization{
+		redirectURI:   q.Get("redirect_uri"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+	}
+	idp.mu.Unlock()
+
+	redirect, _ := url.Parse(q.Get("redirect_uri"))
+	rq := redirect.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	redirect.RawQuery = rq.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (idp *mockIdp) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	id, _ = url.QueryUnescape(id)
+	secret, _ = url.QueryUnescape(secret)
+	if !ok || id != mockClientId || secret != mockClientSecret {
+		writeJSON(w, http.StatusUnauthorized, tokenResponse{Error: "invalid_client"})
+		return
+	}
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, tokenResponse{Error: "unsupported_grant_type"})
+		return
+	}
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+
+	code := r.PostForm.Get("code")
+	authz, ok := idp.codes[code]
+	// codes are single use, a failed redemption burns the code as well
+	delete(idp.codes, code)
+	if !ok || authz.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, tokenResponse{Error: "invalid_grant"})
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != authz.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, tokenResponse{Error: "invalid_grant", ErrorDescription: "code verifier mismatch"})
+		return
+	}
+
+	idToken, err := idp.signIdToken(authz.nonce)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, tokenResponse{Error: "server_error", ErrorDescription: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, tokenResponse{IdToken: idToken})
+}
+
+func (idp *mockIdp) jwks(w http.ResponseWriter, r *http.Request) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+
+	idp.jwksRequests++
+	if idp.jwksDown {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	keys, err := claims.NewKeySet(&claims.Key{
+		Id:        idp.kid,
+		Algorithm: claims.AlgorithmEdDSA,
+		Key:       idp.key.Public(),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, keys.JWKS())
+}
+
+// signIdToken must be called with mu held.
+func (idp *mockIdp) signIdToken(nonce string) (string, error) {
+	now := time.Now()
+	c := jwt.MapClaims{
+		"iss":            idp.URL,
+		"sub":            idp.user.subject,
+		"aud":            mockClientId,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          nonce,
+		"email":          idp.user.email,
+		"email_verified": idp.user.emailVerified,
+		"given_name":     idp.user.givenName,
+	}
+	if idp.editClaims != nil {
+		idp.editClaims(c)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, c)
+	token.Header["kid"] = idp.kid
+	return token.SignedString(idp.key)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
@@ -24,6 +24,11 @@ func New(ctx context.Context, postgresCfg settings.PostgresSettings) (*PostgresC
 			"v1_user_password ", "_v1_user_password",
 			"v1_code", "_v1_code",
 			"v1_refresh_token", "_v1_refresh_token",
+			"v1_rotated_refresh_token", "_v1_rotated_refresh_token",
+			"v1_password_history", "_v1_password_history",
+			"v1_mfa", "_v1_mfa",
+			"v1_identity", "_v1_identity",
+			"v1_registration_saga", "_v1_registration_saga",
 		}
 		types, err := conn.LoadTypes(ctx, names)
 		if err != nil {
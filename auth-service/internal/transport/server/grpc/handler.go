@@ -38,6 +38,30 @@ func (h *authHandler) LoginApplicant(ctx context.Context, req *pb.LoginApplicant
 	return h.authService.LoginApplicant(ctx, req)
 }
 
+func (h *authHandler) RequestApplicantLoginCode(ctx context.Context, req *pb.RequestApplicantLoginCodeRequest) (*pb.RequestApplicantLoginCodeResponse, error) {
+	utils.SanitizeRequestApplicantLoginCodeRequest(req)
+	return h.authService.RequestApplicantLoginCode(ctx, req)
+}
+
+func (h *authHandler) LoginApplicantWithCode(ctx context.Context, req *pb.LoginApplicantWithCodeRequest) (*pb.LoginApplicantWithCodeResponse, error) {
+	utils.SanitizeLoginApplicantWithCodeRequest(req)
+	return h.authService.LoginApplicantWithCode(ctx, req)
+}
+
+func (h *authHandler) StartApplicantOidcLogin(ctx context.Context, req *pb.StartApplicantOidcLoginRequest) (*pb.StartApplicantOidcLoginResponse, error) {
+	return h.authService.StartApplicantOidcLogin(ctx, req)
+}
+
+func (h *authHandler) CompleteApplicantOidcLogin(ctx context.Context, req *pb.CompleteApplicantOidcLoginRequest) (*pb.CompleteApplicantOidcLoginResponse, error) {
+	utils.SanitizeCompleteApplicantOidcLoginRequest(req)
+	return h.authService.CompleteApplicantOidcLogin(ctx, req)
+}
+
+func (h *authHandler) RegisterApplicantWithOidc(ctx context.Context, req *pb.RegisterApplicantWithOidcRequest) (*pb.RegisterApplicantWithOidcResponse, error) {
+	utils.SanitizeRegisterApplicantWithOidcRequest(req)
+	return h.authService.RegisterApplicantWithOidc(ctx, req)
+}
+
 func (h *authHandler) RefreshApplicant(ctx context.Context, req *pb.RefreshApplicantRequest) (*pb.RefreshApplicantResponse, error) {
 	return h.authService.RefreshApplicant(ctx, req)
 }
@@ -61,44 +85,169 @@ func (h *authHandler) ChangeApplicantPassword(ctx context.Context, req *pb.Chang
 	return h.authService.ChangeApplicantPassword(ctx, req)
 }
 
+func (h *authHandler) ListApplicantSessions(ctx context.Context, req *pb.ListApplicantSessionsRequest) (*pb.ListApplicantSessionsResponse, error) {
+	return h.authService.ListApplicantSessions(ctx, req)
+}
+
+func (h *authHandler) RevokeApplicantSession(ctx context.Context, req *pb.RevokeApplicantSessionRequest) (*pb.RevokeApplicantSessionResponse, error) {
+	return h.authService.RevokeApplicantSession(ctx, req)
+}
+
+func (h *authHandler) RevokeOtherApplicantSessions(ctx context.Context, req *pb.RevokeOtherApplicantSessionsRequest) (*pb.RevokeOtherApplicantSessionsResponse, error) {
+	return h.authService.RevokeOtherApplicantSessions(ctx, req)
+}
+
+func (h *authHandler) RequestApplicantEmailChange(ctx context.Context, req *pb.RequestApplicantEmailChangeRequest) (*pb.RequestApplicantEmailChangeResponse, error) {
+	utils.SanitizeRequestApplicantEmailChangeRequest(req)
+	return h.authService.RequestApplicantEmailChange(ctx, req)
+}
+
+func (h *authHandler) ConfirmApplicantEmailChange(ctx context.Context, req *pb.ConfirmApplicantEmailChangeRequest) (*pb.ConfirmApplicantEmailChangeResponse, error) {
+	utils.SanitizeConfirmApplicantEmailChangeRequest(req)
+	return h.authService.ConfirmApplicantEmailChange(ctx, req)
+}
+
+func (h *authHandler) DeleteApplicantAccount(ctx context.Context, req *pb.DeleteApplicantAccountRequest) (*pb.DeleteApplicantAccountResponse, error) {
+	utils.SanitizeDeleteApplicantAccountRequest(req)
+	return h.authService.DeleteApplicantAccount(ctx, req)
+}
+
+func (h *authHandler) RestoreApplicantAccount(ctx context.Context, req *pb.RestoreApplicantAccountRequest) (*pb.RestoreApplicantAccountResponse, error) {
+	utils.SanitizeRestoreApplicantAccountRequest(req)
+	return h.authService.RestoreApplicantAccount(ctx, req)
+}
+
+func (h *authHandler) EnrollApplicantMfa(ctx context.Context, req *pb.EnrollApplicantMfaRequest) (*pb.EnrollApplicantMfaResponse, error) {
+	return h.authService.EnrollApplicantMfa(ctx, req)
+}
+
+func (h *authHandler) ConfirmApplicantMfa(ctx context.Context, req *pb.ConfirmApplicantMfaRequest) (*pb.ConfirmApplicantMfaResponse, error) {
+	utils.SanitizeConfirmApplicantMfaRequest(req)
+	return h.authService.ConfirmApplicantMfa(ctx, req)
+}
+
+func (h *authHandler) DisableApplicantMfa(ctx context.Context, req *pb.DisableApplicantMfaRequest) (*pb.DisableApplicantMfaResponse, error) {
+	utils.SanitizeDisableApplicantMfaRequest(req)
+	return h.authService.DisableApplicantMfa(ctx, req)
+}
+
+func (h *authHandler) VerifyApplicantMfa(ctx context.Context, req *pb.VerifyApplicantMfaRequest) (*pb.VerifyApplicantMfaResponse, error) {
+	utils.SanitizeVerifyApplicantMfaRequest(req)
+	return h.authService.VerifyApplicantMfa(ctx, req)
+}
+
+func (h *authHandler) BumpApplicantSecurityVersion(ctx context.Context, req *pb.BumpApplicantSecurityVersionRequest) (*pb.BumpApplicantSecurityVersionResponse, error) {
+	return h.authService.BumpApplicantSecurityVersion(ctx, req)
+}
+
 func (h *authHandler) RegisterEmployer(ctx context.Context, req *pb.RegisterEmployerRequest) (*pb.RegisterEmployerResponse, error) {
 	utils.SanitizeRegisterEmployerRequest(req)
-	return nil, nil
+	return h.authService.RegisterEmployer(ctx, req)
 }
 
 func (h *authHandler) GetNewEmployerActivationCode(ctx context.Context, req *pb.GetNewEmployerActivationCodeRequest) (*pb.GetNewEmployerActivationCodeResponse, error) {
-	return nil, nil
+	return h.authService.GetNewEmployerActivationCode(ctx, req)
 }
 
 func (h *authHandler) ActivateEmployer(ctx context.Context, req *pb.ActivateEmployerRequest) (*pb.ActivateEmployerResponse, error) {
 	utils.SanitizeActivateEmployerRequest(req)
-	return nil, nil
+	return h.authService.ActivateEmployer(ctx, req)
 }
 
 func (h *authHandler) LoginEmployer(ctx context.Context, req *pb.LoginEmployerRequest) (*pb.LoginEmployerResponse, error) {
 	utils.SanitizeLoginEmployerRequest(req)
-	return nil, nil
+	return h.authService.LoginEmployer(ctx, req)
+}
+
+func (h *authHandler) RequestEmployerLoginCode(ctx context.Context, req *pb.RequestEmployerLoginCodeRequest) (*pb.RequestEmployerLoginCodeResponse, error) {
+	utils.SanitizeRequestEmployerLoginCodeRequest(req)
+	return h.authService.RequestEmployerLoginCode(ctx, req)
+}
+
+func (h *authHandler) LoginEmployerWithCode(ctx context.Context, req *pb.LoginEmployerWithCodeRequest) (*pb.LoginEmployerWithCodeResponse, error) {
+	utils.SanitizeLoginEmployerWithCodeRequest(req)
+	return h.authService.LoginEmployerWithCode(ctx, req)
 }
 
 func (h *authHandler) RefreshEmployer(ctx context.Context, req *pb.RefreshEmployerRequest) (*pb.RefreshEmployerResponse, error) {
-	return nil, nil
+	return h.authService.RefreshEmployer(ctx, req)
 }
 
 func (h *authHandler) LogoutEmployer(ctx context.Context, req *pb.LogoutEmployerRequest) (*pb.LogoutEmployerResponse, error) {
-	return nil, nil
+	return h.authService.LogoutEmployer(ctx, req)
 }
 
 func (h *authHandler) GetResetEmployerPasswordCode(ctx context.Context, req *pb.GetResetEmployerPasswordCodeRequest) (*pb.GetResetEmployerPasswordCodeResponse, error) {
 	utils.SanitizeGetResetEmployerPasswordCodeRequest(req)
-	return nil, nil
+	return h.authService.GetResetEmployerPasswordCode(ctx, req)
 }
 
 func (h *authHandler) ResetEmployerPassword(ctx context.Context, req *pb.ResetEmployerPasswordRequest) (*pb.ResetEmployerPasswordResponse, error) {
 	utils.SanitizeResetEmployerPasswordRequest(req)
-	return nil, nil
+	return h.authService.ResetEmployerPassword(ctx, req)
 }
 
 func (h *authHandler) ChangeEmployerPassword(ctx context.Context, req *pb.ChangeEmployerPasswordRequest) (*pb.ChangeEmployerPasswordResponse, error) {
 	utils.SanitizeChangeEmployerPasswordRequest(req)
-	return nil, nil
+	return h.authService.ChangeEmployerPassword(ctx, req)
+}
+
+func (h *authHandler) ListEmployerSessions(ctx context.Context, req *pb.ListEmployerSessionsRequest) (*pb.ListEmployerSessionsResponse, error) {
+	return h.authService.ListEmployerSessions(ctx, req)
+}
+
+func (h *authHandler) RevokeEmployerSession(ctx context.Context, req *pb.RevokeEmployerSessionRequest) (*pb.RevokeEmployerSessionResponse, error) {
+	return h.authService.RevokeEmployerSession(ctx, req)
+}
+
+func (h *authHandler) RevokeOtherEmployerSessions(ctx context.Context, req *pb.RevokeOtherEmployerSessionsRequest) (*pb.RevokeOtherEmployerSessionsResponse, error) {
+	return h.authService.RevokeOtherEmployerSessions(ctx, req)
+}
+
+func (h *authHandler) RequestEmployerEmailChange(ctx context.Context, req *pb.RequestEmployerEmailChangeRequest) (*pb.RequestEmployerEmailChangeResponse, error) {
+	utils.SanitizeRequestEmployerEmailChangeRequest(req)
+	return h.authService.RequestEmployerEmailChange(ctx, req)
+}
+
+func (h *authHandler) ConfirmEmployerEmailChange(ctx context.Context, req *pb.ConfirmEmployerEmailChangeRequest) (*pb.ConfirmEmployerEmailChangeResponse, error) {
+	utils.SanitizeConfirmEmployerEmailChangeRequest(req)
+	return h.authService.ConfirmEmployerEmailChange(ctx, req)
+}
+
+func (h *authHandler) DeleteEmployerAccount(ctx context.Context, req *pb.DeleteEmployerAccountRequest) (*pb.DeleteEmployerAccountResponse, error) {
+	utils.SanitizeDeleteEmployerAccountRequest(req)
+	return h.authService.DeleteEmployerAccount(ctx, req)
+}
+
+func (h *authHandler) RestoreEmployerAccount(ctx context.Context, req *pb.RestoreEmployerAccountRequest) (*pb.RestoreEmployerAccountResponse, error) {
+	utils.SanitizeRestoreEmployerAccountRequest(req)
+	return h.authService.RestoreEmployerAccount(ctx, req)
+}
+
+func (h *authHandler) EnrollEmployerMfa(ctx context.Context, req *pb.EnrollEmployerMfaRequest) (*pb.EnrollEmployerMfaResponse, error) {
+	return h.authService.EnrollEmployerMfa(ctx, req)
+}
+
+func (h *authHandler) ConfirmEmployerMfa(ctx context.Context, req *pb.ConfirmEmployerMfaRequest) (*pb.ConfirmEmployerMfaResponse, error) {
+	utils.SanitizeConfirmEmployerMfaRequest(req)
+	return h.authService.ConfirmEmployerMfa(ctx, req)
+}
+
+func (h *authHandler) DisableEmployerMfa(ctx context.Context, req *pb.DisableEmployerMfaRequest) (*pb.DisableEmployerMfaResponse, error) {
+	utils.SanitizeDisableEmployerMfaRequest(req)
+	return h.authService.DisableEmployerMfa(ctx, req)
+}
+
+func (h *authHandler) VerifyEmployerMfa(ctx context.Context, req *pb.VerifyEmployerMfaRequest) (*pb.VerifyEmployerMfaResponse, error) {
+	utils.SanitizeVerifyEmployerMfaRequest(req)
+	return h.authService.VerifyEmployerMfa(ctx, req)
+}
+
+func (h *authHandler) BumpEmployerSecurityVersion(ctx context.Context, req *pb.BumpEmployerSecurityVersionRequest) (*pb.BumpEmployerSecurityVersionResponse, error) {
+	return h.authService.BumpEmployerSecurityVersion(ctx, req)
+}
+
+func (h *authHandler) IntrospectToken(ctx context.Context, req *pb.IntrospectTokenRequest) (*pb.IntrospectTokenResponse, error) {
+	utils.SanitizeIntrospectTokenRequest(req)
+	return h.authService.IntrospectToken(ctx, req)
 }
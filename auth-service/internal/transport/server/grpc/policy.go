@@ -0,0 +1,45 @@
+package grpcserver
+
+import (
+	"fmt"
+
+	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/auth_service/v1"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	middleware "github.com/ZaiiiRan/job_search_service/common/pkg/middleware/grpc/server"
+)
+
+// internalMethods are called by other services only, they are not exposed
+// through the http gateway.
+var internalMethods = []string{
+	pb.AuthService_BumpApplicantSecurityVersion_FullMethodName,
+	pb.AuthService_BumpEmployerSecurityVersion_FullMethodName,
+	pb.AuthService_IntrospectToken_FullMethodName,
+}
+
+// newMethodPolicy lets end users call every rpc except the internal ones.
+func newMethodPolicy() middleware.MethodPolicy {
+	policy := make(middleware.MethodPolicy, len(pb.AuthService_ServiceDesc.Methods))
+	for _, m := range pb.AuthService_ServiceDesc.Methods {
+		policy["/"+pb.AuthService_ServiceDesc.ServiceName+"/"+m.MethodName] = middleware.AccessEndUser
+	}
+	for _, method := range internalMethods {
+		policy[method] = middleware.AccessInternal
+	}
+	return policy
+}
+
+func newServiceKeySet(cfg settings.ServiceAuthSettings) (*jwt.KeySet, error) {
+	keys := make([]*jwt.Key, 0, len(cfg.Services))
+	for name, secret := range cfg.Services {
+		if secret == "" {
+			return nil, fmt.Errorf("service %q: secret is empty", name)
+		}
+		keys = append(keys, &jwt.Key{
+			Id:        name,
+			Algorithm: jwt.AlgorithmHS256,
+			Key:       []byte(secret),
+		})
+	}
+	return jwt.NewKeySet(keys...)
+}
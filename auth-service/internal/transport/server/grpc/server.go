@@ -9,6 +9,8 @@ import (
 	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/auth_service/v1"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
 	authservice "github.com/ZaiiiRan/job_search_service/auth-service/internal/services/auth"
+	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt/denylist"
 	middleware "github.com/ZaiiiRan/job_search_service/common/pkg/middleware/grpc/server"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -22,12 +24,19 @@ type Server struct {
 
 func New(
 	srvSettings settings.GRPCServerSettings,
-	jwtSettings settings.JWTSettings,
+	serviceAuth settings.ServiceAuthSettings,
+	accessKeys *claims.KeySet,
+	denylist denylist.Denylist,
 	authService authservice.AuthService,
 	log *zap.SugaredLogger,
 ) (*Server, error) {
+	serviceKeys, err := newServiceKeySet(serviceAuth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service auth settings: %w", err)
+	}
+
 	s := grpc.NewServer(
-		newChainUnaryInterceptor(&jwtSettings, log),
+		newChainUnaryInterceptor(serviceKeys, serviceAuth.Audience, accessKeys, denylist, log),
 		grpc.KeepaliveParams(getGRPCKeepAliveServerParams(&srvSettings)),
 		grpc.KeepaliveEnforcementPolicy(getGRPCKeepAliveEnforcement(&srvSettings)),
 	)
@@ -71,26 +80,51 @@ func (s *Server) Addr() string {
 	return ""
 }
 
-func newChainUnaryInterceptor(jwtSettings *settings.JWTSettings, log *zap.SugaredLogger) grpc.ServerOption {
+func newChainUnaryInterceptor(
+	serviceKeys *claims.KeySet, audience string,
+	accessKeys *claims.KeySet, denylist denylist.Denylist,
+	log *zap.SugaredLogger,
+) grpc.ServerOption {
 	return grpc.ChainUnaryInterceptor(
 		middleware.RequestIdMiddleware(),
 		middleware.LogMiddleware(log),
 		middleware.RecoveryInterceptor(log),
+		middleware.ServiceAuthMiddleware(serviceKeys, audience, newMethodPolicy()),
 
 		middleware.ApplicantAuthMiddleware(
-			[]byte(jwtSettings.AccessTokenSecret),
+			accessKeys,
+			denylist,
 			middleware.MiddlewareOnly(
 				"/auth_service.v1.AuthService/GetNewApplicantActivationCode",
 				"/auth_service.v1.AuthService/ActivateApplicant",
 				"/auth_service.v1.AuthService/ChangeApplicantPassword",
+				"/auth_service.v1.AuthService/ListApplicantSessions",
+				"/auth_service.v1.AuthService/RevokeApplicantSession",
+				"/auth_service.v1.AuthService/RevokeOtherApplicantSessions",
+				"/auth_service.v1.AuthService/RequestApplicantEmailChange",
+				"/auth_service.v1.AuthService/ConfirmApplicantEmailChange",
+				"/auth_service.v1.AuthService/DeleteApplicantAccount",
+				"/auth_service.v1.AuthService/EnrollApplicantMfa",
+				"/auth_service.v1.AuthService/ConfirmApplicantMfa",
+				"/auth_service.v1.AuthService/DisableApplicantMfa",
 			),
 		),
 		middleware.EmployerAuthMiddleware(
-			[]byte(jwtSettings.AccessTokenSecret),
+			accessKeys,
+			denylist,
 			middleware.MiddlewareOnly(
 				"/auth_service.v1.AuthService/GetNewEmployerActivationCode",
 				"/auth_service.v1.AuthService/ActivateEmployer",
 				"/auth_service.v1.AuthService/ChangeEmployerPassword",
+				"/auth_service.v1.AuthService/ListEmployerSessions",
+				"/auth_service.v1.AuthService/RevokeEmployerSession",
+				"/auth_service.v1.AuthService/RevokeOtherEmployerSessions",
+				"/auth_service.v1.AuthService/RequestEmployerEmailChange",
+				"/auth_service.v1.AuthService/ConfirmEmployerEmailChange",
+				"/auth_service.v1.AuthService/DeleteEmployerAccount",
+				"/auth_service.v1.AuthService/EnrollEmployerMfa",
+				"/auth_service.v1.AuthService/ConfirmEmployerMfa",
+				"/auth_service.v1.AuthService/DisableEmployerMfa",
 			),
 		),
 	)
@@ -2,25 +2,52 @@ package httpgateway
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	pb "github.com/ZaiiiRan/job_search_service/auth-service/gen/go/auth_service/v1"
 	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type Server struct {
 	srv *http.Server
 }
 
-func New(ctx context.Context, cfg settings.HTTPServerSettings, grpcAddr string) (*Server, error) {
-	mux := runtime.NewServeMux()
+func New(
+	ctx context.Context,
+	cfg settings.HTTPServerSettings,
+	cookieCfg settings.SessionCookieSettings, jwtCfg settings.JWTSettings, hasher *secret.Hasher,
+	grpcAddr string, accessKeys *claims.KeySet,
+) (*Server, error) {
+	var cookies *sessionCookies
+	if cookieCfg.Enabled {
+		c, err := newSessionCookies(cookieCfg, jwtCfg, hasher)
+		if err != nil {
+			return nil, fmt.Errorf("invalid session cookie settings: %w", err)
+		}
+		cookies = c
+	}
+
+	muxOpts := []runtime.ServeMuxOption{runtime.WithErrorHandler(newErrorHandler(cookies))}
+	if cookies != nil {
+		muxOpts = append(muxOpts,
+			runtime.WithOutgoingTrailerMatcher(cookies.outgoingTrailerMatcher),
+			runtime.WithForwardResponseOption(cookies.forwardTokens),
+		)
+	}
+	mux := runtime.NewServeMux(muxOpts...)
 
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	if err := pb.RegisterAuthServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
@@ -30,7 +57,25 @@ func New(ctx context.Context, cfg settings.HTTPServerSettings, grpcAddr string)
 	swaggerDir := filepath.Join("gen", "openapiv2", "auth_service", "v1")
 
 	rootMux := http.NewServeMux()
-	rootMux.Handle("/", mux)
+	if cookies != nil {
+		rootMux.Handle("/", cookies.middleware(mux, mux))
+	} else {
+		rootMux.Handle("/", mux)
+	}
+
+	jwks, err := json.Marshal(accessKeys.JWKS())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal jwks: %w", err)
+	}
+	rootMux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Write(jwks)
+	})
 
 	rootMux.Handle("/swagger/", http.StripPrefix("/swagger/",
 		http.FileServer(http.Dir(swaggerDir)),
@@ -56,6 +101,25 @@ func New(ctx context.Context, cfg settings.HTTPServerSettings, grpcAddr string)
 	return &Server{srv: srv}, nil
 }
 
+// newErrorHandler exposes the retry delay of rejected requests as the Retry-After
+// header. With session cookies, the cookies of a session ended by the error are removed.
+func newErrorHandler(cookies *sessionCookies) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		if st, ok := status.FromError(err); ok {
+			for _, d := range st.Details() {
+				if ri, ok := d.(*errdetails.RetryInfo); ok {
+					w.Header().Set("Retry-After", strconv.FormatInt(int64(ri.GetRetryDelay().AsDuration().Seconds()), 10))
+					break
+				}
+			}
+		}
+		if cookies != nil {
+			_ = cookies.forwardTokens(ctx, w, nil)
+		}
+		runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+	}
+}
+
 func (s *Server) Start() error {
 	return s.srv.ListenAndServe()
 }
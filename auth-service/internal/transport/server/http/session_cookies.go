@@ -0,0 +1,193 @@
+package httpgateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/config/settings"
+	"github.com/ZaiiiRan/job_search_service/auth-service/internal/domain/secret"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	accessTokenKey  = "x-access-token"
+	refreshTokenKey = "x-refresh-token"
+)
+
+// refreshTokenHeader is forwarded by the gateway as the x-refresh-token metadata.
+var refreshTokenHeader = runtime.MetadataHeaderPrefix + "X-Refresh-Token"
+
+// sessionCookies turns the token trailers into HttpOnly cookies and reads the
+// tokens back from them. Requests authenticated by the cookies are protected
+// by a CSRF token keyed to the tokens of the session, so a token planted in
+// the cookie by a sibling domain does not pass.
+type sessionCookies struct {
+	cfg        settings.SessionCookieSettings
+	hasher     *secret.Hasher
+	sameSite   http.SameSite
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func newSessionCookies(cfg settings.SessionCookieSettings, jwtCfg settings.JWTSettings, hasher *secret.Hasher) (*sessionCookies, error) {
+	var sameSite http.SameSite
+	switch strings.ToLower(cfg.SameSite) {
+	case "strict":
+		sameSite = http.SameSiteStrictMode
+	case "lax":
+		sameSite = http.SameSiteLaxMode
+	case "none":
+		if !cfg.Secure {
+			return nil, fmt.Errorf("same_site none requires secure cookies")
+		}
+		sameSite = http.SameSiteNoneMode
+	default:
+		return nil, fmt.Errorf("unknown same_site %q", cfg.SameSite)
+	}
+
+	if len(cfg.RefreshTokenPaths) == 0 {
+		return nil, fmt.Errorf("refresh_token_paths are required")
+	}
+
+	return &sessionCookies{
+		cfg:        cfg,
+		hasher:     hasher,
+		sameSite:   sameSite,
+		accessTTL:  time.Duration(jwtCfg.AccessTokenTTL) * time.Second,
+		refreshTTL: time.Duration(jwtCfg.RefreshTokenTTL) * time.Second,
+	}, nil
+}
+
+// outgoingTrailerMatcher keeps the tokens out of the response, the browser
+// only receives them as cookies.
+func (c *sessionCookies) outgoingTrailerMatcher(key string) (string, bool) {
+	if key == accessTokenKey || key == refreshTokenKey {
+		return "", false
+	}
+	return runtime.MetadataTrailerPrefix + key, true
+}
+
+// forwardTokens sets the cookies from the token trailers. Empty tokens are
+// set when the session ends, then the cookies are removed.
+func (c *sessionCookies) forwardTokens(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	access := md.TrailerMD.Get(accessTokenKey)
+	refresh := md.TrailerMD.Get(refreshTokenKey)
+	if len(access) == 0 || len(refresh) == 0 {
+		return nil
+	}
+
+	if access[0] == "" || refresh[0] == "" {
+		http.SetCookie(w, c.cookie(c.cfg.AccessTokenName, "", c.cfg.AccessTokenPath, -1, true))
+		for _, path := range c.cfg.RefreshTokenPaths {
+			http.SetCookie(w, c.cookie(c.cfg.RefreshTokenName, "", path, -1, true))
+		}
+		http.SetCookie(w, c.cookie(c.cfg.CsrfTokenName, "", "/", -1, false))
+		return nil
+	}
+
+	http.SetCookie(w, c.cookie(c.cfg.AccessTokenName, access[0], c.cfg.AccessTokenPath, c.accessTTL, true))
+	for _, path := range c.cfg.RefreshTokenPaths {
+		http.SetCookie(w, c.cookie(c.cfg.RefreshTokenName, refresh[0], path, c.refreshTTL, true))
+	}
+	http.SetCookie(w, c.cookie(c.cfg.CsrfTokenName, c.csrfToken(access[0], refresh[0]), "/", c.refreshTTL, false))
+	return nil
+}
+
+// middleware passes the cookie tokens as the headers a client would send.
+// Explicit headers win over the cookies and need no CSRF check.
+func (c *sessionCookies) middleware(mux *runtime.ServeMux, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		access := cookieValue(r, c.cfg.AccessTokenName)
+		refresh := cookieValue(r, c.cfg.RefreshTokenName)
+
+		useAccess := access != "" && r.Header.Get("Authorization") == ""
+		useRefresh := refresh != "" && r.Header.Get(refreshTokenHeader) == ""
+		if !useAccess && !useRefresh {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !isSafeMethod(r.Method) && !c.checkCsrf(r, access, refresh, useAccess, useRefresh) {
+			err := status.Errorf(codes.PermissionDenied, "invalid csrf token")
+			runtime.HTTPError(r.Context(), mux, &runtime.JSONPb{}, w, r, err)
+			return
+		}
+
+		if useAccess {
+			r.Header.Set("Authorization", "Bearer "+access)
+		}
+		if useRefresh {
+			r.Header.Set(refreshTokenHeader, refresh)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// csrfToken keys both tokens of the session, the access token cookie and the
+// refresh token cookie are sent to different paths and either may be missing.
+func (c *sessionCookies) csrfToken(access, refresh string) string {
+	return c.hasher.Hash("csrf:access:"+access) + "." + c.hasher.Hash("csrf:refresh:"+refresh)
+}
+
+// checkCsrf verifies the header against every cookie token the request is
+// authenticated with. Only the server can compute a valid value, so the
+// value in the CSRF cookie itself is not trusted.
+func (c *sessionCookies) checkCsrf(r *http.Request, access, refresh string, useAccess, useRefresh bool) bool {
+	accessMac, refreshMac, ok := strings.Cut(r.Header.Get(c.cfg.CsrfHeader), ".")
+	if !ok {
+		return false
+	}
+	if useAccess && !c.hasher.Equal(accessMac, "csrf:access:"+access) {
+		return false
+	}
+	if useRefresh && !c.hasher.Equal(refreshMac, "csrf:refresh:"+refresh) {
+		return false
+	}
+	return true
+}
+
+// cookie builds a cookie removed by the browser once ttl passes, a negative
+// ttl removes it at once.
+func (c *sessionCookies) cookie(name, value, path string, ttl time.Duration, httpOnly bool) *http.Cookie {
+	maxAge := int(ttl.Seconds())
+	if ttl < 0 {
+		maxAge = -1
+	}
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   c.cfg.Domain,
+		MaxAge:   maxAge,
+		Secure:   c.cfg.Secure,
+		HttpOnly: httpOnly,
+		SameSite: c.sameSite,
+	}
+}
+
+func cookieValue(r *http.Request, name string) string {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
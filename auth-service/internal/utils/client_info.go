@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	DeviceKey           = "x-device"
+	GatewayUserAgentKey = "grpcgateway-user-agent"
+	UserAgentKey        = "user-agent"
+	ForwardedForKey     = "x-forwarded-for"
+	maxClientInfoLength = 512
+)
+
+type ClientInfo struct {
+	Device    string
+	UserAgent string
+	IP        string
+}
+
+// GetClientInfoFromContext collects client details forwarded by the http gateway
+// (or sent directly by grpc clients) to describe the session of a refresh token.
+func GetClientInfoFromContext(ctx context.Context) ClientInfo {
+	var info ClientInfo
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	info.Device = firstMetadataValue(md, DeviceKey)
+	info.UserAgent = firstMetadataValue(md, GatewayUserAgentKey, UserAgentKey)
+	info.IP = clientIP(ctx, md)
+
+	info.Device = truncate(info.Device, maxClientInfoLength)
+	info.UserAgent = truncate(info.UserAgent, maxClientInfoLength)
+	info.IP = truncate(info.IP, maxClientInfoLength)
+	return info
+}
+
+// clientIP trusts x-forwarded-for only on calls of the http gateway, which runs in
+// the same process and dials the grpc server over loopback. The gateway appends the
+// address of its own peer as the rightmost hop, the hops before it are sent by the
+// client and may be forged. Other callers are identified by their peer address.
+func clientIP(ctx context.Context, md metadata.MD) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	if parsed := net.ParseIP(ip); parsed == nil || !parsed.IsLoopback() {
+		return ip
+	}
+	// the gateway adds its value last, after any forwarded by the client
+	if values := md.Get(ForwardedForKey); len(values) > 0 {
+		hops := strings.Split(values[len(values)-1], ",")
+		if hop := strings.TrimSpace(hops[len(hops)-1]); hop != "" {
+			return hop
+		}
+	}
+	return ip
+}
+
+func firstMetadataValue(md metadata.MD, keys ...string) string {
+	for _, key := range keys {
+		if values := md.Get(key); len(values) > 0 && strings.TrimSpace(values[0]) != "" {
+			return strings.TrimSpace(values[0])
+		}
+	}
+	return ""
+}
+
+func truncate(s string, max int) string {
+	if len(s) > max {
+		return s[:max]
+	}
+	return s
+}
@@ -19,6 +19,24 @@ func SanitizeLoginApplicantRequest(req *pb.LoginApplicantRequest) {
 	req.Password = strings.TrimSpace(req.Password)
 }
 
+func SanitizeRequestApplicantLoginCodeRequest(req *pb.RequestApplicantLoginCodeRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+}
+
+func SanitizeLoginApplicantWithCodeRequest(req *pb.LoginApplicantWithCodeRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeCompleteApplicantOidcLoginRequest(req *pb.CompleteApplicantOidcLoginRequest) {
+	req.Code = strings.TrimSpace(req.Code)
+	req.State = strings.TrimSpace(req.State)
+}
+
+func SanitizeRegisterApplicantWithOidcRequest(req *pb.RegisterApplicantWithOidcRequest) {
+	req.RegistrationToken = strings.TrimSpace(req.RegistrationToken)
+}
+
 func SanitizeGetResetApplicantPasswordCodeRequest(req *pb.GetResetApplicantPasswordCodeRequest) {
 	req.Email = strings.TrimSpace(req.Email)
 }
@@ -34,6 +52,38 @@ func SanitizeChangeApplicantPasswordRequest(req *pb.ChangeApplicantPasswordReque
 	req.NewPassword = strings.TrimSpace(req.NewPassword)
 }
 
+func SanitizeRequestApplicantEmailChangeRequest(req *pb.RequestApplicantEmailChangeRequest) {
+	req.NewEmail = strings.TrimSpace(req.NewEmail)
+	req.Password = strings.TrimSpace(req.Password)
+}
+
+func SanitizeConfirmApplicantEmailChangeRequest(req *pb.ConfirmApplicantEmailChangeRequest) {
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeDeleteApplicantAccountRequest(req *pb.DeleteApplicantAccountRequest) {
+	req.Password = strings.TrimSpace(req.Password)
+}
+
+func SanitizeRestoreApplicantAccountRequest(req *pb.RestoreApplicantAccountRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+	req.Password = strings.TrimSpace(req.Password)
+}
+
+func SanitizeConfirmApplicantMfaRequest(req *pb.ConfirmApplicantMfaRequest) {
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeDisableApplicantMfaRequest(req *pb.DisableApplicantMfaRequest) {
+	req.Password = strings.TrimSpace(req.Password)
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeVerifyApplicantMfaRequest(req *pb.VerifyApplicantMfaRequest) {
+	req.MfaToken = strings.TrimSpace(req.MfaToken)
+	req.Code = strings.TrimSpace(req.Code)
+}
+
 func SanitizeRegisterEmployerRequest(req *pb.RegisterEmployerRequest) {
 	req.Password = strings.TrimSpace(req.Password)
 }
@@ -47,6 +97,15 @@ func SanitizeLoginEmployerRequest(req *pb.LoginEmployerRequest) {
 	req.Password = strings.TrimSpace(req.Password)
 }
 
+func SanitizeRequestEmployerLoginCodeRequest(req *pb.RequestEmployerLoginCodeRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+}
+
+func SanitizeLoginEmployerWithCodeRequest(req *pb.LoginEmployerWithCodeRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+	req.Code = strings.TrimSpace(req.Code)
+}
+
 func SanitizeGetResetEmployerPasswordCodeRequest(req *pb.GetResetEmployerPasswordCodeRequest) {
 	req.Email = strings.TrimSpace(req.Email)
 }
@@ -61,3 +120,39 @@ func SanitizeChangeEmployerPasswordRequest(req *pb.ChangeEmployerPasswordRequest
 	req.OldPassword = strings.TrimSpace(req.OldPassword)
 	req.NewPassword = strings.TrimSpace(req.NewPassword)
 }
+
+func SanitizeRequestEmployerEmailChangeRequest(req *pb.RequestEmployerEmailChangeRequest) {
+	req.NewEmail = strings.TrimSpace(req.NewEmail)
+	req.Password = strings.TrimSpace(req.Password)
+}
+
+func SanitizeConfirmEmployerEmailChangeRequest(req *pb.ConfirmEmployerEmailChangeRequest) {
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeDeleteEmployerAccountRequest(req *pb.DeleteEmployerAccountRequest) {
+	req.Password = strings.TrimSpace(req.Password)
+}
+
+func SanitizeRestoreEmployerAccountRequest(req *pb.RestoreEmployerAccountRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+	req.Password = strings.TrimSpace(req.Password)
+}
+
+func SanitizeConfirmEmployerMfaRequest(req *pb.ConfirmEmployerMfaRequest) {
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeDisableEmployerMfaRequest(req *pb.DisableEmployerMfaRequest) {
+	req.Password = strings.TrimSpace(req.Password)
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeVerifyEmployerMfaRequest(req *pb.VerifyEmployerMfaRequest) {
+	req.MfaToken = strings.TrimSpace(req.MfaToken)
+	req.Code = strings.TrimSpace(req.Code)
+}
+
+func SanitizeIntrospectTokenRequest(req *pb.IntrospectTokenRequest) {
+	req.Token = strings.TrimSpace(req.Token)
+}
@@ -0,0 +1,37 @@
+-- +goose Up
+ALTER TABLE applicant_refresh_tokens
+    ADD COLUMN device TEXT NOT NULL DEFAULT '',
+    ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+    ADD COLUMN ip TEXT NOT NULL DEFAULT '',
+    ADD COLUMN last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+ALTER TABLE employer_refresh_tokens
+    ADD COLUMN device TEXT NOT NULL DEFAULT '',
+    ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+    ADD COLUMN ip TEXT NOT NULL DEFAULT '',
+    ADD COLUMN last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+ALTER TYPE v1_refresh_token
+    ADD ATTRIBUTE device TEXT,
+    ADD ATTRIBUTE user_agent TEXT,
+    ADD ATTRIBUTE ip TEXT,
+    ADD ATTRIBUTE last_used_at TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TYPE v1_refresh_token
+    DROP ATTRIBUTE IF EXISTS last_used_at,
+    DROP ATTRIBUTE IF EXISTS ip,
+    DROP ATTRIBUTE IF EXISTS user_agent,
+    DROP ATTRIBUTE IF EXISTS device;
+
+ALTER TABLE employer_refresh_tokens
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS ip,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS device;
+
+ALTER TABLE applicant_refresh_tokens
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS ip,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS device;
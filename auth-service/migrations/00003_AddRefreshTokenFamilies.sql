@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE TABLE applicant_rotated_refresh_tokens (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    family_id BIGINT NOT NULL REFERENCES applicant_refresh_tokens(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    token TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    rotated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_applicant_rotated_refresh_tokens_token ON applicant_rotated_refresh_tokens(token);
+CREATE INDEX idx_applicant_rotated_refresh_tokens_family_id ON applicant_rotated_refresh_tokens(family_id);
+
+CREATE TABLE employer_rotated_refresh_tokens (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    family_id BIGINT NOT NULL REFERENCES employer_refresh_tokens(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    token TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    rotated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_employer_rotated_refresh_tokens_token ON employer_rotated_refresh_tokens(token);
+CREATE INDEX idx_employer_rotated_refresh_tokens_family_id ON employer_rotated_refresh_tokens(family_id);
+
+CREATE TYPE v1_rotated_refresh_token AS (
+    id BIGINT,
+    family_id BIGINT,
+    user_id BIGINT,
+    token TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    rotated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applicant_rotated_refresh_tokens_token;
+DROP INDEX IF EXISTS idx_applicant_rotated_refresh_tokens_family_id;
+DROP TABLE IF EXISTS applicant_rotated_refresh_tokens;
+
+DROP INDEX IF EXISTS idx_employer_rotated_refresh_tokens_token;
+DROP INDEX IF EXISTS idx_employer_rotated_refresh_tokens_family_id;
+DROP TABLE IF EXISTS employer_rotated_refresh_tokens;
+
+DROP TYPE IF EXISTS v1_rotated_refresh_token;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE applicant_security_versions (
+    user_id BIGINT NOT NULL PRIMARY KEY,
+    version INT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE employer_security_versions (
+    user_id BIGINT NOT NULL PRIMARY KEY,
+    version INT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS applicant_security_versions;
+DROP TABLE IF EXISTS employer_security_versions;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE applicant_activation_codes ADD COLUMN attempts_left INT NOT NULL DEFAULT 5;
+ALTER TABLE applicant_reset_password_codes ADD COLUMN attempts_left INT NOT NULL DEFAULT 5;
+ALTER TABLE employer_activation_codes ADD COLUMN attempts_left INT NOT NULL DEFAULT 5;
+ALTER TABLE employer_reset_password_codes ADD COLUMN attempts_left INT NOT NULL DEFAULT 5;
+
+ALTER TYPE v1_code ADD ATTRIBUTE attempts_left INT;
+
+-- +goose Down
+ALTER TYPE v1_code DROP ATTRIBUTE IF EXISTS attempts_left;
+
+ALTER TABLE applicant_activation_codes DROP COLUMN IF EXISTS attempts_left;
+ALTER TABLE applicant_reset_password_codes DROP COLUMN IF EXISTS attempts_left;
+ALTER TABLE employer_activation_codes DROP COLUMN IF EXISTS attempts_left;
+ALTER TABLE employer_reset_password_codes DROP COLUMN IF EXISTS attempts_left;
@@ -0,0 +1,56 @@
+-- +goose Up
+-- Stored codes and refresh tokens are plaintext and cannot be hashed here without
+-- the application key, so they are dropped: pending codes have to be requested
+-- again and every session has to log in again.
+DELETE FROM applicant_activation_codes;
+DELETE FROM applicant_reset_password_codes;
+DELETE FROM employer_activation_codes;
+DELETE FROM employer_reset_password_codes;
+DELETE FROM applicant_refresh_tokens;
+DELETE FROM employer_refresh_tokens;
+
+ALTER TABLE applicant_activation_codes RENAME COLUMN code TO code_hash;
+ALTER TABLE applicant_reset_password_codes RENAME COLUMN code TO code_hash;
+ALTER TABLE employer_activation_codes RENAME COLUMN code TO code_hash;
+ALTER TABLE employer_reset_password_codes RENAME COLUMN code TO code_hash;
+
+ALTER TABLE applicant_refresh_tokens RENAME COLUMN token TO token_hash;
+ALTER INDEX idx_applicant_refresh_tokens_token RENAME TO idx_applicant_refresh_tokens_token_hash;
+ALTER TABLE employer_refresh_tokens RENAME COLUMN token TO token_hash;
+ALTER INDEX idx_employer_refresh_tokens_token RENAME TO idx_employer_refresh_tokens_token_hash;
+
+ALTER TABLE applicant_rotated_refresh_tokens RENAME COLUMN token TO token_hash;
+ALTER INDEX idx_applicant_rotated_refresh_tokens_token RENAME TO idx_applicant_rotated_refresh_tokens_token_hash;
+ALTER TABLE employer_rotated_refresh_tokens RENAME COLUMN token TO token_hash;
+ALTER INDEX idx_employer_rotated_refresh_tokens_token RENAME TO idx_employer_rotated_refresh_tokens_token_hash;
+
+ALTER TYPE v1_code RENAME ATTRIBUTE code TO code_hash;
+ALTER TYPE v1_refresh_token RENAME ATTRIBUTE token TO token_hash;
+ALTER TYPE v1_rotated_refresh_token RENAME ATTRIBUTE token TO token_hash;
+
+-- +goose Down
+DELETE FROM applicant_activation_codes;
+DELETE FROM applicant_reset_password_codes;
+DELETE FROM employer_activation_codes;
+DELETE FROM employer_reset_password_codes;
+DELETE FROM applicant_refresh_tokens;
+DELETE FROM employer_refresh_tokens;
+
+ALTER TYPE v1_code RENAME ATTRIBUTE code_hash TO code;
+ALTER TYPE v1_refresh_token RENAME ATTRIBUTE token_hash TO token;
+ALTER TYPE v1_rotated_refresh_token RENAME ATTRIBUTE token_hash TO token;
+
+ALTER TABLE applicant_activation_codes RENAME COLUMN code_hash TO code;
+ALTER TABLE applicant_reset_password_codes RENAME COLUMN code_hash TO code;
+ALTER TABLE employer_activation_codes RENAME COLUMN code_hash TO code;
+ALTER TABLE employer_reset_password_codes RENAME COLUMN code_hash TO code;
+
+ALTER TABLE applicant_refresh_tokens RENAME COLUMN token_hash TO token;
+ALTER INDEX idx_applicant_refresh_tokens_token_hash RENAME TO idx_applicant_refresh_tokens_token;
+ALTER TABLE employer_refresh_tokens RENAME COLUMN token_hash TO token;
+ALTER INDEX idx_employer_refresh_tokens_token_hash RENAME TO idx_employer_refresh_tokens_token;
+
+ALTER TABLE applicant_rotated_refresh_tokens RENAME COLUMN token_hash TO token;
+ALTER INDEX idx_applicant_rotated_refresh_tokens_token_hash RENAME TO idx_applicant_rotated_refresh_tokens_token;
+ALTER TABLE employer_rotated_refresh_tokens RENAME COLUMN token_hash TO token;
+ALTER INDEX idx_employer_rotated_refresh_tokens_token_hash RENAME TO idx_employer_rotated_refresh_tokens_token;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE applicant_password_history (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_applicant_password_history_user_id ON applicant_password_history(user_id, created_at DESC);
+
+CREATE TABLE employer_password_history (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_employer_password_history_user_id ON employer_password_history(user_id, created_at DESC);
+
+CREATE TYPE v1_password_history AS (
+    id BIGINT,
+    user_id BIGINT,
+    password_hash TEXT,
+    created_at TIMESTAMP WITH TIME ZONE
+);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applicant_password_history_user_id;
+DROP TABLE IF EXISTS applicant_password_history;
+
+DROP INDEX IF EXISTS idx_employer_password_history_user_id;
+DROP TABLE IF EXISTS employer_password_history;
+
+DROP TYPE IF EXISTS v1_password_history;
@@ -0,0 +1,46 @@
+-- +goose Up
+CREATE TABLE applicant_mfa (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    encrypted_secret TEXT NOT NULL,
+    is_confirmed BOOLEAN NOT NULL DEFAULT FALSE,
+    recovery_code_hashes TEXT[] NOT NULL DEFAULT '{}',
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_applicant_mfa_user_id_unique ON applicant_mfa(user_id);
+
+CREATE TABLE employer_mfa (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    encrypted_secret TEXT NOT NULL,
+    is_confirmed BOOLEAN NOT NULL DEFAULT FALSE,
+    recovery_code_hashes TEXT[] NOT NULL DEFAULT '{}',
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_employer_mfa_user_id_unique ON employer_mfa(user_id);
+
+CREATE TYPE v1_mfa AS (
+    id BIGINT,
+    user_id BIGINT,
+    encrypted_secret TEXT,
+    is_confirmed BOOLEAN,
+    recovery_code_hashes TEXT[],
+    last_used_step BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applicant_mfa_user_id_unique;
+DROP TABLE IF EXISTS applicant_mfa;
+
+DROP INDEX IF EXISTS idx_employer_mfa_user_id_unique;
+DROP TABLE IF EXISTS employer_mfa;
+
+DROP TYPE IF EXISTS v1_mfa;
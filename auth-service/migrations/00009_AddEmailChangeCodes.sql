@@ -0,0 +1,49 @@
+-- +goose Up
+ALTER TABLE applicant_activation_codes ADD COLUMN new_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE applicant_reset_password_codes ADD COLUMN new_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE employer_activation_codes ADD COLUMN new_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE employer_reset_password_codes ADD COLUMN new_email TEXT NOT NULL DEFAULT '';
+
+ALTER TYPE v1_code ADD ATTRIBUTE new_email TEXT;
+
+CREATE TABLE applicant_email_change_codes (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL UNIQUE,
+    code_hash TEXT NOT NULL,
+    generations_left INT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts_left INT NOT NULL DEFAULT 5,
+    new_email TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_applicant_email_change_codes_user_id ON applicant_email_change_codes(user_id);
+
+CREATE TABLE employer_email_change_codes (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL UNIQUE,
+    code_hash TEXT NOT NULL,
+    generations_left INT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts_left INT NOT NULL DEFAULT 5,
+    new_email TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_employer_email_change_codes_user_id ON employer_email_change_codes(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applicant_email_change_codes_user_id;
+DROP TABLE IF EXISTS applicant_email_change_codes;
+
+DROP INDEX IF EXISTS idx_employer_email_change_codes_user_id;
+DROP TABLE IF EXISTS employer_email_change_codes;
+
+ALTER TYPE v1_code DROP ATTRIBUTE IF EXISTS new_email;
+
+ALTER TABLE applicant_activation_codes DROP COLUMN IF EXISTS new_email;
+ALTER TABLE applicant_reset_password_codes DROP COLUMN IF EXISTS new_email;
+ALTER TABLE employer_activation_codes DROP COLUMN IF EXISTS new_email;
+ALTER TABLE employer_reset_password_codes DROP COLUMN IF EXISTS new_email;
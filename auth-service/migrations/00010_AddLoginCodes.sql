@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE applicant_login_codes (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL UNIQUE,
+    code_hash TEXT NOT NULL,
+    generations_left INT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts_left INT NOT NULL DEFAULT 5,
+    new_email TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_applicant_login_codes_user_id ON applicant_login_codes(user_id);
+
+CREATE TABLE employer_login_codes (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL UNIQUE,
+    code_hash TEXT NOT NULL,
+    generations_left INT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts_left INT NOT NULL DEFAULT 5,
+    new_email TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_employer_login_codes_user_id ON employer_login_codes(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applicant_login_codes_user_id;
+DROP TABLE IF EXISTS applicant_login_codes;
+
+DROP INDEX IF EXISTS idx_employer_login_codes_user_id;
+DROP TABLE IF EXISTS employer_login_codes;
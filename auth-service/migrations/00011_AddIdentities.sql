@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE applicant_identities (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_applicant_identities_provider_subject_unique ON applicant_identities(provider, subject);
+CREATE INDEX idx_applicant_identities_user_id ON applicant_identities(user_id);
+
+CREATE TYPE v1_identity AS (
+    id BIGINT,
+    user_id BIGINT,
+    provider TEXT,
+    subject TEXT,
+    email TEXT,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applicant_identities_user_id;
+DROP INDEX IF EXISTS idx_applicant_identities_provider_subject_unique;
+DROP TABLE IF EXISTS applicant_identities;
+
+DROP TYPE IF EXISTS v1_identity;
@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE applicant_registration_sagas (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    email TEXT NOT NULL,
+    state TEXT NOT NULL,
+    attempts INT NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_applicant_registration_sagas_state_updated_at ON applicant_registration_sagas(state, updated_at);
+CREATE INDEX idx_applicant_registration_sagas_email ON applicant_registration_sagas(email);
+
+CREATE TABLE employer_registration_sagas (
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    email TEXT NOT NULL,
+    state TEXT NOT NULL,
+    attempts INT NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_employer_registration_sagas_state_updated_at ON employer_registration_sagas(state, updated_at);
+CREATE INDEX idx_employer_registration_sagas_email ON employer_registration_sagas(email);
+
+CREATE TYPE v1_registration_saga AS (
+    id BIGINT,
+    user_id BIGINT,
+    email TEXT,
+    state TEXT,
+    attempts INT,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_employer_registration_sagas_email;
+DROP INDEX IF EXISTS idx_employer_registration_sagas_state_updated_at;
+DROP TABLE IF EXISTS employer_registration_sagas;
+
+DROP INDEX IF EXISTS idx_applicant_registration_sagas_email;
+DROP INDEX IF EXISTS idx_applicant_registration_sagas_state_updated_at;
+DROP TABLE IF EXISTS applicant_registration_sagas;
+
+DROP TYPE IF EXISTS v1_registration_saga;
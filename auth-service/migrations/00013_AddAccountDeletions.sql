@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE applicant_account_deletions (
+    user_id BIGINT NOT NULL PRIMARY KEY,
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_applicant_account_deletions_deleted_at ON applicant_account_deletions(deleted_at);
+
+CREATE TABLE employer_account_deletions (
+    user_id BIGINT NOT NULL PRIMARY KEY,
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_employer_account_deletions_deleted_at ON employer_account_deletions(deleted_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_employer_account_deletions_deleted_at;
+DROP TABLE IF EXISTS employer_account_deletions;
+
+DROP INDEX IF EXISTS idx_applicant_account_deletions_deleted_at;
+DROP TABLE IF EXISTS applicant_account_deletions;
@@ -0,0 +1,32 @@
+package ctxmetadata
+
+import (
+	"context"
+
+	claims "github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"google.golang.org/grpc/metadata"
+)
+
+type CtxKeyServiceClaims struct{}
+
+// ServiceTokenKey carries the token of an internal caller. It is kept apart
+// from the authorization header, which holds the token of the end user.
+const ServiceTokenKey = "x-service-token"
+
+func WithServiceClaims(ctx context.Context, claims *claims.ServiceClaims) context.Context {
+	return context.WithValue(ctx, CtxKeyServiceClaims{}, claims)
+}
+
+func GetServiceClaimsFromContext(ctx context.Context) (*claims.ServiceClaims, bool) {
+	c, ok := ctx.Value(CtxKeyServiceClaims{}).(*claims.ServiceClaims)
+	return c, ok
+}
+
+func GetServiceTokenFromIncomingContext(ctx context.Context) (string, bool) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ServiceTokenKey); len(values) > 0 && values[0] != "" {
+			return values[0], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,147 @@
+package introspection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// Result is the answer of the IntrospectToken rpc of the auth service.
+// Only Active is set for an inactive token.
+type Result struct {
+	Active    bool
+	Subject   string
+	UserKind  string // jwt.KindApplicant or jwt.KindEmployer
+	Scopes    []string
+	ExpiresAt time.Time
+	IssuedAt  time.Time
+}
+
+func (r *Result) HasScope(scope string) bool {
+	for _, s := range r.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IntrospectFunc calls the IntrospectToken rpc. Services adapt their generated
+// auth service client to it, so common does not depend on the generated code.
+type IntrospectFunc func(ctx context.Context, token string) (*Result, error)
+
+type Options struct {
+	// TTL bounds how long a revoked token may still be reported as active.
+	TTL time.Duration
+	// InactiveTTL keeps inactive tokens from hitting the auth service on every call.
+	InactiveTTL time.Duration
+	MaxEntries  int
+}
+
+func DefaultOptions() Options {
+	return Options{
+		TTL:         30 * time.Second,
+		InactiveTTL: 10 * time.Second,
+		MaxEntries:  10000,
+	}
+}
+
+type entry struct {
+	result    *Result
+	expiresAt time.Time
+}
+
+// Client caches the introspection results by the hash of the token.
+type Client struct {
+	introspect IntrospectFunc
+	opts       Options
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+func New(introspect IntrospectFunc, opts Options) *Client {
+	return &Client{
+		introspect: introspect,
+		opts:       opts,
+		entries:    make(map[string]entry),
+	}
+}
+
+// Introspect returns the cached result or asks the auth service. Errors of
+// the call are returned as they are and never cached.
+func (c *Client) Introspect(ctx context.Context, token string) (*Result, error) {
+	if token == "" {
+		return &Result{Active: false}, nil
+	}
+
+	key := hashToken(token)
+	now := time.Now()
+	if res, ok := c.get(key, now); ok {
+		return res, nil
+	}
+
+	res, err := c.introspect(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	c.set(key, res, now)
+	return res, nil
+}
+
+func (c *Client) get(key string, now time.Time) (*Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(e.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.result, true
+}
+
+func (c *Client) set(key string, res *Result, now time.Time) {
+	expiresAt := now.Add(c.opts.InactiveTTL)
+	if res.Active {
+		expiresAt = now.Add(c.opts.TTL)
+		// an active result must not outlive the token
+		if !res.ExpiresAt.IsZero() && res.ExpiresAt.Before(expiresAt) {
+			expiresAt = res.ExpiresAt
+		}
+	}
+	if !now.Before(expiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.opts.MaxEntries > 0 && len(c.entries) >= c.opts.MaxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = entry{result: res, expiresAt: expiresAt}
+}
+
+// evict drops the expired entries, or everything when none has expired.
+func (c *Client) evict(now time.Time) {
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= c.opts.MaxEntries {
+		c.entries = make(map[string]entry)
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
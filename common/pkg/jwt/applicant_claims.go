@@ -11,5 +11,6 @@ type ApplicantClaims struct {
 	IsActive   bool
 	IsDeleted  bool
 	Version    int
+	Kind       string
 	jwt.RegisteredClaims
 }
@@ -0,0 +1,129 @@
+package denylist
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type Subject string
+
+const (
+	Applicant Subject = "applicant"
+	Employer  Subject = "employer"
+)
+
+const keyPrefix = "denylist"
+
+// TokenInfo describes an access token being checked against the denylist.
+type TokenInfo struct {
+	Subject  Subject
+	UserId   int64
+	Jti      string
+	IssuedAt time.Time
+	Version  int
+}
+
+// Denylist keeps revoked access tokens until they expire on their own.
+// A token is revoked by its jti, because it was issued before the
+// "revoked before" timestamp of its user or because its version is older
+// than the security version of its user.
+type Denylist interface {
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	RevokeUserTokens(ctx context.Context, subject Subject, userId int64, before time.Time, ttl time.Duration) error
+	SetUserVersion(ctx context.Context, subject Subject, userId int64, version int) error
+	IsRevoked(ctx context.Context, t TokenInfo) (bool, error)
+}
+
+type redisDenylist struct {
+	client redis.UniversalClient
+}
+
+func NewRedis(client redis.UniversalClient) Denylist {
+	return &redisDenylist{client: client}
+}
+
+func (d *redisDenylist) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" {
+		return nil
+	}
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	return d.client.Set(ctx, jtiKey(jti), 1, ttl).Err()
+}
+
+// RevokeUserTokens revokes every token of the user issued before the given time.
+// ttl should be at least the access token lifetime.
+func (d *redisDenylist) RevokeUserTokens(ctx context.Context, subject Subject, userId int64, before time.Time, ttl time.Duration) error {
+	return d.client.Set(ctx, userKey(subject, userId), before.Unix(), ttl).Err()
+}
+
+// raiseVersionScript sets the version only when it is higher than the stored one.
+var raiseVersionScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current and tonumber(current) >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1])
+return 1
+`)
+
+// SetUserVersion stores the current security version of the user. The stored version
+// is only ever raised, so a login that read the version before a concurrent bump
+// cannot bring the revoked tokens back. The key has no ttl because the version is
+// compared with every token of the user.
+func (d *redisDenylist) SetUserVersion(ctx context.Context, subject Subject, userId int64, version int) error {
+	return raiseVersionScript.Run(ctx, d.client, []string{versionKey(subject, userId)}, version).Err()
+}
+
+func (d *redisDenylist) IsRevoked(ctx context.Context, t TokenInfo) (bool, error) {
+	keys := []string{userKey(t.Subject, t.UserId), versionKey(t.Subject, t.UserId)}
+	if t.Jti != "" {
+		keys = append(keys, jtiKey(t.Jti))
+	}
+
+	vals, err := d.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return false, fmt.Errorf("denylist lookup: %w", err)
+	}
+
+	if before, ok := vals[0].(string); ok {
+		ts, err := strconv.ParseInt(before, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("denylist parse timestamp: %w", err)
+		}
+		if t.IssuedAt.Unix() < ts {
+			return true, nil
+		}
+	}
+	if version, ok := vals[1].(string); ok {
+		v, err := strconv.Atoi(version)
+		if err != nil {
+			return false, fmt.Errorf("denylist parse version: %w", err)
+		}
+		if t.Version < v {
+			return true, nil
+		}
+	}
+	if len(vals) > 2 && vals[2] != nil {
+		return true, nil
+	}
+	return false, nil
+}
+
+func jtiKey(jti string) string {
+	return fmt.Sprintf("%s:jti:%s", keyPrefix, jti)
+}
+
+func userKey(subject Subject, userId int64) string {
+	return fmt.Sprintf("%s:%s:%d", keyPrefix, subject, userId)
+}
+
+func versionKey(subject Subject, userId int64) string {
+	return fmt.Sprintf("%s:%s:%d:version", keyPrefix, subject, userId)
+}
@@ -9,5 +9,6 @@ type EmployerClaims struct {
 	IsActive    bool
 	IsDeleted   bool
 	Version     int
+	Kind        string
 	jwt.RegisteredClaims
 }
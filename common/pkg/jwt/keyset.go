@@ -0,0 +1,96 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"fmt"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+	AlgorithmHS256 = "HS256"
+)
+
+// Key is a verification key identified by the kid header of a token.
+// Key holds *rsa.PublicKey for RS256, ed25519.PublicKey for EdDSA and []byte for HS256.
+type Key struct {
+	Id        string
+	Algorithm string
+	Key       any
+}
+
+// KeyProvider looks verification keys up by the kid header of a token.
+type KeyProvider interface {
+	Key(id string) (*Key, bool)
+}
+
+type KeySet struct {
+	keys  map[string]*Key
+	order []string
+}
+
+func NewKeySet(keys ...*Key) (*KeySet, error) {
+	s := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, k := range keys {
+		if k == nil {
+			continue
+		}
+		if err := validateKey(k); err != nil {
+			return nil, err
+		}
+		if _, ok := s.keys[k.Id]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", k.Id)
+		}
+		s.keys[k.Id] = k
+		s.order = append(s.order, k.Id)
+	}
+	if len(s.keys) == 0 {
+		return nil, fmt.Errorf("key set is empty")
+	}
+	return s, nil
+}
+
+// Key returns the key with the given id. Tokens without a kid header
+// are accepted only when the set holds a single key.
+func (s *KeySet) Key(id string) (*Key, bool) {
+	if s == nil {
+		return nil, false
+	}
+	if id == "" && len(s.order) == 1 {
+		return s.keys[s.order[0]], true
+	}
+	k, ok := s.keys[id]
+	return k, ok
+}
+
+func (s *KeySet) Keys() []*Key {
+	if s == nil {
+		return nil
+	}
+	keys := make([]*Key, 0, len(s.order))
+	for _, id := range s.order {
+		keys = append(keys, s.keys[id])
+	}
+	return keys
+}
+
+func validateKey(k *Key) error {
+	var ok bool
+	switch k.Algorithm {
+	case AlgorithmRS256:
+		_, ok = k.Key.(*rsa.PublicKey)
+	case AlgorithmEdDSA:
+		_, ok = k.Key.(ed25519.PublicKey)
+	case AlgorithmHS256:
+		var secret []byte
+		secret, ok = k.Key.([]byte)
+		ok = ok && len(secret) > 0
+	default:
+		return fmt.Errorf("key %q: unsupported algorithm %q", k.Id, k.Algorithm)
+	}
+	if !ok {
+		return fmt.Errorf("key %q: invalid key for algorithm %s", k.Id, k.Algorithm)
+	}
+	return nil
+}
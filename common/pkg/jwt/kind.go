@@ -0,0 +1,27 @@
+package jwt
+
+import "github.com/golang-jwt/jwt/v5"
+
+// Kind tells applicant and employer tokens apart, both are signed with the
+// same keys. A token without a kind is invalid.
+const (
+	KindApplicant = "applicant"
+	KindEmployer  = "employer"
+)
+
+type kindClaims struct {
+	Kind string
+	jwt.RegisteredClaims
+}
+
+// ParseTokenKind verifies the token and returns its kind.
+func ParseTokenKind(tokenStr string, keys KeyProvider) (string, error) {
+	claims, err := parseToken(tokenStr, keys, func() *kindClaims { return &kindClaims{} })
+	if err != nil {
+		return "", err
+	}
+	if claims.Kind == "" {
+		return "", ErrInvalidToken
+	}
+	return claims.Kind, nil
+}
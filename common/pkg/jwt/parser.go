@@ -2,27 +2,47 @@ package jwt
 
 import "github.com/golang-jwt/jwt/v5"
 
-func ParseApplicantToken(tokenStr string, key []byte) (*ApplicantClaims, error) {
-	return parseToken(tokenStr, key, func() *ApplicantClaims { return &ApplicantClaims{} })
+// ParseApplicantToken rejects employer tokens and tokens without a kind.
+func ParseApplicantToken(tokenStr string, keys KeyProvider) (*ApplicantClaims, error) {
+	claims, err := parseToken(tokenStr, keys, func() *ApplicantClaims { return &ApplicantClaims{} })
+	if err != nil {
+		return nil, err
+	}
+	if claims.Kind != KindApplicant {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
 }
 
-func ParseEmployerToken(tokenStr string, key []byte) (*EmployerClaims, error) {
-	return parseToken(tokenStr, key, func() *EmployerClaims { return &EmployerClaims{} })
+// ParseEmployerToken rejects applicant tokens and tokens without a kind.
+func ParseEmployerToken(tokenStr string, keys KeyProvider) (*EmployerClaims, error) {
+	claims, err := parseToken(tokenStr, keys, func() *EmployerClaims { return &EmployerClaims{} })
+	if err != nil {
+		return nil, err
+	}
+	if claims.Kind != KindEmployer {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
 }
 
 func parseToken[T jwt.Claims](
 	tokenStr string,
-	key []byte,
+	keys KeyProvider,
 	newClaims func() T,
 ) (T, error) {
 	claims := newClaims()
 	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			var zero T
-			return zero, ErrInvalidToken
+		kid, _ := token.Header["kid"].(string)
+		key, ok := keys.Key(kid)
+		if !ok || token.Method.Alg() != key.Algorithm {
+			return nil, ErrInvalidToken
 		}
-		return key, nil
-	})
+		return key.Key, nil
+	},
+		jwt.WithValidMethods([]string{AlgorithmRS256, AlgorithmEdDSA, AlgorithmHS256}),
+		jwt.WithExpirationRequired(),
+	)
 
 	if err != nil || !token.Valid {
 		var zero T
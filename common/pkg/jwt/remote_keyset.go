@@ -0,0 +1,71 @@
+package jwt
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval keeps tokens with unknown kids from hammering the issuer.
+const minRefreshInterval = time.Minute
+
+// RemoteKeySet provides the keys of the JWKS document published by the issuer.
+// The document is fetched again once it is stale or a token names an unknown
+// key, so rotated keys are picked up without a restart.
+type RemoteKeySet struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      *KeySet
+	checkedAt time.Time
+}
+
+func NewRemoteKeySet(url string, ttl, timeout time.Duration) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Key returns the key with the given id. While the document is unavailable
+// the keys fetched last are used.
+func (s *RemoteKeySet) Key(id string) (*Key, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.keys != nil {
+		key, ok := s.keys.Key(id)
+		since := time.Since(s.checkedAt)
+		if (ok && since < s.ttl) || since < minRefreshInterval {
+			return key, ok
+		}
+	}
+
+	s.checkedAt = time.Now()
+	if keys, err := s.fetch(); err == nil {
+		s.keys = keys
+	}
+	return s.keys.Key(id)
+}
+
+func (s *RemoteKeySet) fetch() (*KeySet, error) {
+	resp, err := s.client.Get(s.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read jwks: %w", err)
+	}
+	return ParseJWKS(data)
+}
@@ -0,0 +1,43 @@
+package jwt
+
+import "github.com/golang-jwt/jwt/v5"
+
+// KindService marks the tokens services authenticate to each other with.
+// The subject names the calling service and is the id of its key.
+const KindService = "service"
+
+type ServiceClaims struct {
+	Kind string
+	jwt.RegisteredClaims
+}
+
+func (c *ServiceClaims) Service() string {
+	return c.Subject
+}
+
+// ParseServiceToken verifies a token issued for audience. Every service signs
+// with its own key, so a token is accepted only with the key of its subject.
+func ParseServiceToken(tokenStr string, keys *KeySet, audience string) (*ServiceClaims, error) {
+	claims := &ServiceClaims{}
+	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" || kid != claims.Subject {
+			return nil, ErrInvalidToken
+		}
+		key, ok := keys.Key(kid)
+		if !ok || token.Method.Alg() != key.Algorithm {
+			return nil, ErrInvalidToken
+		}
+		return key.Key, nil
+	},
+		jwt.WithValidMethods([]string{AlgorithmRS256, AlgorithmEdDSA, AlgorithmHS256}),
+		jwt.WithExpirationRequired(),
+		jwt.WithAudience(audience),
+	)
+
+	if err != nil || !token.Valid || claims.Kind != KindService {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
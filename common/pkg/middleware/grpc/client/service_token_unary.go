@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ServiceTokenUnary authenticates the calls of the service with a token of signer.
+func ServiceTokenUnary(signer *jwt.ServiceTokenSigner) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		token, err := signer.Token()
+		if err != nil {
+			return err
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, ctxmetadata.ServiceTokenKey, token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
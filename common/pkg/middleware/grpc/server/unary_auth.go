@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt/denylist"
+	gojwt "github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,7 +19,9 @@ var (
 	errUnathorized = errors.New("unauthorized")
 )
 
-func ApplicantAuthMiddleware(secretKey []byte, shouldProtect MethodMatcher) grpc.UnaryServerInterceptor {
+// ApplicantAuthMiddleware verifies the bearer token against keys. When dl is not nil,
+// revoked tokens are rejected as well.
+func ApplicantAuthMiddleware(keys jwt.KeyProvider, dl denylist.Denylist, shouldProtect MethodMatcher) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -33,18 +38,24 @@ func ApplicantAuthMiddleware(secretKey []byte, shouldProtect MethodMatcher) grpc
 			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
 		}
 
-		claims, err := jwt.ParseApplicantToken(tokenStr, secretKey)
+		claims, err := jwt.ParseApplicantToken(tokenStr, keys)
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
 		}
 
+		if err := checkDenylist(ctx, dl, denylist.Applicant, claims.Id, claims.Version, &claims.RegisteredClaims); err != nil {
+			return nil, err
+		}
+
 		ctx = ctxmetadata.WithApplicantClaims(ctx, claims)
 
 		return handler(ctx, req)
 	}
 }
 
-func EmployerAuthMiddleware(secretKey []byte, shouldProtect MethodMatcher) grpc.UnaryServerInterceptor {
+// EmployerAuthMiddleware verifies the bearer token against keys. When dl is not nil,
+// revoked tokens are rejected as well.
+func EmployerAuthMiddleware(keys jwt.KeyProvider, dl denylist.Denylist, shouldProtect MethodMatcher) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -61,17 +72,85 @@ func EmployerAuthMiddleware(secretKey []byte, shouldProtect MethodMatcher) grpc.
 			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
 		}
 
-		claims, err := jwt.ParseEmployerToken(tokenStr, secretKey)
+		claims, err := jwt.ParseEmployerToken(tokenStr, keys)
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
 		}
 
+		if err := checkDenylist(ctx, dl, denylist.Employer, claims.Id, claims.Version, &claims.RegisteredClaims); err != nil {
+			return nil, err
+		}
+
 		ctx = ctxmetadata.WithEmployerClaims(ctx, claims)
 
 		return handler(ctx, req)
 	}
 }
 
+// UserAuthMiddleware accepts the bearer token of an applicant as well as of an employer
+// and puts the claims of its kind into the context. When dl is not nil, revoked tokens
+// are rejected as well.
+func UserAuthMiddleware(keys jwt.KeyProvider, dl denylist.Denylist, shouldProtect MethodMatcher) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+
+		if shouldProtect == nil || !shouldProtect(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		tokenStr, err := extractBearerToken(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
+		}
+
+		if claims, err := jwt.ParseApplicantToken(tokenStr, keys); err == nil {
+			if err := checkDenylist(ctx, dl, denylist.Applicant, claims.Id, claims.Version, &claims.RegisteredClaims); err != nil {
+				return nil, err
+			}
+			return handler(ctxmetadata.WithApplicantClaims(ctx, claims), req)
+		}
+
+		claims, err := jwt.ParseEmployerToken(tokenStr, keys)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
+		}
+		if err := checkDenylist(ctx, dl, denylist.Employer, claims.Id, claims.Version, &claims.RegisteredClaims); err != nil {
+			return nil, err
+		}
+		return handler(ctxmetadata.WithEmployerClaims(ctx, claims), req)
+	}
+}
+
+func checkDenylist(ctx context.Context, dl denylist.Denylist, subject denylist.Subject, userId int64, version int, rc *gojwt.RegisteredClaims) error {
+	if dl == nil {
+		return nil
+	}
+
+	var issuedAt time.Time
+	if rc.IssuedAt != nil {
+		issuedAt = rc.IssuedAt.Time
+	}
+
+	revoked, err := dl.IsRevoked(ctx, denylist.TokenInfo{
+		Subject:  subject,
+		UserId:   userId,
+		Jti:      rc.ID,
+		IssuedAt: issuedAt,
+		Version:  version,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "internal server error")
+	}
+	if revoked {
+		return status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
+	}
+	return nil
+}
+
 func extractBearerToken(ctx context.Context) (string, error) {
 	authHeader, err := ctxmetadata.GetAuthMetadataFromIncomingContext(ctx)
 	if err != nil || len(authHeader) == 0 {
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Access int
+
+const (
+	// AccessInternal methods are called by other services only.
+	AccessInternal Access = iota
+	// AccessEndUser methods are called by end users as well.
+	AccessEndUser
+)
+
+// MethodPolicy maps full method names to their access. Methods missing from
+// the policy are internal.
+type MethodPolicy map[string]Access
+
+func (p MethodPolicy) Access(method string) Access {
+	if a, ok := p[method]; ok {
+		return a
+	}
+	return AccessInternal
+}
+
+// ServiceAuthMiddleware verifies the service token of internal callers against keys.
+// Internal methods are rejected without a valid token, end-user methods are passed on
+// without one.
+func ServiceAuthMiddleware(keys *jwt.KeySet, audience string, policy MethodPolicy) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+
+		tokenStr, ok := ctxmetadata.GetServiceTokenFromIncomingContext(ctx)
+		if !ok {
+			if policy.Access(info.FullMethod) == AccessEndUser {
+				return handler(ctx, req)
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
+		}
+
+		claims, err := jwt.ParseServiceToken(tokenStr, keys, audience)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "%s", errUnathorized.Error())
+		}
+
+		ctx = ctxmetadata.WithServiceClaims(ctx, claims)
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,5278 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: auth_service/v1/auth_service.proto
+
+package authv1
+
+import (
+	v1 "github.com/ZaiiiRan/job_search_service/user-service/gen/go/user_service/v1"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserKind int32
+
+const (
+	UserKind_USER_KIND_UNSPECIFIED UserKind = 0
+	UserKind_USER_KIND_APPLICANT   UserKind = 1
+	UserKind_USER_KIND_EMPLOYER    UserKind = 2
+)
+
+// Enum value maps for UserKind.
+var (
+	UserKind_name = map[int32]string{
+		0: "USER_KIND_UNSPECIFIED",
+		1: "USER_KIND_APPLICANT",
+		2: "USER_KIND_EMPLOYER",
+	}
+	UserKind_value = map[string]int32{
+		"USER_KIND_UNSPECIFIED": 0,
+		"USER_KIND_APPLICANT":   1,
+		"USER_KIND_EMPLOYER":    2,
+	}
+)
+
+func (x UserKind) Enum() *UserKind {
+	p := new(UserKind)
+	*p = x
+	return p
+}
+
+func (x UserKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_service_v1_auth_service_proto_enumTypes[0].Descriptor()
+}
+
+func (UserKind) Type() protoreflect.EnumType {
+	return &file_auth_service_v1_auth_service_proto_enumTypes[0]
+}
+
+func (x UserKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserKind.Descriptor instead.
+func (UserKind) EnumDescriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{0}
+}
+
+type RegisterApplicantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterApplicantRequest) Reset() {
+	*x = RegisterApplicantRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterApplicantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterApplicantRequest) ProtoMessage() {}
+
+func (x *RegisterApplicantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterApplicantRequest.ProtoReflect.Descriptor instead.
+func (*RegisterApplicantRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterApplicantRequest) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+func (x *RegisterApplicantRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterApplicantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterApplicantResponse) Reset() {
+	*x = RegisterApplicantResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterApplicantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterApplicantResponse) ProtoMessage() {}
+
+func (x *RegisterApplicantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterApplicantResponse.ProtoReflect.Descriptor instead.
+func (*RegisterApplicantResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterApplicantResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type GetNewApplicantActivationCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewApplicantActivationCodeRequest) Reset() {
+	*x = GetNewApplicantActivationCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewApplicantActivationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewApplicantActivationCodeRequest) ProtoMessage() {}
+
+func (x *GetNewApplicantActivationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewApplicantActivationCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNewApplicantActivationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{2}
+}
+
+type GetNewApplicantActivationCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewApplicantActivationCodeResponse) Reset() {
+	*x = GetNewApplicantActivationCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewApplicantActivationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewApplicantActivationCodeResponse) ProtoMessage() {}
+
+func (x *GetNewApplicantActivationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewApplicantActivationCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNewApplicantActivationCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ActivateApplicantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateApplicantRequest) Reset() {
+	*x = ActivateApplicantRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateApplicantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateApplicantRequest) ProtoMessage() {}
+
+func (x *ActivateApplicantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateApplicantRequest.ProtoReflect.Descriptor instead.
+func (*ActivateApplicantRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivateApplicantRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ActivateApplicantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateApplicantResponse) Reset() {
+	*x = ActivateApplicantResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateApplicantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateApplicantResponse) ProtoMessage() {}
+
+func (x *ActivateApplicantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateApplicantResponse.ProtoReflect.Descriptor instead.
+func (*ActivateApplicantResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivateApplicantResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type LoginApplicantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginApplicantRequest) Reset() {
+	*x = LoginApplicantRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginApplicantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginApplicantRequest) ProtoMessage() {}
+
+func (x *LoginApplicantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginApplicantRequest.ProtoReflect.Descriptor instead.
+func (*LoginApplicantRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LoginApplicantRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginApplicantRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// When the applicant has enabled mfa, no tokens are issued: applicant is empty,
+// mfa_required is set and mfa_token has to be passed to VerifyApplicantMfa.
+type LoginApplicantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,2,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,3,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginApplicantResponse) Reset() {
+	*x = LoginApplicantResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginApplicantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginApplicantResponse) ProtoMessage() {}
+
+func (x *LoginApplicantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginApplicantResponse.ProtoReflect.Descriptor instead.
+func (*LoginApplicantResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoginApplicantResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+func (x *LoginApplicantResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginApplicantResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type RequestApplicantLoginCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestApplicantLoginCodeRequest) Reset() {
+	*x = RequestApplicantLoginCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestApplicantLoginCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestApplicantLoginCodeRequest) ProtoMessage() {}
+
+func (x *RequestApplicantLoginCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestApplicantLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*RequestApplicantLoginCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RequestApplicantLoginCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestApplicantLoginCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestApplicantLoginCodeResponse) Reset() {
+	*x = RequestApplicantLoginCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestApplicantLoginCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestApplicantLoginCodeResponse) ProtoMessage() {}
+
+func (x *RequestApplicantLoginCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestApplicantLoginCodeResponse.ProtoReflect.Descriptor instead.
+func (*RequestApplicantLoginCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{9}
+}
+
+type LoginApplicantWithCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginApplicantWithCodeRequest) Reset() {
+	*x = LoginApplicantWithCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginApplicantWithCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginApplicantWithCodeRequest) ProtoMessage() {}
+
+func (x *LoginApplicantWithCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginApplicantWithCodeRequest.ProtoReflect.Descriptor instead.
+func (*LoginApplicantWithCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LoginApplicantWithCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginApplicantWithCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Same as LoginApplicantResponse: with mfa enabled only the mfa token is returned.
+type LoginApplicantWithCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,2,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,3,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginApplicantWithCodeResponse) Reset() {
+	*x = LoginApplicantWithCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginApplicantWithCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginApplicantWithCodeResponse) ProtoMessage() {}
+
+func (x *LoginApplicantWithCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginApplicantWithCodeResponse.ProtoReflect.Descriptor instead.
+func (*LoginApplicantWithCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LoginApplicantWithCodeResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+func (x *LoginApplicantWithCodeResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginApplicantWithCodeResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type StartApplicantOidcLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartApplicantOidcLoginRequest) Reset() {
+	*x = StartApplicantOidcLoginRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartApplicantOidcLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartApplicantOidcLoginRequest) ProtoMessage() {}
+
+func (x *StartApplicantOidcLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartApplicantOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartApplicantOidcLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{12}
+}
+
+type StartApplicantOidcLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartApplicantOidcLoginResponse) Reset() {
+	*x = StartApplicantOidcLoginResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartApplicantOidcLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartApplicantOidcLoginResponse) ProtoMessage() {}
+
+func (x *StartApplicantOidcLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartApplicantOidcLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartApplicantOidcLoginResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StartApplicantOidcLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+type CompleteApplicantOidcLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteApplicantOidcLoginRequest) Reset() {
+	*x = CompleteApplicantOidcLoginRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteApplicantOidcLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteApplicantOidcLoginRequest) ProtoMessage() {}
+
+func (x *CompleteApplicantOidcLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteApplicantOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteApplicantOidcLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompleteApplicantOidcLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteApplicantOidcLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// Either the applicant is logged in (or mfa_token is set when mfa is enabled),
+// or registration_required is set and registration_token has to be passed to
+// RegisterApplicantWithOidc together with the missing profile fields.
+type CompleteApplicantOidcLoginResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Applicant            *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	MfaRequired          bool                   `protobuf:"varint,2,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken             string                 `protobuf:"bytes,3,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	RegistrationRequired bool                   `protobuf:"varint,4,opt,name=registration_required,json=registrationRequired,proto3" json:"registration_required,omitempty"`
+	RegistrationToken    string                 `protobuf:"bytes,5,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CompleteApplicantOidcLoginResponse) Reset() {
+	*x = CompleteApplicantOidcLoginResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteApplicantOidcLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteApplicantOidcLoginResponse) ProtoMessage() {}
+
+func (x *CompleteApplicantOidcLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteApplicantOidcLoginResponse.ProtoReflect.Descriptor instead.
+func (*CompleteApplicantOidcLoginResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompleteApplicantOidcLoginResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+func (x *CompleteApplicantOidcLoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *CompleteApplicantOidcLoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *CompleteApplicantOidcLoginResponse) GetRegistrationRequired() bool {
+	if x != nil {
+		return x.RegistrationRequired
+	}
+	return false
+}
+
+func (x *CompleteApplicantOidcLoginResponse) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+type RegisterApplicantWithOidcRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationToken string                 `protobuf:"bytes,1,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	// The email is taken from the identity provider account.
+	Applicant     *v1.Applicant `protobuf:"bytes,2,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterApplicantWithOidcRequest) Reset() {
+	*x = RegisterApplicantWithOidcRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterApplicantWithOidcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterApplicantWithOidcRequest) ProtoMessage() {}
+
+func (x *RegisterApplicantWithOidcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterApplicantWithOidcRequest.ProtoReflect.Descriptor instead.
+func (*RegisterApplicantWithOidcRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegisterApplicantWithOidcRequest) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+func (x *RegisterApplicantWithOidcRequest) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type RegisterApplicantWithOidcResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterApplicantWithOidcResponse) Reset() {
+	*x = RegisterApplicantWithOidcResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterApplicantWithOidcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterApplicantWithOidcResponse) ProtoMessage() {}
+
+func (x *RegisterApplicantWithOidcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterApplicantWithOidcResponse.ProtoReflect.Descriptor instead.
+func (*RegisterApplicantWithOidcResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegisterApplicantWithOidcResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type RefreshApplicantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshApplicantRequest) Reset() {
+	*x = RefreshApplicantRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshApplicantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshApplicantRequest) ProtoMessage() {}
+
+func (x *RefreshApplicantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshApplicantRequest.ProtoReflect.Descriptor instead.
+func (*RefreshApplicantRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{18}
+}
+
+type RefreshApplicantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshApplicantResponse) Reset() {
+	*x = RefreshApplicantResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshApplicantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshApplicantResponse) ProtoMessage() {}
+
+func (x *RefreshApplicantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshApplicantResponse.ProtoReflect.Descriptor instead.
+func (*RefreshApplicantResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{19}
+}
+
+type LogoutApplicantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutApplicantRequest) Reset() {
+	*x = LogoutApplicantRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutApplicantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutApplicantRequest) ProtoMessage() {}
+
+func (x *LogoutApplicantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutApplicantRequest.ProtoReflect.Descriptor instead.
+func (*LogoutApplicantRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{20}
+}
+
+type LogoutApplicantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutApplicantResponse) Reset() {
+	*x = LogoutApplicantResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutApplicantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutApplicantResponse) ProtoMessage() {}
+
+func (x *LogoutApplicantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutApplicantResponse.ProtoReflect.Descriptor instead.
+func (*LogoutApplicantResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{21}
+}
+
+type GetResetApplicantPasswordCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResetApplicantPasswordCodeRequest) Reset() {
+	*x = GetResetApplicantPasswordCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResetApplicantPasswordCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResetApplicantPasswordCodeRequest) ProtoMessage() {}
+
+func (x *GetResetApplicantPasswordCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResetApplicantPasswordCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetResetApplicantPasswordCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetResetApplicantPasswordCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetResetApplicantPasswordCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResetApplicantPasswordCodeResponse) Reset() {
+	*x = GetResetApplicantPasswordCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResetApplicantPasswordCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResetApplicantPasswordCodeResponse) ProtoMessage() {}
+
+func (x *GetResetApplicantPasswordCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResetApplicantPasswordCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetResetApplicantPasswordCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{23}
+}
+
+type ResetApplicantPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetApplicantPasswordRequest) Reset() {
+	*x = ResetApplicantPasswordRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetApplicantPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetApplicantPasswordRequest) ProtoMessage() {}
+
+func (x *ResetApplicantPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetApplicantPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetApplicantPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResetApplicantPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ResetApplicantPasswordRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResetApplicantPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetApplicantPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetApplicantPasswordResponse) Reset() {
+	*x = ResetApplicantPasswordResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetApplicantPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetApplicantPasswordResponse) ProtoMessage() {}
+
+func (x *ResetApplicantPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetApplicantPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetApplicantPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResetApplicantPasswordResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type ChangeApplicantPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeApplicantPasswordRequest) Reset() {
+	*x = ChangeApplicantPasswordRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeApplicantPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeApplicantPasswordRequest) ProtoMessage() {}
+
+func (x *ChangeApplicantPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeApplicantPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeApplicantPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeApplicantPasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangeApplicantPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangeApplicantPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeApplicantPasswordResponse) Reset() {
+	*x = ChangeApplicantPasswordResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeApplicantPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeApplicantPasswordResponse) ProtoMessage() {}
+
+func (x *ChangeApplicantPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeApplicantPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangeApplicantPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{27}
+}
+
+type ListApplicantSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicantSessionsRequest) Reset() {
+	*x = ListApplicantSessionsRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicantSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicantSessionsRequest) ProtoMessage() {}
+
+func (x *ListApplicantSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicantSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicantSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{28}
+}
+
+type ListApplicantSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicantSessionsResponse) Reset() {
+	*x = ListApplicantSessionsResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicantSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicantSessionsResponse) ProtoMessage() {}
+
+func (x *ListApplicantSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicantSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicantSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListApplicantSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeApplicantSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int64                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApplicantSessionRequest) Reset() {
+	*x = RevokeApplicantSessionRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApplicantSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApplicantSessionRequest) ProtoMessage() {}
+
+func (x *RevokeApplicantSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApplicantSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApplicantSessionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeApplicantSessionRequest) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type RevokeApplicantSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApplicantSessionResponse) Reset() {
+	*x = RevokeApplicantSessionResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApplicantSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApplicantSessionResponse) ProtoMessage() {}
+
+func (x *RevokeApplicantSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApplicantSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApplicantSessionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{31}
+}
+
+type RevokeOtherApplicantSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherApplicantSessionsRequest) Reset() {
+	*x = RevokeOtherApplicantSessionsRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherApplicantSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherApplicantSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherApplicantSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherApplicantSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherApplicantSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{32}
+}
+
+type RevokeOtherApplicantSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherApplicantSessionsResponse) Reset() {
+	*x = RevokeOtherApplicantSessionsResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherApplicantSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherApplicantSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeOtherApplicantSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherApplicantSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherApplicantSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{33}
+}
+
+type EnrollApplicantMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollApplicantMfaRequest) Reset() {
+	*x = EnrollApplicantMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollApplicantMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollApplicantMfaRequest) ProtoMessage() {}
+
+func (x *EnrollApplicantMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollApplicantMfaRequest.ProtoReflect.Descriptor instead.
+func (*EnrollApplicantMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{34}
+}
+
+type EnrollApplicantMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollApplicantMfaResponse) Reset() {
+	*x = EnrollApplicantMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollApplicantMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollApplicantMfaResponse) ProtoMessage() {}
+
+func (x *EnrollApplicantMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollApplicantMfaResponse.ProtoReflect.Descriptor instead.
+func (*EnrollApplicantMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EnrollApplicantMfaResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollApplicantMfaResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmApplicantMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmApplicantMfaRequest) Reset() {
+	*x = ConfirmApplicantMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmApplicantMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmApplicantMfaRequest) ProtoMessage() {}
+
+func (x *ConfirmApplicantMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmApplicantMfaRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmApplicantMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConfirmApplicantMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmApplicantMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmApplicantMfaResponse) Reset() {
+	*x = ConfirmApplicantMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmApplicantMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmApplicantMfaResponse) ProtoMessage() {}
+
+func (x *ConfirmApplicantMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmApplicantMfaResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmApplicantMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ConfirmApplicantMfaResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableApplicantMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableApplicantMfaRequest) Reset() {
+	*x = DisableApplicantMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableApplicantMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableApplicantMfaRequest) ProtoMessage() {}
+
+func (x *DisableApplicantMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableApplicantMfaRequest.ProtoReflect.Descriptor instead.
+func (*DisableApplicantMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DisableApplicantMfaRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DisableApplicantMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableApplicantMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableApplicantMfaResponse) Reset() {
+	*x = DisableApplicantMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableApplicantMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableApplicantMfaResponse) ProtoMessage() {}
+
+func (x *DisableApplicantMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableApplicantMfaResponse.ProtoReflect.Descriptor instead.
+func (*DisableApplicantMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{39}
+}
+
+type VerifyApplicantMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyApplicantMfaRequest) Reset() {
+	*x = VerifyApplicantMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyApplicantMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyApplicantMfaRequest) ProtoMessage() {}
+
+func (x *VerifyApplicantMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyApplicantMfaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyApplicantMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifyApplicantMfaRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyApplicantMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyApplicantMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyApplicantMfaResponse) Reset() {
+	*x = VerifyApplicantMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyApplicantMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyApplicantMfaResponse) ProtoMessage() {}
+
+func (x *VerifyApplicantMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyApplicantMfaResponse.ProtoReflect.Descriptor instead.
+func (*VerifyApplicantMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VerifyApplicantMfaResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type RequestApplicantEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestApplicantEmailChangeRequest) Reset() {
+	*x = RequestApplicantEmailChangeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestApplicantEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestApplicantEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestApplicantEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestApplicantEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestApplicantEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RequestApplicantEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestApplicantEmailChangeRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RequestApplicantEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestApplicantEmailChangeResponse) Reset() {
+	*x = RequestApplicantEmailChangeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestApplicantEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestApplicantEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestApplicantEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestApplicantEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestApplicantEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{43}
+}
+
+type ConfirmApplicantEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmApplicantEmailChangeRequest) Reset() {
+	*x = ConfirmApplicantEmailChangeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmApplicantEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmApplicantEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmApplicantEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmApplicantEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmApplicantEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ConfirmApplicantEmailChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmApplicantEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmApplicantEmailChangeResponse) Reset() {
+	*x = ConfirmApplicantEmailChangeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmApplicantEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmApplicantEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmApplicantEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmApplicantEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmApplicantEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ConfirmApplicantEmailChangeResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type DeleteApplicantAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicantAccountRequest) Reset() {
+	*x = DeleteApplicantAccountRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicantAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicantAccountRequest) ProtoMessage() {}
+
+func (x *DeleteApplicantAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicantAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicantAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteApplicantAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteApplicantAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicantAccountResponse) Reset() {
+	*x = DeleteApplicantAccountResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicantAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicantAccountResponse) ProtoMessage() {}
+
+func (x *DeleteApplicantAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicantAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteApplicantAccountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{47}
+}
+
+type RestoreApplicantAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreApplicantAccountRequest) Reset() {
+	*x = RestoreApplicantAccountRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreApplicantAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreApplicantAccountRequest) ProtoMessage() {}
+
+func (x *RestoreApplicantAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreApplicantAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreApplicantAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RestoreApplicantAccountRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RestoreApplicantAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RestoreApplicantAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applicant     *v1.Applicant          `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreApplicantAccountResponse) Reset() {
+	*x = RestoreApplicantAccountResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreApplicantAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreApplicantAccountResponse) ProtoMessage() {}
+
+func (x *RestoreApplicantAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreApplicantAccountResponse.ProtoReflect.Descriptor instead.
+func (*RestoreApplicantAccountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RestoreApplicantAccountResponse) GetApplicant() *v1.Applicant {
+	if x != nil {
+		return x.Applicant
+	}
+	return nil
+}
+
+type BumpApplicantSecurityVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicantId   int64                  `protobuf:"varint,1,opt,name=applicant_id,json=applicantId,proto3" json:"applicant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BumpApplicantSecurityVersionRequest) Reset() {
+	*x = BumpApplicantSecurityVersionRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BumpApplicantSecurityVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpApplicantSecurityVersionRequest) ProtoMessage() {}
+
+func (x *BumpApplicantSecurityVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpApplicantSecurityVersionRequest.ProtoReflect.Descriptor instead.
+func (*BumpApplicantSecurityVersionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BumpApplicantSecurityVersionRequest) GetApplicantId() int64 {
+	if x != nil {
+		return x.ApplicantId
+	}
+	return 0
+}
+
+type BumpApplicantSecurityVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BumpApplicantSecurityVersionResponse) Reset() {
+	*x = BumpApplicantSecurityVersionResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BumpApplicantSecurityVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpApplicantSecurityVersionResponse) ProtoMessage() {}
+
+func (x *BumpApplicantSecurityVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpApplicantSecurityVersionResponse.ProtoReflect.Descriptor instead.
+func (*BumpApplicantSecurityVersionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BumpApplicantSecurityVersionResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RegisterEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterEmployerRequest) Reset() {
+	*x = RegisterEmployerRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterEmployerRequest) ProtoMessage() {}
+
+func (x *RegisterEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterEmployerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RegisterEmployerRequest) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+func (x *RegisterEmployerRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterEmployerResponse) Reset() {
+	*x = RegisterEmployerResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterEmployerResponse) ProtoMessage() {}
+
+func (x *RegisterEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterEmployerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RegisterEmployerResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type GetNewEmployerActivationCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewEmployerActivationCodeRequest) Reset() {
+	*x = GetNewEmployerActivationCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewEmployerActivationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewEmployerActivationCodeRequest) ProtoMessage() {}
+
+func (x *GetNewEmployerActivationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewEmployerActivationCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNewEmployerActivationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{54}
+}
+
+type GetNewEmployerActivationCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewEmployerActivationCodeResponse) Reset() {
+	*x = GetNewEmployerActivationCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewEmployerActivationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewEmployerActivationCodeResponse) ProtoMessage() {}
+
+func (x *GetNewEmployerActivationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewEmployerActivationCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNewEmployerActivationCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{55}
+}
+
+type ActivateEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateEmployerRequest) Reset() {
+	*x = ActivateEmployerRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEmployerRequest) ProtoMessage() {}
+
+func (x *ActivateEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEmployerRequest.ProtoReflect.Descriptor instead.
+func (*ActivateEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ActivateEmployerRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ActivateEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateEmployerResponse) Reset() {
+	*x = ActivateEmployerResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEmployerResponse) ProtoMessage() {}
+
+func (x *ActivateEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEmployerResponse.ProtoReflect.Descriptor instead.
+func (*ActivateEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ActivateEmployerResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type LoginEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginEmployerRequest) Reset() {
+	*x = LoginEmployerRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEmployerRequest) ProtoMessage() {}
+
+func (x *LoginEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEmployerRequest.ProtoReflect.Descriptor instead.
+func (*LoginEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LoginEmployerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginEmployerRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// When the employer has enabled mfa, no tokens are issued: employer is empty,
+// mfa_required is set and mfa_token has to be passed to VerifyEmployerMfa.
+type LoginEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,2,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,3,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginEmployerResponse) Reset() {
+	*x = LoginEmployerResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEmployerResponse) ProtoMessage() {}
+
+func (x *LoginEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEmployerResponse.ProtoReflect.Descriptor instead.
+func (*LoginEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LoginEmployerResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+func (x *LoginEmployerResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginEmployerResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type RequestEmployerLoginCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmployerLoginCodeRequest) Reset() {
+	*x = RequestEmployerLoginCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmployerLoginCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmployerLoginCodeRequest) ProtoMessage() {}
+
+func (x *RequestEmployerLoginCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmployerLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmployerLoginCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RequestEmployerLoginCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestEmployerLoginCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmployerLoginCodeResponse) Reset() {
+	*x = RequestEmployerLoginCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmployerLoginCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmployerLoginCodeResponse) ProtoMessage() {}
+
+func (x *RequestEmployerLoginCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmployerLoginCodeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmployerLoginCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{61}
+}
+
+type LoginEmployerWithCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginEmployerWithCodeRequest) Reset() {
+	*x = LoginEmployerWithCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginEmployerWithCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEmployerWithCodeRequest) ProtoMessage() {}
+
+func (x *LoginEmployerWithCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEmployerWithCodeRequest.ProtoReflect.Descriptor instead.
+func (*LoginEmployerWithCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LoginEmployerWithCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginEmployerWithCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Same as LoginEmployerResponse: with mfa enabled only the mfa token is returned.
+type LoginEmployerWithCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,2,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,3,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginEmployerWithCodeResponse) Reset() {
+	*x = LoginEmployerWithCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginEmployerWithCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEmployerWithCodeResponse) ProtoMessage() {}
+
+func (x *LoginEmployerWithCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEmployerWithCodeResponse.ProtoReflect.Descriptor instead.
+func (*LoginEmployerWithCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LoginEmployerWithCodeResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+func (x *LoginEmployerWithCodeResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginEmployerWithCodeResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type RefreshEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshEmployerRequest) Reset() {
+	*x = RefreshEmployerRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshEmployerRequest) ProtoMessage() {}
+
+func (x *RefreshEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshEmployerRequest.ProtoReflect.Descriptor instead.
+func (*RefreshEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{64}
+}
+
+type RefreshEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshEmployerResponse) Reset() {
+	*x = RefreshEmployerResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshEmployerResponse) ProtoMessage() {}
+
+func (x *RefreshEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshEmployerResponse.ProtoReflect.Descriptor instead.
+func (*RefreshEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{65}
+}
+
+type LogoutEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutEmployerRequest) Reset() {
+	*x = LogoutEmployerRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutEmployerRequest) ProtoMessage() {}
+
+func (x *LogoutEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutEmployerRequest.ProtoReflect.Descriptor instead.
+func (*LogoutEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{66}
+}
+
+type LogoutEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutEmployerResponse) Reset() {
+	*x = LogoutEmployerResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutEmployerResponse) ProtoMessage() {}
+
+func (x *LogoutEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutEmployerResponse.ProtoReflect.Descriptor instead.
+func (*LogoutEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{67}
+}
+
+type GetResetEmployerPasswordCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResetEmployerPasswordCodeRequest) Reset() {
+	*x = GetResetEmployerPasswordCodeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResetEmployerPasswordCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResetEmployerPasswordCodeRequest) ProtoMessage() {}
+
+func (x *GetResetEmployerPasswordCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResetEmployerPasswordCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetResetEmployerPasswordCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetResetEmployerPasswordCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetResetEmployerPasswordCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResetEmployerPasswordCodeResponse) Reset() {
+	*x = GetResetEmployerPasswordCodeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResetEmployerPasswordCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResetEmployerPasswordCodeResponse) ProtoMessage() {}
+
+func (x *GetResetEmployerPasswordCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResetEmployerPasswordCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetResetEmployerPasswordCodeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{69}
+}
+
+type ResetEmployerPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetEmployerPasswordRequest) Reset() {
+	*x = ResetEmployerPasswordRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetEmployerPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetEmployerPasswordRequest) ProtoMessage() {}
+
+func (x *ResetEmployerPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetEmployerPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetEmployerPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ResetEmployerPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ResetEmployerPasswordRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResetEmployerPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetEmployerPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetEmployerPasswordResponse) Reset() {
+	*x = ResetEmployerPasswordResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetEmployerPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetEmployerPasswordResponse) ProtoMessage() {}
+
+func (x *ResetEmployerPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetEmployerPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetEmployerPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ResetEmployerPasswordResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type ChangeEmployerPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEmployerPasswordRequest) Reset() {
+	*x = ChangeEmployerPasswordRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmployerPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmployerPasswordRequest) ProtoMessage() {}
+
+func (x *ChangeEmployerPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmployerPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeEmployerPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ChangeEmployerPasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangeEmployerPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangeEmployerPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEmployerPasswordResponse) Reset() {
+	*x = ChangeEmployerPasswordResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmployerPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmployerPasswordResponse) ProtoMessage() {}
+
+func (x *ChangeEmployerPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmployerPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangeEmployerPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{73}
+}
+
+type ListEmployerSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployerSessionsRequest) Reset() {
+	*x = ListEmployerSessionsRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployerSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployerSessionsRequest) ProtoMessage() {}
+
+func (x *ListEmployerSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployerSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployerSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{74}
+}
+
+type ListEmployerSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployerSessionsResponse) Reset() {
+	*x = ListEmployerSessionsResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployerSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployerSessionsResponse) ProtoMessage() {}
+
+func (x *ListEmployerSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployerSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployerSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListEmployerSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeEmployerSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int64                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeEmployerSessionRequest) Reset() {
+	*x = RevokeEmployerSessionRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeEmployerSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeEmployerSessionRequest) ProtoMessage() {}
+
+func (x *RevokeEmployerSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeEmployerSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeEmployerSessionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RevokeEmployerSessionRequest) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type RevokeEmployerSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeEmployerSessionResponse) Reset() {
+	*x = RevokeEmployerSessionResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeEmployerSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeEmployerSessionResponse) ProtoMessage() {}
+
+func (x *RevokeEmployerSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeEmployerSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeEmployerSessionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{77}
+}
+
+type RevokeOtherEmployerSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherEmployerSessionsRequest) Reset() {
+	*x = RevokeOtherEmployerSessionsRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherEmployerSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherEmployerSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherEmployerSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherEmployerSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherEmployerSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{78}
+}
+
+type RevokeOtherEmployerSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherEmployerSessionsResponse) Reset() {
+	*x = RevokeOtherEmployerSessionsResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherEmployerSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherEmployerSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeOtherEmployerSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherEmployerSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherEmployerSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{79}
+}
+
+type EnrollEmployerMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollEmployerMfaRequest) Reset() {
+	*x = EnrollEmployerMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollEmployerMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollEmployerMfaRequest) ProtoMessage() {}
+
+func (x *EnrollEmployerMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollEmployerMfaRequest.ProtoReflect.Descriptor instead.
+func (*EnrollEmployerMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{80}
+}
+
+type EnrollEmployerMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollEmployerMfaResponse) Reset() {
+	*x = EnrollEmployerMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollEmployerMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollEmployerMfaResponse) ProtoMessage() {}
+
+func (x *EnrollEmployerMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollEmployerMfaResponse.ProtoReflect.Descriptor instead.
+func (*EnrollEmployerMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *EnrollEmployerMfaResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollEmployerMfaResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmEmployerMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmployerMfaRequest) Reset() {
+	*x = ConfirmEmployerMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmployerMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmployerMfaRequest) ProtoMessage() {}
+
+func (x *ConfirmEmployerMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmployerMfaRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmployerMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ConfirmEmployerMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmEmployerMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmployerMfaResponse) Reset() {
+	*x = ConfirmEmployerMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmployerMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmployerMfaResponse) ProtoMessage() {}
+
+func (x *ConfirmEmployerMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmployerMfaResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmployerMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ConfirmEmployerMfaResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableEmployerMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableEmployerMfaRequest) Reset() {
+	*x = DisableEmployerMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableEmployerMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableEmployerMfaRequest) ProtoMessage() {}
+
+func (x *DisableEmployerMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableEmployerMfaRequest.ProtoReflect.Descriptor instead.
+func (*DisableEmployerMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DisableEmployerMfaRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DisableEmployerMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableEmployerMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableEmployerMfaResponse) Reset() {
+	*x = DisableEmployerMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableEmployerMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableEmployerMfaResponse) ProtoMessage() {}
+
+func (x *DisableEmployerMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableEmployerMfaResponse.ProtoReflect.Descriptor instead.
+func (*DisableEmployerMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{85}
+}
+
+type VerifyEmployerMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmployerMfaRequest) Reset() {
+	*x = VerifyEmployerMfaRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmployerMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmployerMfaRequest) ProtoMessage() {}
+
+func (x *VerifyEmployerMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmployerMfaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmployerMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *VerifyEmployerMfaRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyEmployerMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyEmployerMfaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmployerMfaResponse) Reset() {
+	*x = VerifyEmployerMfaResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmployerMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmployerMfaResponse) ProtoMessage() {}
+
+func (x *VerifyEmployerMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmployerMfaResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmployerMfaResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *VerifyEmployerMfaResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type RequestEmployerEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmployerEmailChangeRequest) Reset() {
+	*x = RequestEmployerEmailChangeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmployerEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmployerEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmployerEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmployerEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmployerEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RequestEmployerEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestEmployerEmailChangeRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RequestEmployerEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmployerEmailChangeResponse) Reset() {
+	*x = RequestEmployerEmailChangeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmployerEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmployerEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmployerEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmployerEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmployerEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{89}
+}
+
+type ConfirmEmployerEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmployerEmailChangeRequest) Reset() {
+	*x = ConfirmEmployerEmailChangeRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmployerEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmployerEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmployerEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmployerEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmployerEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ConfirmEmployerEmailChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmEmployerEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmployerEmailChangeResponse) Reset() {
+	*x = ConfirmEmployerEmailChangeResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmployerEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmployerEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmployerEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmployerEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmployerEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ConfirmEmployerEmailChangeResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type DeleteEmployerAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployerAccountRequest) Reset() {
+	*x = DeleteEmployerAccountRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployerAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployerAccountRequest) ProtoMessage() {}
+
+func (x *DeleteEmployerAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployerAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployerAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteEmployerAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteEmployerAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployerAccountResponse) Reset() {
+	*x = DeleteEmployerAccountResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployerAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployerAccountResponse) ProtoMessage() {}
+
+func (x *DeleteEmployerAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployerAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmployerAccountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{93}
+}
+
+type RestoreEmployerAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployerAccountRequest) Reset() {
+	*x = RestoreEmployerAccountRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployerAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployerAccountRequest) ProtoMessage() {}
+
+func (x *RestoreEmployerAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployerAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEmployerAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RestoreEmployerAccountRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RestoreEmployerAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RestoreEmployerAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *v1.Employer           `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployerAccountResponse) Reset() {
+	*x = RestoreEmployerAccountResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployerAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployerAccountResponse) ProtoMessage() {}
+
+func (x *RestoreEmployerAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployerAccountResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEmployerAccountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RestoreEmployerAccountResponse) GetEmployer() *v1.Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type BumpEmployerSecurityVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    int64                  `protobuf:"varint,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BumpEmployerSecurityVersionRequest) Reset() {
+	*x = BumpEmployerSecurityVersionRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BumpEmployerSecurityVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpEmployerSecurityVersionRequest) ProtoMessage() {}
+
+func (x *BumpEmployerSecurityVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpEmployerSecurityVersionRequest.ProtoReflect.Descriptor instead.
+func (*BumpEmployerSecurityVersionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *BumpEmployerSecurityVersionRequest) GetEmployerId() int64 {
+	if x != nil {
+		return x.EmployerId
+	}
+	return 0
+}
+
+type BumpEmployerSecurityVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BumpEmployerSecurityVersionResponse) Reset() {
+	*x = BumpEmployerSecurityVersionResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BumpEmployerSecurityVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpEmployerSecurityVersionResponse) ProtoMessage() {}
+
+func (x *BumpEmployerSecurityVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpEmployerSecurityVersionResponse.ProtoReflect.Descriptor instead.
+func (*BumpEmployerSecurityVersionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *BumpEmployerSecurityVersionResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type IntrospectTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenRequest) Reset() {
+	*x = IntrospectTokenRequest{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenRequest) ProtoMessage() {}
+
+func (x *IntrospectTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *IntrospectTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Only active is set for an inactive token.
+type IntrospectTokenResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Active bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// id of the user the token was issued to
+	Subject  string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	UserKind UserKind `protobuf:"varint,3,opt,name=user_kind,json=userKind,proto3,enum=auth_service.v1.UserKind" json:"user_kind,omitempty"`
+	// the user kind, plus "activated" once the account is activated
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenResponse) Reset() {
+	*x = IntrospectTokenResponse{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenResponse) ProtoMessage() {}
+
+func (x *IntrospectTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *IntrospectTokenResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *IntrospectTokenResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetUserKind() UserKind {
+	if x != nil {
+		return x.UserKind
+	}
+	return UserKind_USER_KIND_UNSPECIFIED
+}
+
+func (x *IntrospectTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *IntrospectTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *IntrospectTokenResponse) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	Current       bool                   `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Session) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_auth_service_v1_auth_service_proto protoreflect.FileDescriptor
+
+const file_auth_service_v1_auth_service_proto_rawDesc = "" +
+	"\n" +
+	"\"auth_service/v1/auth_service.proto\x12\x0fauth_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\"user_service/v1/user_service.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"p\n" +
+	"\x18RegisterApplicantRequest\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"U\n" +
+	"\x19RegisterApplicantResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"&\n" +
+	"$GetNewApplicantActivationCodeRequest\"'\n" +
+	"%GetNewApplicantActivationCodeResponse\".\n" +
+	"\x18ActivateApplicantRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"U\n" +
+	"\x19ActivateApplicantResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"I\n" +
+	"\x15LoginApplicantRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x92\x01\n" +
+	"\x16LoginApplicantResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\x12!\n" +
+	"\fmfa_required\x18\x02 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x03 \x01(\tR\bmfaToken\"8\n" +
+	" RequestApplicantLoginCodeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"#\n" +
+	"!RequestApplicantLoginCodeResponse\"I\n" +
+	"\x1dLoginApplicantWithCodeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x9a\x01\n" +
+	"\x1eLoginApplicantWithCodeResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\x12!\n" +
+	"\fmfa_required\x18\x02 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x03 \x01(\tR\bmfaToken\" \n" +
+	"\x1eStartApplicantOidcLoginRequest\"N\n" +
+	"\x1fStartApplicantOidcLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"M\n" +
+	"!CompleteApplicantOidcLoginRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x82\x02\n" +
+	"\"CompleteApplicantOidcLoginResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\x12!\n" +
+	"\fmfa_required\x18\x02 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x03 \x01(\tR\bmfaToken\x123\n" +
+	"\x15registration_required\x18\x04 \x01(\bR\x14registrationRequired\x12-\n" +
+	"\x12registration_token\x18\x05 \x01(\tR\x11registrationToken\"\x8b\x01\n" +
+	" RegisterApplicantWithOidcRequest\x12-\n" +
+	"\x12registration_token\x18\x01 \x01(\tR\x11registrationToken\x128\n" +
+	"\tapplicant\x18\x02 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"]\n" +
+	"!RegisterApplicantWithOidcResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"\x19\n" +
+	"\x17RefreshApplicantRequest\"\x1a\n" +
+	"\x18RefreshApplicantResponse\"\x18\n" +
+	"\x16LogoutApplicantRequest\"\x19\n" +
+	"\x17LogoutApplicantResponse\"<\n" +
+	"$GetResetApplicantPasswordCodeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"'\n" +
+	"%GetResetApplicantPasswordCodeResponse\"l\n" +
+	"\x1dResetApplicantPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"Z\n" +
+	"\x1eResetApplicantPasswordResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"f\n" +
+	"\x1eChangeApplicantPasswordRequest\x12!\n" +
+	"\fold_password\x18\x01 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"!\n" +
+	"\x1fChangeApplicantPasswordResponse\"\x1e\n" +
+	"\x1cListApplicantSessionsRequest\"U\n" +
+	"\x1dListApplicantSessionsResponse\x124\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.auth_service.v1.SessionR\bsessions\"O\n" +
+	"\x1dRevokeApplicantSessionRequest\x12.\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\tsessionId\" \n" +
+	"\x1eRevokeApplicantSessionResponse\"%\n" +
+	"#RevokeOtherApplicantSessionsRequest\"&\n" +
+	"$RevokeOtherApplicantSessionsResponse\"\x1b\n" +
+	"\x19EnrollApplicantMfaRequest\"U\n" +
+	"\x1aEnrollApplicantMfaResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"0\n" +
+	"\x1aConfirmApplicantMfaRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"D\n" +
+	"\x1bConfirmApplicantMfaResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"L\n" +
+	"\x1aDisableApplicantMfaRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x1d\n" +
+	"\x1bDisableApplicantMfaResponse\"L\n" +
+	"\x19VerifyApplicantMfaRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"V\n" +
+	"\x1aVerifyApplicantMfaResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"]\n" +
+	"\"RequestApplicantEmailChangeRequest\x12\x1b\n" +
+	"\tnew_email\x18\x01 \x01(\tR\bnewEmail\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"%\n" +
+	"#RequestApplicantEmailChangeResponse\"8\n" +
+	"\"ConfirmApplicantEmailChangeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"_\n" +
+	"#ConfirmApplicantEmailChangeResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\";\n" +
+	"\x1dDeleteApplicantAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\" \n" +
+	"\x1eDeleteApplicantAccountResponse\"R\n" +
+	"\x1eRestoreApplicantAccountRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"[\n" +
+	"\x1fRestoreApplicantAccountResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"H\n" +
+	"#BumpApplicantSecurityVersionRequest\x12!\n" +
+	"\fapplicant_id\x18\x01 \x01(\x03R\vapplicantId\"@\n" +
+	"$BumpApplicantSecurityVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\"l\n" +
+	"\x17RegisterEmployerRequest\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"Q\n" +
+	"\x18RegisterEmployerResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"%\n" +
+	"#GetNewEmployerActivationCodeRequest\"&\n" +
+	"$GetNewEmployerActivationCodeResponse\"-\n" +
+	"\x17ActivateEmployerRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"Q\n" +
+	"\x18ActivateEmployerResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"H\n" +
+	"\x14LoginEmployerRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x8e\x01\n" +
+	"\x15LoginEmployerResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\x12!\n" +
+	"\fmfa_required\x18\x02 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x03 \x01(\tR\bmfaToken\"7\n" +
+	"\x1fRequestEmployerLoginCodeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\"\n" +
+	" RequestEmployerLoginCodeResponse\"H\n" +
+	"\x1cLoginEmployerWithCodeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x96\x01\n" +
+	"\x1dLoginEmployerWithCodeResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\x12!\n" +
+	"\fmfa_required\x18\x02 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x03 \x01(\tR\bmfaToken\"\x18\n" +
+	"\x16RefreshEmployerRequest\"\x19\n" +
+	"\x17RefreshEmployerResponse\"\x17\n" +
+	"\x15LogoutEmployerRequest\"\x18\n" +
+	"\x16LogoutEmployerResponse\";\n" +
+	"#GetResetEmployerPasswordCodeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"&\n" +
+	"$GetResetEmployerPasswordCodeResponse\"k\n" +
+	"\x1cResetEmployerPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"V\n" +
+	"\x1dResetEmployerPasswordResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"e\n" +
+	"\x1dChangeEmployerPasswordRequest\x12!\n" +
+	"\fold_password\x18\x01 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\" \n" +
+	"\x1eChangeEmployerPasswordResponse\"\x1d\n" +
+	"\x1bListEmployerSessionsRequest\"T\n" +
+	"\x1cListEmployerSessionsResponse\x124\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.auth_service.v1.SessionR\bsessions\"N\n" +
+	"\x1cRevokeEmployerSessionRequest\x12.\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\tsessionId\"\x1f\n" +
+	"\x1dRevokeEmployerSessionResponse\"$\n" +
+	"\"RevokeOtherEmployerSessionsRequest\"%\n" +
+	"#RevokeOtherEmployerSessionsResponse\"\x1a\n" +
+	"\x18EnrollEmployerMfaRequest\"T\n" +
+	"\x19EnrollEmployerMfaResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"/\n" +
+	"\x19ConfirmEmployerMfaRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"C\n" +
+	"\x1aConfirmEmployerMfaResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"K\n" +
+	"\x19DisableEmployerMfaRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x1c\n" +
+	"\x1aDisableEmployerMfaResponse\"K\n" +
+	"\x18VerifyEmployerMfaRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"R\n" +
+	"\x19VerifyEmployerMfaResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"\\\n" +
+	"!RequestEmployerEmailChangeRequest\x12\x1b\n" +
+	"\tnew_email\x18\x01 \x01(\tR\bnewEmail\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"$\n" +
+	"\"RequestEmployerEmailChangeResponse\"7\n" +
+	"!ConfirmEmployerEmailChangeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"[\n" +
+	"\"ConfirmEmployerEmailChangeResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\":\n" +
+	"\x1cDeleteEmployerAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"\x1f\n" +
+	"\x1dDeleteEmployerAccountResponse\"Q\n" +
+	"\x1dRestoreEmployerAccountRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"W\n" +
+	"\x1eRestoreEmployerAccountResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"E\n" +
+	"\"BumpEmployerSecurityVersionRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\x03R\n" +
+	"employerId\"?\n" +
+	"#BumpEmployerSecurityVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\".\n" +
+	"\x16IntrospectTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x8f\x02\n" +
+	"\x17IntrospectTokenResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x126\n" +
+	"\tuser_kind\x18\x03 \x01(\x0e2\x19.auth_service.v1.UserKindR\buserKind\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x127\n" +
+	"\tissued_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\"\xbf\x02\n" +
+	"\aSession\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x18\n" +
+	"\acurrent\x18\x05 \x01(\bR\acurrent\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*V\n" +
+	"\bUserKind\x12\x19\n" +
+	"\x15USER_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13USER_KIND_APPLICANT\x10\x01\x12\x16\n" +
+	"\x12USER_KIND_EMPLOYER\x10\x022\x89f\n" +
+	"\vAuthService\x12\xce\x01\n" +
+	"\x11RegisterApplicant\x12).auth_service.v1.RegisterApplicantRequest\x1a*.auth_service.v1.RegisterApplicantResponse\"b\x92A:\n" +
+	"\n" +
+	"applicants\x12\x17Register applicant user\x1a\x13Registers applicant\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/applicant/register\x12\x97\x02\n" +
+	"\x1dGetNewApplicantActivationCode\x125.auth_service.v1.GetNewApplicantActivationCodeRequest\x1a6.auth_service.v1.GetNewApplicantActivationCodeResponse\"\x86\x01\x92AV\n" +
+	"\n" +
+	"applicants\x12!Get new applicant activation code\x1a%Sending a new account activation code\x82\xd3\xe4\x93\x02'\x12%/api/v1/applicant/new-activation-code\x12\xde\x01\n" +
+	"\x11ActivateApplicant\x12).auth_service.v1.ActivateApplicantRequest\x1a*.auth_service.v1.ActivateApplicantResponse\"r\x92AJ\n" +
+	"\n" +
+	"applicants\x12\x19Activate applicant accout\x1a!Activates a new applicant account\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/applicant/activate\x12\xbe\x01\n" +
+	"\x0eLoginApplicant\x12&.auth_service.v1.LoginApplicantRequest\x1a'.auth_service.v1.LoginApplicantResponse\"[\x92A6\n" +
+	"\n" +
+	"applicants\x12\x0fLogin applicant\x1a\x17Applicant authorization\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/applicant/login\x12\x8d\x02\n" +
+	"\x19RequestApplicantLoginCode\x121.auth_service.v1.RequestApplicantLoginCodeRequest\x1a2.auth_service.v1.RequestApplicantLoginCodeResponse\"\x88\x01\x92A^\n" +
+	"\n" +
+	"applicants\x12\x1cRequest applicant login code\x1a2Sends a one-time login code to the applicant email\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/applicant/login-code\x12\x85\x02\n" +
+	"\x16LoginApplicantWithCode\x12..auth_service.v1.LoginApplicantWithCodeRequest\x1a/.auth_service.v1.LoginApplicantWithCodeResponse\"\x89\x01\x92AY\n" +
+	"\n" +
+	"applicants\x12\x19Login applicant with code\x1a0Applicant authorization by a one-time email code\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/applicant/login-code/login\x12\x9a\x02\n" +
+	"\x17StartApplicantOidcLogin\x12/.auth_service.v1.StartApplicantOidcLoginRequest\x1a0.auth_service.v1.StartApplicantOidcLoginResponse\"\x9b\x01\x92Aq\n" +
+	"\n" +
+	"applicants\x12\x1aStart applicant OIDC login\x1aGReturns the identity provider url the applicant has to be redirected to\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/applicant/oidc/start\x12\xd1\x02\n" +
+	"\x1aCompleteApplicantOidcLogin\x122.auth_service.v1.CompleteApplicantOidcLoginRequest\x1a3.auth_service.v1.CompleteApplicantOidcLoginResponse\"\xc9\x01\x92A\x9b\x01\n" +
+	"\n" +
+	"applicants\x12\x1dComplete applicant OIDC login\x1anRedeems the identity provider code. Logs in the linked applicant or returns a registration token for a new one\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/applicant/oidc/callback\x12\xad\x02\n" +
+	"\x19RegisterApplicantWithOidc\x121.auth_service.v1.RegisterApplicantWithOidcRequest\x1a2.auth_service.v1.RegisterApplicantWithOidcResponse\"\xa8\x01\x92A{\n" +
+	"\n" +
+	"applicants\x12\x1cRegister applicant with OIDC\x1aOCreates an active applicant linked to the identity provider account and logs in\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/applicant/oidc/register\x12\xcd\x01\n" +
+	"\x10RefreshApplicant\x12(.auth_service.v1.RefreshApplicantRequest\x1a).auth_service.v1.RefreshApplicantResponse\"d\x92A=\n" +
+	"\n" +
+	"applicants\x12\x11Refresh applicant\x1a\x1cReturns a new pair of tokens\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/applicant/refresh\x12\xc5\x01\n" +
+	"\x0fLogoutApplicant\x12'.auth_service.v1.LogoutApplicantRequest\x1a(.auth_service.v1.LogoutApplicantResponse\"_\x92A<\n" +
+	"\n" +
+	"applicants\x12\x10Logout applicant\x1a\x1cDeactivates applicant tokens\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/applicant/logout\x12\x95\x02\n" +
+	"\x1dGetResetApplicantPasswordCode\x125.auth_service.v1.GetResetApplicantPasswordCodeRequest\x1a6.auth_service.v1.GetResetApplicantPasswordCodeResponse\"\x84\x01\x92AQ\n" +
+	"\n" +
+	"applicants\x12#Get reset applicant's password code\x1a\x1eSends a password recovery code\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/applicant/reset-password/code\x12\xea\x01\n" +
+	"\x16ResetApplicantPassword\x12..auth_service.v1.ResetApplicantPasswordRequest\x1a/.auth_service.v1.ResetApplicantPasswordResponse\"o\x92AA\n" +
+	"\n" +
+	"applicants\x12\x18Reset applicant password\x1a\x19Resets applicant password\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/applicant/reset-password\x12\xf0\x01\n" +
+	"\x17ChangeApplicantPassword\x12/.auth_service.v1.ChangeApplicantPasswordRequest\x1a0.auth_service.v1.ChangeApplicantPasswordResponse\"r\x92AC\n" +
+	"\n" +
+	"applicants\x12\x19Change applicant password\x1a\x1aChanges applicant password\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/applicant/change-password\x12\xbb\x02\n" +
+	"\x15ListApplicantSessions\x12-.auth_service.v1.ListApplicantSessionsRequest\x1a..auth_service.v1.ListApplicantSessionsResponse\"\xc2\x01\x92A\x9c\x01\n" +
+	"\n" +
+	"applicants\x12\x17List applicant sessions\x1auReturns active applicant sessions. Pass the refresh token in the x-refresh-token metadata to mark the current session\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/applicant/sessions\x12\x8c\x02\n" +
+	"\x16RevokeApplicantSession\x12..auth_service.v1.RevokeApplicantSessionRequest\x1a/.auth_service.v1.RevokeApplicantSessionResponse\"\x90\x01\x92A^\n" +
+	"\n" +
+	"applicants\x12\x18Revoke applicant session\x1a6Deactivates the refresh token of the applicant session\x82\xd3\xe4\x93\x02)*'/api/v1/applicant/sessions/{session_id}\x12\xac\x02\n" +
+	"\x1cRevokeOtherApplicantSessions\x124.auth_service.v1.RevokeOtherApplicantSessionsRequest\x1a5.auth_service.v1.RevokeOtherApplicantSessionsResponse\"\x9e\x01\x92Ah\n" +
+	"\n" +
+	"applicants\x12\x1fRevoke other applicant sessions\x1a9Deactivates all applicant sessions except the current one\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/applicant/sessions/revoke-others\x12\x9e\x02\n" +
+	"\x12EnrollApplicantMfa\x12*.auth_service.v1.EnrollApplicantMfaRequest\x1a+.auth_service.v1.EnrollApplicantMfaResponse\"\xae\x01\x92A\x83\x01\n" +
+	"\n" +
+	"applicants\x12\x14Enroll applicant mfa\x1a_Generates a TOTP secret for the applicant. The second factor is enabled only after confirmation\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/applicant/mfa/enroll\x12\x89\x02\n" +
+	"\x13ConfirmApplicantMfa\x12+.auth_service.v1.ConfirmApplicantMfaRequest\x1a,.auth_service.v1.ConfirmApplicantMfaResponse\"\x96\x01\x92Ak\n" +
+	"\n" +
+	"applicants\x12\x15Confirm applicant mfa\x1aFEnables the enrolled second factor and returns one-time recovery codes\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/applicant/mfa/confirm\x12\x90\x02\n" +
+	"\x13DisableApplicantMfa\x12+.auth_service.v1.DisableApplicantMfaRequest\x1a,.auth_service.v1.DisableApplicantMfaResponse\"\x9d\x01\x92Ar\n" +
+	"\n" +
+	"applicants\x12\x15Disable applicant mfa\x1aMDisables the second factor. Requires the password and a TOTP or recovery code\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/applicant/mfa/disable\x12\x87\x02\n" +
+	"\x12VerifyApplicantMfa\x12*.auth_service.v1.VerifyApplicantMfaRequest\x1a+.auth_service.v1.VerifyApplicantMfaResponse\"\x97\x01\x92Am\n" +
+	"\n" +
+	"applicants\x12\x14Verify applicant mfa\x1aICompletes the login started with the mfa token by a TOTP or recovery code\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/applicant/mfa/verify\x12\xaf\x02\n" +
+	"\x1bRequestApplicantEmailChange\x123.auth_service.v1.RequestApplicantEmailChangeRequest\x1a4.auth_service.v1.RequestApplicantEmailChangeResponse\"\xa4\x01\x92Ap\n" +
+	"\n" +
+	"applicants\x12\x1eRequest applicant email change\x1aBChecks the password and sends a confirmation code to the new email\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/applicant/email-change/request\x12\xbe\x02\n" +
+	"\x1bConfirmApplicantEmailChange\x123.auth_service.v1.ConfirmApplicantEmailChangeRequest\x1a4.auth_service.v1.ConfirmApplicantEmailChangeResponse\"\xb3\x01\x92A\x7f\n" +
+	"\n" +
+	"applicants\x12\x1eConfirm applicant email change\x1aQChanges the email by the code sent to the new address. Other sessions are revoked\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/applicant/email-change/confirm\x12\xee\x02\n" +
+	"\x16DeleteApplicantAccount\x12..auth_service.v1.DeleteApplicantAccountRequest\x1a/.auth_service.v1.DeleteApplicantAccountResponse\"\xf2\x01\x92A\xc3\x01\n" +
+	"\n" +
+	"applicants\x12\x18Delete applicant account\x1a\x9a\x01Deletes the account of the authorized applicant after checking the password. Every session is revoked. The account can be restored within the grace period\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/applicant/account/delete\x12\xd9\x02\n" +
+	"\x17RestoreApplicantAccount\x12/.auth_service.v1.RestoreApplicantAccountRequest\x1a0.auth_service.v1.RestoreApplicantAccountResponse\"\xda\x01\x92A\xaa\x01\n" +
+	"\n" +
+	"applicants\x12\x19Restore applicant account\x1a\x80\x01Restores the account deleted within the grace period after checking the email and the password. The applicant logs in afterwards\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/applicant/account/restore\x12\x8b\x01\n" +
+	"\x1cBumpApplicantSecurityVersion\x124.auth_service.v1.BumpApplicantSecurityVersionRequest\x1a5.auth_service.v1.BumpApplicantSecurityVersionResponse\x12\xc7\x01\n" +
+	"\x10RegisterEmployer\x12(.auth_service.v1.RegisterEmployerRequest\x1a).auth_service.v1.RegisterEmployerResponse\"^\x92A7\n" +
+	"\temployers\x12\x16Register employer user\x1a\x12Registers employer\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/employer/register\x12\x91\x02\n" +
+	"\x1cGetNewEmployerActivationCode\x124.auth_service.v1.GetNewEmployerActivationCodeRequest\x1a5.auth_service.v1.GetNewEmployerActivationCodeResponse\"\x83\x01\x92AT\n" +
+	"\temployers\x12 Get new employer activation code\x1a%Sending a new account activation code\x82\xd3\xe4\x93\x02&\x12$/api/v1/employer/new-activation-code\x12\xd0\x01\n" +
+	"\x10ActivateEmployer\x12(.auth_service.v1.ActivateEmployerRequest\x1a).auth_service.v1.ActivateEmployerResponse\"g\x92A@\n" +
+	"\temployers\x12\x11Activate employer\x1a Activates a new employer account\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/employer/activate\x12\xb7\x01\n" +
+	"\rLoginEmployer\x12%.auth_service.v1.LoginEmployerRequest\x1a&.auth_service.v1.LoginEmployerResponse\"W\x92A3\n" +
+	"\temployers\x12\x0eLogin employer\x1a\x16Employer authorization\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/employer/login\x12\x86\x02\n" +
+	"\x18RequestEmployerLoginCode\x120.auth_service.v1.RequestEmployerLoginCodeRequest\x1a1.auth_service.v1.RequestEmployerLoginCodeResponse\"\x84\x01\x92A[\n" +
+	"\temployers\x12\x1bRequest employer login code\x1a1Sends a one-time login code to the employer email\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/employer/login-code\x12\xfe\x01\n" +
+	"\x15LoginEmployerWithCode\x12-.auth_service.v1.LoginEmployerWithCodeRequest\x1a..auth_service.v1.LoginEmployerWithCodeResponse\"\x85\x01\x92AV\n" +
+	"\temployers\x12\x18Login employer with code\x1a/Employer authorization by a one-time email code\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/employer/login-code/login\x12\xc7\x01\n" +
+	"\x0fRefreshEmployer\x12'.auth_service.v1.RefreshEmployerRequest\x1a(.auth_service.v1.RefreshEmployerResponse\"a\x92A;\n" +
+	"\temployers\x12\x10Refresh employer\x1a\x1cReturns a new pair of tokens\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/employer/refresh\x12\xbe\x01\n" +
+	"\x0eLogoutEmployer\x12&.auth_service.v1.LogoutEmployerRequest\x1a'.auth_service.v1.LogoutEmployerResponse\"[\x92A9\n" +
+	"\temployers\x12\x0fLogout employer\x1a\x1bDeactivates employer tokens\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/employer/logout\x12\x8f\x02\n" +
+	"\x1cGetResetEmployerPasswordCode\x124.auth_service.v1.GetResetEmployerPasswordCodeRequest\x1a5.auth_service.v1.GetResetEmployerPasswordCodeResponse\"\x81\x01\x92AO\n" +
+	"\temployers\x12\"Get reset employer's password code\x1a\x1eSends a password recovery code\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/employer/reset-password/code\x12\xe3\x01\n" +
+	"\x15ResetEmployerPassword\x12-.auth_service.v1.ResetEmployerPasswordRequest\x1a..auth_service.v1.ResetEmployerPasswordResponse\"k\x92A>\n" +
+	"\temployers\x12\x17Reset employer password\x1a\x18Resets employer password\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/employer/reset-password\x12\xe9\x01\n" +
+	"\x16ChangeEmployerPassword\x12..auth_service.v1.ChangeEmployerPasswordRequest\x1a/.auth_service.v1.ChangeEmployerPasswordResponse\"n\x92A@\n" +
+	"\temployers\x12\x18Change employer password\x1a\x19Changes employer password\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/employer/change-password\x12\xb4\x02\n" +
+	"\x14ListEmployerSessions\x12,.auth_service.v1.ListEmployerSessionsRequest\x1a-.auth_service.v1.ListEmployerSessionsResponse\"\xbe\x01\x92A\x99\x01\n" +
+	"\temployers\x12\x16List employer sessions\x1atReturns active employer sessions. Pass the refresh token in the x-refresh-token metadata to mark the current session\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/employer/sessions\x12\x85\x02\n" +
+	"\x15RevokeEmployerSession\x12-.auth_service.v1.RevokeEmployerSessionRequest\x1a..auth_service.v1.RevokeEmployerSessionResponse\"\x8c\x01\x92A[\n" +
+	"\temployers\x12\x17Revoke employer session\x1a5Deactivates the refresh token of the employer session\x82\xd3\xe4\x93\x02(*&/api/v1/employer/sessions/{session_id}\x12\xa5\x02\n" +
+	"\x1bRevokeOtherEmployerSessions\x123.auth_service.v1.RevokeOtherEmployerSessionsRequest\x1a4.auth_service.v1.RevokeOtherEmployerSessionsResponse\"\x9a\x01\x92Ae\n" +
+	"\temployers\x12\x1eRevoke other employer sessions\x1a8Deactivates all employer sessions except the current one\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/employer/sessions/revoke-others\x12\x97\x02\n" +
+	"\x11EnrollEmployerMfa\x12).auth_service.v1.EnrollEmployerMfaRequest\x1a*.auth_service.v1.EnrollEmployerMfaResponse\"\xaa\x01\x92A\x80\x01\n" +
+	"\temployers\x12\x13Enroll employer mfa\x1a^Generates a TOTP secret for the employer. The second factor is enabled only after confirmation\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/employer/mfa/enroll\x12\x83\x02\n" +
+	"\x12ConfirmEmployerMfa\x12*.auth_service.v1.ConfirmEmployerMfaRequest\x1a+.auth_service.v1.ConfirmEmployerMfaResponse\"\x93\x01\x92Ai\n" +
+	"\temployers\x12\x14Confirm employer mfa\x1aFEnables the enrolled second factor and returns one-time recovery codes\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/employer/mfa/confirm\x12\x8a\x02\n" +
+	"\x12DisableEmployerMfa\x12*.auth_service.v1.DisableEmployerMfaRequest\x1a+.auth_service.v1.DisableEmployerMfaResponse\"\x9a\x01\x92Ap\n" +
+	"\temployers\x12\x14Disable employer mfa\x1aMDisables the second factor. Requires the password and a TOTP or recovery code\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/employer/mfa/disable\x12\x81\x02\n" +
+	"\x11VerifyEmployerMfa\x12).auth_service.v1.VerifyEmployerMfaRequest\x1a*.auth_service.v1.VerifyEmployerMfaResponse\"\x94\x01\x92Ak\n" +
+	"\temployers\x12\x13Verify employer mfa\x1aICompletes the login started with the mfa token by a TOTP or recovery code\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/employer/mfa/verify\x12\xa9\x02\n" +
+	"\x1aRequestEmployerEmailChange\x122.auth_service.v1.RequestEmployerEmailChangeRequest\x1a3.auth_service.v1.RequestEmployerEmailChangeResponse\"\xa1\x01\x92An\n" +
+	"\temployers\x12\x1dRequest employer email change\x1aBChecks the password and sends a confirmation code to the new email\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/employer/email-change/request\x12\xb8\x02\n" +
+	"\x1aConfirmEmployerEmailChange\x122.auth_service.v1.ConfirmEmployerEmailChangeRequest\x1a3.auth_service.v1.ConfirmEmployerEmailChangeResponse\"\xb0\x01\x92A}\n" +
+	"\temployers\x12\x1dConfirm employer email change\x1aQChanges the email by the code sent to the new address. Other sessions are revoked\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/employer/email-change/confirm\x12\xe7\x02\n" +
+	"\x15DeleteEmployerAccount\x12-.auth_service.v1.DeleteEmployerAccountRequest\x1a..auth_service.v1.DeleteEmployerAccountResponse\"\xee\x01\x92A\xc0\x01\n" +
+	"\temployers\x12\x17Delete employer account\x1a\x99\x01Deletes the account of the authorized employer after checking the password. Every session is revoked. The account can be restored within the grace period\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/employer/account/delete\x12\xd1\x02\n" +
+	"\x16RestoreEmployerAccount\x12..auth_service.v1.RestoreEmployerAccountRequest\x1a/.auth_service.v1.RestoreEmployerAccountResponse\"\xd5\x01\x92A\xa6\x01\n" +
+	"\temployers\x12\x18Restore employer account\x1a\x7fRestores the account deleted within the grace period after checking the email and the password. The employer logs in afterwards\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/employer/account/restore\x12\x88\x01\n" +
+	"\x1bBumpEmployerSecurityVersion\x123.auth_service.v1.BumpEmployerSecurityVersionRequest\x1a4.auth_service.v1.BumpEmployerSecurityVersionResponse\x12d\n" +
+	"\x0fIntrospectToken\x12'.auth_service.v1.IntrospectTokenRequest\x1a(.auth_service.v1.IntrospectTokenResponseB\x89\x02\x92A\xb2\x01\x12x\n" +
+	"\x10Auth Service API\x12_API for registration, authorization, changing and resetting passwords, and updating user tokens2\x031.0\x1a\x0elocalhost:8082*\x02\x01\x022\x10application/json:\x10application/jsonZQgithub.com/ZaiiiRan/job_search_service/auth-service/gen/go/auth-service/v1;authv1b\x06proto3"
+
+var (
+	file_auth_service_v1_auth_service_proto_rawDescOnce sync.Once
+	file_auth_service_v1_auth_service_proto_rawDescData []byte
+)
+
+func file_auth_service_v1_auth_service_proto_rawDescGZIP() []byte {
+	file_auth_service_v1_auth_service_proto_rawDescOnce.Do(func() {
+		file_auth_service_v1_auth_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_service_v1_auth_service_proto_rawDesc), len(file_auth_service_v1_auth_service_proto_rawDesc)))
+	})
+	return file_auth_service_v1_auth_service_proto_rawDescData
+}
+
+var file_auth_service_v1_auth_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_auth_service_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
+var file_auth_service_v1_auth_service_proto_goTypes = []any{
+	(UserKind)(0),                                 // 0: auth_service.v1.UserKind
+	(*RegisterApplicantRequest)(nil),              // 1: auth_service.v1.RegisterApplicantRequest
+	(*RegisterApplicantResponse)(nil),             // 2: auth_service.v1.RegisterApplicantResponse
+	(*GetNewApplicantActivationCodeRequest)(nil),  // 3: auth_service.v1.GetNewApplicantActivationCodeRequest
+	(*GetNewApplicantActivationCodeResponse)(nil), // 4: auth_service.v1.GetNewApplicantActivationCodeResponse
+	(*ActivateApplicantRequest)(nil),              // 5: auth_service.v1.ActivateApplicantRequest
+	(*ActivateApplicantResponse)(nil),             // 6: auth_service.v1.ActivateApplicantResponse
+	(*LoginApplicantRequest)(nil),                 // 7: auth_service.v1.LoginApplicantRequest
+	(*LoginApplicantResponse)(nil),                // 8: auth_service.v1.LoginApplicantResponse
+	(*RequestApplicantLoginCodeRequest)(nil),      // 9: auth_service.v1.RequestApplicantLoginCodeRequest
+	(*RequestApplicantLoginCodeResponse)(nil),     // 10: auth_service.v1.RequestApplicantLoginCodeResponse
+	(*LoginApplicantWithCodeRequest)(nil),         // 11: auth_service.v1.LoginApplicantWithCodeRequest
+	(*LoginApplicantWithCodeResponse)(nil),        // 12: auth_service.v1.LoginApplicantWithCodeResponse
+	(*StartApplicantOidcLoginRequest)(nil),        // 13: auth_service.v1.StartApplicantOidcLoginRequest
+	(*StartApplicantOidcLoginResponse)(nil),       // 14: auth_service.v1.StartApplicantOidcLoginResponse
+	(*CompleteApplicantOidcLoginRequest)(nil),     // 15: auth_service.v1.CompleteApplicantOidcLoginRequest
+	(*CompleteApplicantOidcLoginResponse)(nil),    // 16: auth_service.v1.CompleteApplicantOidcLoginResponse
+	(*RegisterApplicantWithOidcRequest)(nil),      // 17: auth_service.v1.RegisterApplicantWithOidcRequest
+	(*RegisterApplicantWithOidcResponse)(nil),     // 18: auth_service.v1.RegisterApplicantWithOidcResponse
+	(*RefreshApplicantRequest)(nil),               // 19: auth_service.v1.RefreshApplicantRequest
+	(*RefreshApplicantResponse)(nil),              // 20: auth_service.v1.RefreshApplicantResponse
+	(*LogoutApplicantRequest)(nil),                // 21: auth_service.v1.LogoutApplicantRequest
+	(*LogoutApplicantResponse)(nil),               // 22: auth_service.v1.LogoutApplicantResponse
+	(*GetResetApplicantPasswordCodeRequest)(nil),  // 23: auth_service.v1.GetResetApplicantPasswordCodeRequest
+	(*GetResetApplicantPasswordCodeResponse)(nil), // 24: auth_service.v1.GetResetApplicantPasswordCodeResponse
+	(*ResetApplicantPasswordRequest)(nil),         // 25: auth_service.v1.ResetApplicantPasswordRequest
+	(*ResetApplicantPasswordResponse)(nil),        // 26: auth_service.v1.ResetApplicantPasswordResponse
+	(*ChangeApplicantPasswordRequest)(nil),        // 27: auth_service.v1.ChangeApplicantPasswordRequest
+	(*ChangeApplicantPasswordResponse)(nil),       // 28: auth_service.v1.ChangeApplicantPasswordResponse
+	(*ListApplicantSessionsRequest)(nil),          // 29: auth_service.v1.ListApplicantSessionsRequest
+	(*ListApplicantSessionsResponse)(nil),         // 30: auth_service.v1.ListApplicantSessionsResponse
+	(*RevokeApplicantSessionRequest)(nil),         // 31: auth_service.v1.RevokeApplicantSessionRequest
+	(*RevokeApplicantSessionResponse)(nil),        // 32: auth_service.v1.RevokeApplicantSessionResponse
+	(*RevokeOtherApplicantSessionsRequest)(nil),   // 33: auth_service.v1.RevokeOtherApplicantSessionsRequest
+	(*RevokeOtherApplicantSessionsResponse)(nil),  // 34: auth_service.v1.RevokeOtherApplicantSessionsResponse
+	(*EnrollApplicantMfaRequest)(nil),             // 35: auth_service.v1.EnrollApplicantMfaRequest
+	(*EnrollApplicantMfaResponse)(nil),            // 36: auth_service.v1.EnrollApplicantMfaResponse
+	(*ConfirmApplicantMfaRequest)(nil),            // 37: auth_service.v1.ConfirmApplicantMfaRequest
+	(*ConfirmApplicantMfaResponse)(nil),           // 38: auth_service.v1.ConfirmApplicantMfaResponse
+	(*DisableApplicantMfaRequest)(nil),            // 39: auth_service.v1.DisableApplicantMfaRequest
+	(*DisableApplicantMfaResponse)(nil),           // 40: auth_service.v1.DisableApplicantMfaResponse
+	(*VerifyApplicantMfaRequest)(nil),             // 41: auth_service.v1.VerifyApplicantMfaRequest
+	(*VerifyApplicantMfaResponse)(nil),            // 42: auth_service.v1.VerifyApplicantMfaResponse
+	(*RequestApplicantEmailChangeRequest)(nil),    // 43: auth_service.v1.RequestApplicantEmailChangeRequest
+	(*RequestApplicantEmailChangeResponse)(nil),   // 44: auth_service.v1.RequestApplicantEmailChangeResponse
+	(*ConfirmApplicantEmailChangeRequest)(nil),    // 45: auth_service.v1.ConfirmApplicantEmailChangeRequest
+	(*ConfirmApplicantEmailChangeResponse)(nil),   // 46: auth_service.v1.ConfirmApplicantEmailChangeResponse
+	(*DeleteApplicantAccountRequest)(nil),         // 47: auth_service.v1.DeleteApplicantAccountRequest
+	(*DeleteApplicantAccountResponse)(nil),        // 48: auth_service.v1.DeleteApplicantAccountResponse
+	(*RestoreApplicantAccountRequest)(nil),        // 49: auth_service.v1.RestoreApplicantAccountRequest
+	(*RestoreApplicantAccountResponse)(nil),       // 50: auth_service.v1.RestoreApplicantAccountResponse
+	(*BumpApplicantSecurityVersionRequest)(nil),   // 51: auth_service.v1.BumpApplicantSecurityVersionRequest
+	(*BumpApplicantSecurityVersionResponse)(nil),  // 52: auth_service.v1.BumpApplicantSecurityVersionResponse
+	(*RegisterEmployerRequest)(nil),               // 53: auth_service.v1.RegisterEmployerRequest
+	(*RegisterEmployerResponse)(nil),              // 54: auth_service.v1.RegisterEmployerResponse
+	(*GetNewEmployerActivationCodeRequest)(nil),   // 55: auth_service.v1.GetNewEmployerActivationCodeRequest
+	(*GetNewEmployerActivationCodeResponse)(nil),  // 56: auth_service.v1.GetNewEmployerActivationCodeResponse
+	(*ActivateEmployerRequest)(nil),               // 57: auth_service.v1.ActivateEmployerRequest
+	(*ActivateEmployerResponse)(nil),              // 58: auth_service.v1.ActivateEmployerResponse
+	(*LoginEmployerRequest)(nil),                  // 59: auth_service.v1.LoginEmployerRequest
+	(*LoginEmployerResponse)(nil),                 // 60: auth_service.v1.LoginEmployerResponse
+	(*RequestEmployerLoginCodeRequest)(nil),       // 61: auth_service.v1.RequestEmployerLoginCodeRequest
+	(*RequestEmployerLoginCodeResponse)(nil),      // 62: auth_service.v1.RequestEmployerLoginCodeResponse
+	(*LoginEmployerWithCodeRequest)(nil),          // 63: auth_service.v1.LoginEmployerWithCodeRequest
+	(*LoginEmployerWithCodeResponse)(nil),         // 64: auth_service.v1.LoginEmployerWithCodeResponse
+	(*RefreshEmployerRequest)(nil),                // 65: auth_service.v1.RefreshEmployerRequest
+	(*RefreshEmployerResponse)(nil),               // 66: auth_service.v1.RefreshEmployerResponse
+	(*LogoutEmployerRequest)(nil),                 // 67: auth_service.v1.LogoutEmployerRequest
+	(*LogoutEmployerResponse)(nil),                // 68: auth_service.v1.LogoutEmployerResponse
+	(*GetResetEmployerPasswordCodeRequest)(nil),   // 69: auth_service.v1.GetResetEmployerPasswordCodeRequest
+	(*GetResetEmployerPasswordCodeResponse)(nil),  // 70: auth_service.v1.GetResetEmployerPasswordCodeResponse
+	(*ResetEmployerPasswordRequest)(nil),          // 71: auth_service.v1.ResetEmployerPasswordRequest
+	(*ResetEmployerPasswordResponse)(nil),         // 72: auth_service.v1.ResetEmployerPasswordResponse
+	(*ChangeEmployerPasswordRequest)(nil),         // 73: auth_service.v1.ChangeEmployerPasswordRequest
+	(*ChangeEmployerPasswordResponse)(nil),        // 74: auth_service.v1.ChangeEmployerPasswordResponse
+	(*ListEmployerSessionsRequest)(nil),           // 75: auth_service.v1.ListEmployerSessionsRequest
+	(*ListEmployerSessionsResponse)(nil),          // 76: auth_service.v1.ListEmployerSessionsResponse
+	(*RevokeEmployerSessionRequest)(nil),          // 77: auth_service.v1.RevokeEmployerSessionRequest
+	(*RevokeEmployerSessionResponse)(nil),         // 78: auth_service.v1.RevokeEmployerSessionResponse
+	(*RevokeOtherEmployerSessionsRequest)(nil),    // 79: auth_service.v1.RevokeOtherEmployerSessionsRequest
+	(*RevokeOtherEmployerSessionsResponse)(nil),   // 80: auth_service.v1.RevokeOtherEmployerSessionsResponse
+	(*EnrollEmployerMfaRequest)(nil),              // 81: auth_service.v1.EnrollEmployerMfaRequest
+	(*EnrollEmployerMfaResponse)(nil),             // 82: auth_service.v1.EnrollEmployerMfaResponse
+	(*ConfirmEmployerMfaRequest)(nil),             // 83: auth_service.v1.ConfirmEmployerMfaRequest
+	(*ConfirmEmployerMfaResponse)(nil),            // 84: auth_service.v1.ConfirmEmployerMfaResponse
+	(*DisableEmployerMfaRequest)(nil),             // 85: auth_service.v1.DisableEmployerMfaRequest
+	(*DisableEmployerMfaResponse)(nil),            // 86: auth_service.v1.DisableEmployerMfaResponse
+	(*VerifyEmployerMfaRequest)(nil),              // 87: auth_service.v1.VerifyEmployerMfaRequest
+	(*VerifyEmployerMfaResponse)(nil),             // 88: auth_service.v1.VerifyEmployerMfaResponse
+	(*RequestEmployerEmailChangeRequest)(nil),     // 89: auth_service.v1.RequestEmployerEmailChangeRequest
+	(*RequestEmployerEmailChangeResponse)(nil),    // 90: auth_service.v1.RequestEmployerEmailChangeResponse
+	(*ConfirmEmployerEmailChangeRequest)(nil),     // 91: auth_service.v1.ConfirmEmployerEmailChangeRequest
+	(*ConfirmEmployerEmailChangeResponse)(nil),    // 92: auth_service.v1.ConfirmEmployerEmailChangeResponse
+	(*DeleteEmployerAccountRequest)(nil),          // 93: auth_service.v1.DeleteEmployerAccountRequest
+	(*DeleteEmployerAccountResponse)(nil),         // 94: auth_service.v1.DeleteEmployerAccountResponse
+	(*RestoreEmployerAccountRequest)(nil),         // 95: auth_service.v1.RestoreEmployerAccountRequest
+	(*RestoreEmployerAccountResponse)(nil),        // 96: auth_service.v1.RestoreEmployerAccountResponse
+	(*BumpEmployerSecurityVersionRequest)(nil),    // 97: auth_service.v1.BumpEmployerSecurityVersionRequest
+	(*BumpEmployerSecurityVersionResponse)(nil),   // 98: auth_service.v1.BumpEmployerSecurityVersionResponse
+	(*IntrospectTokenRequest)(nil),                // 99: auth_service.v1.IntrospectTokenRequest
+	(*IntrospectTokenResponse)(nil),               // 100: auth_service.v1.IntrospectTokenResponse
+	(*Session)(nil),                               // 101: auth_service.v1.Session
+	(*v1.Applicant)(nil),                          // 102: user_service.v1.Applicant
+	(*v1.Employer)(nil),                           // 103: user_service.v1.Employer
+	(*timestamppb.Timestamp)(nil),                 // 104: google.protobuf.Timestamp
+}
+var file_auth_service_v1_auth_service_proto_depIdxs = []int32{
+	102, // 0: auth_service.v1.RegisterApplicantRequest.applicant:type_name -> user_service.v1.Applicant
+	102, // 1: auth_service.v1.RegisterApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 2: auth_service.v1.ActivateApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 3: auth_service.v1.LoginApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 4: auth_service.v1.LoginApplicantWithCodeResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 5: auth_service.v1.CompleteApplicantOidcLoginResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 6: auth_service.v1.RegisterApplicantWithOidcRequest.applicant:type_name -> user_service.v1.Applicant
+	102, // 7: auth_service.v1.RegisterApplicantWithOidcResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 8: auth_service.v1.ResetApplicantPasswordResponse.applicant:type_name -> user_service.v1.Applicant
+	101, // 9: auth_service.v1.ListApplicantSessionsResponse.sessions:type_name -> auth_service.v1.Session
+	102, // 10: auth_service.v1.VerifyApplicantMfaResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 11: auth_service.v1.ConfirmApplicantEmailChangeResponse.applicant:type_name -> user_service.v1.Applicant
+	102, // 12: auth_service.v1.RestoreApplicantAccountResponse.applicant:type_name -> user_service.v1.Applicant
+	103, // 13: auth_service.v1.RegisterEmployerRequest.employer:type_name -> user_service.v1.Employer
+	103, // 14: auth_service.v1.RegisterEmployerResponse.employer:type_name -> user_service.v1.Employer
+	103, // 15: auth_service.v1.ActivateEmployerResponse.employer:type_name -> user_service.v1.Employer
+	103, // 16: auth_service.v1.LoginEmployerResponse.employer:type_name -> user_service.v1.Employer
+	103, // 17: auth_service.v1.LoginEmployerWithCodeResponse.employer:type_name -> user_service.v1.Employer
+	103, // 18: auth_service.v1.ResetEmployerPasswordResponse.employer:type_name -> user_service.v1.Employer
+	101, // 19: auth_service.v1.ListEmployerSessionsResponse.sessions:type_name -> auth_service.v1.Session
+	103, // 20: auth_service.v1.VerifyEmployerMfaResponse.employer:type_name -> user_service.v1.Employer
+	103, // 21: auth_service.v1.ConfirmEmployerEmailChangeResponse.employer:type_name -> user_service.v1.Employer
+	103, // 22: auth_service.v1.RestoreEmployerAccountResponse.employer:type_name -> user_service.v1.Employer
+	0,   // 23: auth_service.v1.IntrospectTokenResponse.user_kind:type_name -> auth_service.v1.UserKind
+	104, // 24: auth_service.v1.IntrospectTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	104, // 25: auth_service.v1.IntrospectTokenResponse.issued_at:type_name -> google.protobuf.Timestamp
+	104, // 26: auth_service.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	104, // 27: auth_service.v1.Session.last_used_at:type_name -> google.protobuf.Timestamp
+	104, // 28: auth_service.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	1,   // 29: auth_service.v1.AuthService.RegisterApplicant:input_type -> auth_service.v1.RegisterApplicantRequest
+	3,   // 30: auth_service.v1.AuthService.GetNewApplicantActivationCode:input_type -> auth_service.v1.GetNewApplicantActivationCodeRequest
+	5,   // 31: auth_service.v1.AuthService.ActivateApplicant:input_type -> auth_service.v1.ActivateApplicantRequest
+	7,   // 32: auth_service.v1.AuthService.LoginApplicant:input_type -> auth_service.v1.LoginApplicantRequest
+	9,   // 33: auth_service.v1.AuthService.RequestApplicantLoginCode:input_type -> auth_service.v1.RequestApplicantLoginCodeRequest
+	11,  // 34: auth_service.v1.AuthService.LoginApplicantWithCode:input_type -> auth_service.v1.LoginApplicantWithCodeRequest
+	13,  // 35: auth_service.v1.AuthService.StartApplicantOidcLogin:input_type -> auth_service.v1.StartApplicantOidcLoginRequest
+	15,  // 36: auth_service.v1.AuthService.CompleteApplicantOidcLogin:input_type -> auth_service.v1.CompleteApplicantOidcLoginRequest
+	17,  // 37: auth_service.v1.AuthService.RegisterApplicantWithOidc:input_type -> auth_service.v1.RegisterApplicantWithOidcRequest
+	19,  // 38: auth_service.v1.AuthService.RefreshApplicant:input_type -> auth_service.v1.RefreshApplicantRequest
+	21,  // 39: auth_service.v1.AuthService.LogoutApplicant:input_type -> auth_service.v1.LogoutApplicantRequest
+	23,  // 40: auth_service.v1.AuthService.GetResetApplicantPasswordCode:input_type -> auth_service.v1.GetResetApplicantPasswordCodeRequest
+	25,  // 41: auth_service.v1.AuthService.ResetApplicantPassword:input_type -> auth_service.v1.ResetApplicantPasswordRequest
+	27,  // 42: auth_service.v1.AuthService.ChangeApplicantPassword:input_type -> auth_service.v1.ChangeApplicantPasswordRequest
+	29,  // 43: auth_service.v1.AuthService.ListApplicantSessions:input_type -> auth_service.v1.ListApplicantSessionsRequest
+	31,  // 44: auth_service.v1.AuthService.RevokeApplicantSession:input_type -> auth_service.v1.RevokeApplicantSessionRequest
+	33,  // 45: auth_service.v1.AuthService.RevokeOtherApplicantSessions:input_type -> auth_service.v1.RevokeOtherApplicantSessionsRequest
+	35,  // 46: auth_service.v1.AuthService.EnrollApplicantMfa:input_type -> auth_service.v1.EnrollApplicantMfaRequest
+	37,  // 47: auth_service.v1.AuthService.ConfirmApplicantMfa:input_type -> auth_service.v1.ConfirmApplicantMfaRequest
+	39,  // 48: auth_service.v1.AuthService.DisableApplicantMfa:input_type -> auth_service.v1.DisableApplicantMfaRequest
+	41,  // 49: auth_service.v1.AuthService.VerifyApplicantMfa:input_type -> auth_service.v1.VerifyApplicantMfaRequest
+	43,  // 50: auth_service.v1.AuthService.RequestApplicantEmailChange:input_type -> auth_service.v1.RequestApplicantEmailChangeRequest
+	45,  // 51: auth_service.v1.AuthService.ConfirmApplicantEmailChange:input_type -> auth_service.v1.ConfirmApplicantEmailChangeRequest
+	47,  // 52: auth_service.v1.AuthService.DeleteApplicantAccount:input_type -> auth_service.v1.DeleteApplicantAccountRequest
+	49,  // 53: auth_service.v1.AuthService.RestoreApplicantAccount:input_type -> auth_service.v1.RestoreApplicantAccountRequest
+	51,  // 54: auth_service.v1.AuthService.BumpApplicantSecurityVersion:input_type -> auth_service.v1.BumpApplicantSecurityVersionRequest
+	53,  // 55: auth_service.v1.AuthService.RegisterEmployer:input_type -> auth_service.v1.RegisterEmployerRequest
+	55,  // 56: auth_service.v1.AuthService.GetNewEmployerActivationCode:input_type -> auth_service.v1.GetNewEmployerActivationCodeRequest
+	57,  // 57: auth_service.v1.AuthService.ActivateEmployer:input_type -> auth_service.v1.ActivateEmployerRequest
+	59,  // 58: auth_service.v1.AuthService.LoginEmployer:input_type -> auth_service.v1.LoginEmployerRequest
+	61,  // 59: auth_service.v1.AuthService.RequestEmployerLoginCode:input_type -> auth_service.v1.RequestEmployerLoginCodeRequest
+	63,  // 60: auth_service.v1.AuthService.LoginEmployerWithCode:input_type -> auth_service.v1.LoginEmployerWithCodeRequest
+	65,  // 61: auth_service.v1.AuthService.RefreshEmployer:input_type -> auth_service.v1.RefreshEmployerRequest
+	67,  // 62: auth_service.v1.AuthService.LogoutEmployer:input_type -> auth_service.v1.LogoutEmployerRequest
+	69,  // 63: auth_service.v1.AuthService.GetResetEmployerPasswordCode:input_type -> auth_service.v1.GetResetEmployerPasswordCodeRequest
+	71,  // 64: auth_service.v1.AuthService.ResetEmployerPassword:input_type -> auth_service.v1.ResetEmployerPasswordRequest
+	73,  // 65: auth_service.v1.AuthService.ChangeEmployerPassword:input_type -> auth_service.v1.ChangeEmployerPasswordRequest
+	75,  // 66: auth_service.v1.AuthService.ListEmployerSessions:input_type -> auth_service.v1.ListEmployerSessionsRequest
+	77,  // 67: auth_service.v1.AuthService.RevokeEmployerSession:input_type -> auth_service.v1.RevokeEmployerSessionRequest
+	79,  // 68: auth_service.v1.AuthService.RevokeOtherEmployerSessions:input_type -> auth_service.v1.RevokeOtherEmployerSessionsRequest
+	81,  // 69: auth_service.v1.AuthService.EnrollEmployerMfa:input_type -> auth_service.v1.EnrollEmployerMfaRequest
+	83,  // 70: auth_service.v1.AuthService.ConfirmEmployerMfa:input_type -> auth_service.v1.ConfirmEmployerMfaRequest
+	85,  // 71: auth_service.v1.AuthService.DisableEmployerMfa:input_type -> auth_service.v1.DisableEmployerMfaRequest
+	87,  // 72: auth_service.v1.AuthService.VerifyEmployerMfa:input_type -> auth_service.v1.VerifyEmployerMfaRequest
+	89,  // 73: auth_service.v1.AuthService.RequestEmployerEmailChange:input_type -> auth_service.v1.RequestEmployerEmailChangeRequest
+	91,  // 74: auth_service.v1.AuthService.ConfirmEmployerEmailChange:input_type -> auth_service.v1.ConfirmEmployerEmailChangeRequest
+	93,  // 75: auth_service.v1.AuthService.DeleteEmployerAccount:input_type -> auth_service.v1.DeleteEmployerAccountRequest
+	95,  // 76: auth_service.v1.AuthService.RestoreEmployerAccount:input_type -> auth_service.v1.RestoreEmployerAccountRequest
+	97,  // 77: auth_service.v1.AuthService.BumpEmployerSecurityVersion:input_type -> auth_service.v1.BumpEmployerSecurityVersionRequest
+	99,  // 78: auth_service.v1.AuthService.IntrospectToken:input_type -> auth_service.v1.IntrospectTokenRequest
+	2,   // 79: auth_service.v1.AuthService.RegisterApplicant:output_type -> auth_service.v1.RegisterApplicantResponse
+	4,   // 80: auth_service.v1.AuthService.GetNewApplicantActivationCode:output_type -> auth_service.v1.GetNewApplicantActivationCodeResponse
+	6,   // 81: auth_service.v1.AuthService.ActivateApplicant:output_type -> auth_service.v1.ActivateApplicantResponse
+	8,   // 82: auth_service.v1.AuthService.LoginApplicant:output_type -> auth_service.v1.LoginApplicantResponse
+	10,  // 83: auth_service.v1.AuthService.RequestApplicantLoginCode:output_type -> auth_service.v1.RequestApplicantLoginCodeResponse
+	12,  // 84: auth_service.v1.AuthService.LoginApplicantWithCode:output_type -> auth_service.v1.LoginApplicantWithCodeResponse
+	14,  // 85: auth_service.v1.AuthService.StartApplicantOidcLogin:output_type -> auth_service.v1.StartApplicantOidcLoginResponse
+	16,  // 86: auth_service.v1.AuthService.CompleteApplicantOidcLogin:output_type -> auth_service.v1.CompleteApplicantOidcLoginResponse
+	18,  // 87: auth_service.v1.AuthService.RegisterApplicantWithOidc:output_type -> auth_service.v1.RegisterApplicantWithOidcResponse
+	20,  // 88: auth_service.v1.AuthService.RefreshApplicant:output_type -> auth_service.v1.RefreshApplicantResponse
+	22,  // 89: auth_service.v1.AuthService.LogoutApplicant:output_type -> auth_service.v1.LogoutApplicantResponse
+	24,  // 90: auth_service.v1.AuthService.GetResetApplicantPasswordCode:output_type -> auth_service.v1.GetResetApplicantPasswordCodeResponse
+	26,  // 91: auth_service.v1.AuthService.ResetApplicantPassword:output_type -> auth_service.v1.ResetApplicantPasswordResponse
+	28,  // 92: auth_service.v1.AuthService.ChangeApplicantPassword:output_type -> auth_service.v1.ChangeApplicantPasswordResponse
+	30,  // 93: auth_service.v1.AuthService.ListApplicantSessions:output_type -> auth_service.v1.ListApplicantSessionsResponse
+	32,  // 94: auth_service.v1.AuthService.RevokeApplicantSession:output_type -> auth_service.v1.RevokeApplicantSessionResponse
+	34,  // 95: auth_service.v1.AuthService.RevokeOtherApplicantSessions:output_type -> auth_service.v1.RevokeOtherApplicantSessionsResponse
+	36,  // 96: auth_service.v1.AuthService.EnrollApplicantMfa:output_type -> auth_service.v1.EnrollApplicantMfaResponse
+	38,  // 97: auth_service.v1.AuthService.ConfirmApplicantMfa:output_type -> auth_service.v1.ConfirmApplicantMfaResponse
+	40,  // 98: auth_service.v1.AuthService.DisableApplicantMfa:output_type -> auth_service.v1.DisableApplicantMfaResponse
+	42,  // 99: auth_service.v1.AuthService.VerifyApplicantMfa:output_type -> auth_service.v1.VerifyApplicantMfaResponse
+	44,  // 100: auth_service.v1.AuthService.RequestApplicantEmailChange:output_type -> auth_service.v1.RequestApplicantEmailChangeResponse
+	46,  // 101: auth_service.v1.AuthService.ConfirmApplicantEmailChange:output_type -> auth_service.v1.ConfirmApplicantEmailChangeResponse
+	48,  // 102: auth_service.v1.AuthService.DeleteApplicantAccount:output_type -> auth_service.v1.DeleteApplicantAccountResponse
+	50,  // 103: auth_service.v1.AuthService.RestoreApplicantAccount:output_type -> auth_service.v1.RestoreApplicantAccountResponse
+	52,  // 104: auth_service.v1.AuthService.BumpApplicantSecurityVersion:output_type -> auth_service.v1.BumpApplicantSecurityVersionResponse
+	54,  // 105: auth_service.v1.AuthService.RegisterEmployer:output_type -> auth_service.v1.RegisterEmployerResponse
+	56,  // 106: auth_service.v1.AuthService.GetNewEmployerActivationCode:output_type -> auth_service.v1.GetNewEmployerActivationCodeResponse
+	58,  // 107: auth_service.v1.AuthService.ActivateEmployer:output_type -> auth_service.v1.ActivateEmployerResponse
+	60,  // 108: auth_service.v1.AuthService.LoginEmployer:output_type -> auth_service.v1.LoginEmployerResponse
+	62,  // 109: auth_service.v1.AuthService.RequestEmployerLoginCode:output_type -> auth_service.v1.RequestEmployerLoginCodeResponse
+	64,  // 110: auth_service.v1.AuthService.LoginEmployerWithCode:output_type -> auth_service.v1.LoginEmployerWithCodeResponse
+	66,  // 111: auth_service.v1.AuthService.RefreshEmployer:output_type -> auth_service.v1.RefreshEmployerResponse
+	68,  // 112: auth_service.v1.AuthService.LogoutEmployer:output_type -> auth_service.v1.LogoutEmployerResponse
+	70,  // 113: auth_service.v1.AuthService.GetResetEmployerPasswordCode:output_type -> auth_service.v1.GetResetEmployerPasswordCodeResponse
+	72,  // 114: auth_service.v1.AuthService.ResetEmployerPassword:output_type -> auth_service.v1.ResetEmployerPasswordResponse
+	74,  // 115: auth_service.v1.AuthService.ChangeEmployerPassword:output_type -> auth_service.v1.ChangeEmployerPasswordResponse
+	76,  // 116: auth_service.v1.AuthService.ListEmployerSessions:output_type -> auth_service.v1.ListEmployerSessionsResponse
+	78,  // 117: auth_service.v1.AuthService.RevokeEmployerSession:output_type -> auth_service.v1.RevokeEmployerSessionResponse
+	80,  // 118: auth_service.v1.AuthService.RevokeOtherEmployerSessions:output_type -> auth_service.v1.RevokeOtherEmployerSessionsResponse
+	82,  // 119: auth_service.v1.AuthService.EnrollEmployerMfa:output_type -> auth_service.v1.EnrollEmployerMfaResponse
+	84,  // 120: auth_service.v1.AuthService.ConfirmEmployerMfa:output_type -> auth_service.v1.ConfirmEmployerMfaResponse
+	86,  // 121: auth_service.v1.AuthService.DisableEmployerMfa:output_type -> auth_service.v1.DisableEmployerMfaResponse
+	88,  // 122: auth_service.v1.AuthService.VerifyEmployerMfa:output_type -> auth_service.v1.VerifyEmployerMfaResponse
+	90,  // 123: auth_service.v1.AuthService.RequestEmployerEmailChange:output_type -> auth_service.v1.RequestEmployerEmailChangeResponse
+	92,  // 124: auth_service.v1.AuthService.ConfirmEmployerEmailChange:output_type -> auth_service.v1.ConfirmEmployerEmailChangeResponse
+	94,  // 125: auth_service.v1.AuthService.DeleteEmployerAccount:output_type -> auth_service.v1.DeleteEmployerAccountResponse
+	96,  // 126: auth_service.v1.AuthService.RestoreEmployerAccount:output_type -> auth_service.v1.RestoreEmployerAccountResponse
+	98,  // 127: auth_service.v1.AuthService.BumpEmployerSecurityVersion:output_type -> auth_service.v1.BumpEmployerSecurityVersionResponse
+	100, // 128: auth_service.v1.AuthService.IntrospectToken:output_type -> auth_service.v1.IntrospectTokenResponse
+	79,  // [79:129] is the sub-list for method output_type
+	29,  // [29:79] is the sub-list for method input_type
+	29,  // [29:29] is the sub-list for extension type_name
+	29,  // [29:29] is the sub-list for extension extendee
+	0,   // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_auth_service_v1_auth_service_proto_init() }
+func file_auth_service_v1_auth_service_proto_init() {
+	if File_auth_service_v1_auth_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_service_v1_auth_service_proto_rawDesc), len(file_auth_service_v1_auth_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   101,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_service_v1_auth_service_proto_goTypes,
+		DependencyIndexes: file_auth_service_v1_auth_service_proto_depIdxs,
+		EnumInfos:         file_auth_service_v1_auth_service_proto_enumTypes,
+		MessageInfos:      file_auth_service_v1_auth_service_proto_msgTypes,
+	}.Build()
+	File_auth_service_v1_auth_service_proto = out.File
+	file_auth_service_v1_auth_service_proto_goTypes = nil
+	file_auth_service_v1_auth_service_proto_depIdxs = nil
+}
@@ -0,0 +1,1997 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: auth_service/v1/auth_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthService_RegisterApplicant_FullMethodName             = "/auth_service.v1.AuthService/RegisterApplicant"
+	AuthService_GetNewApplicantActivationCode_FullMethodName = "/auth_service.v1.AuthService/GetNewApplicantActivationCode"
+	AuthService_ActivateApplicant_FullMethodName             = "/auth_service.v1.AuthService/ActivateApplicant"
+	AuthService_LoginApplicant_FullMethodName                = "/auth_service.v1.AuthService/LoginApplicant"
+	AuthService_RequestApplicantLoginCode_FullMethodName     = "/auth_service.v1.AuthService/RequestApplicantLoginCode"
+	AuthService_LoginApplicantWithCode_FullMethodName        = "/auth_service.v1.AuthService/LoginApplicantWithCode"
+	AuthService_StartApplicantOidcLogin_FullMethodName       = "/auth_service.v1.AuthService/StartApplicantOidcLogin"
+	AuthService_CompleteApplicantOidcLogin_FullMethodName    = "/auth_service.v1.AuthService/CompleteApplicantOidcLogin"
+	AuthService_RegisterApplicantWithOidc_FullMethodName     = "/auth_service.v1.AuthService/RegisterApplicantWithOidc"
+	AuthService_RefreshApplicant_FullMethodName              = "/auth_service.v1.AuthService/RefreshApplicant"
+	AuthService_LogoutApplicant_FullMethodName               = "/auth_service.v1.AuthService/LogoutApplicant"
+	AuthService_GetResetApplicantPasswordCode_FullMethodName = "/auth_service.v1.AuthService/GetResetApplicantPasswordCode"
+	AuthService_ResetApplicantPassword_FullMethodName        = "/auth_service.v1.AuthService/ResetApplicantPassword"
+	AuthService_ChangeApplicantPassword_FullMethodName       = "/auth_service.v1.AuthService/ChangeApplicantPassword"
+	AuthService_ListApplicantSessions_FullMethodName         = "/auth_service.v1.AuthService/ListApplicantSessions"
+	AuthService_RevokeApplicantSession_FullMethodName        = "/auth_service.v1.AuthService/RevokeApplicantSession"
+	AuthService_RevokeOtherApplicantSessions_FullMethodName  = "/auth_service.v1.AuthService/RevokeOtherApplicantSessions"
+	AuthService_EnrollApplicantMfa_FullMethodName            = "/auth_service.v1.AuthService/EnrollApplicantMfa"
+	AuthService_ConfirmApplicantMfa_FullMethodName           = "/auth_service.v1.AuthService/ConfirmApplicantMfa"
+	AuthService_DisableApplicantMfa_FullMethodName           = "/auth_service.v1.AuthService/DisableApplicantMfa"
+	AuthService_VerifyApplicantMfa_FullMethodName            = "/auth_service.v1.AuthService/VerifyApplicantMfa"
+	AuthService_RequestApplicantEmailChange_FullMethodName   = "/auth_service.v1.AuthService/RequestApplicantEmailChange"
+	AuthService_ConfirmApplicantEmailChange_FullMethodName   = "/auth_service.v1.AuthService/ConfirmApplicantEmailChange"
+	AuthService_DeleteApplicantAccount_FullMethodName        = "/auth_service.v1.AuthService/DeleteApplicantAccount"
+	AuthService_RestoreApplicantAccount_FullMethodName       = "/auth_service.v1.AuthService/RestoreApplicantAccount"
+	AuthService_BumpApplicantSecurityVersion_FullMethodName  = "/auth_service.v1.AuthService/BumpApplicantSecurityVersion"
+	AuthService_RegisterEmployer_FullMethodName              = "/auth_service.v1.AuthService/RegisterEmployer"
+	AuthService_GetNewEmployerActivationCode_FullMethodName  = "/auth_service.v1.AuthService/GetNewEmployerActivationCode"
+	AuthService_ActivateEmployer_FullMethodName              = "/auth_service.v1.AuthService/ActivateEmployer"
+	AuthService_LoginEmployer_FullMethodName                 = "/auth_service.v1.AuthService/LoginEmployer"
+	AuthService_RequestEmployerLoginCode_FullMethodName      = "/auth_service.v1.AuthService/RequestEmployerLoginCode"
+	AuthService_LoginEmployerWithCode_FullMethodName         = "/auth_service.v1.AuthService/LoginEmployerWithCode"
+	AuthService_RefreshEmployer_FullMethodName               = "/auth_service.v1.AuthService/RefreshEmployer"
+	AuthService_LogoutEmployer_FullMethodName                = "/auth_service.v1.AuthService/LogoutEmployer"
+	AuthService_GetResetEmployerPasswordCode_FullMethodName  = "/auth_service.v1.AuthService/GetResetEmployerPasswordCode"
+	AuthService_ResetEmployerPassword_FullMethodName         = "/auth_service.v1.AuthService/ResetEmployerPassword"
+	AuthService_ChangeEmployerPassword_FullMethodName        = "/auth_service.v1.AuthService/ChangeEmployerPassword"
+	AuthService_ListEmployerSessions_FullMethodName          = "/auth_service.v1.AuthService/ListEmployerSessions"
+	AuthService_RevokeEmployerSession_FullMethodName         = "/auth_service.v1.AuthService/RevokeEmployerSession"
+	AuthService_RevokeOtherEmployerSessions_FullMethodName   = "/auth_service.v1.AuthService/RevokeOtherEmployerSessions"
+	AuthService_EnrollEmployerMfa_FullMethodName             = "/auth_service.v1.AuthService/EnrollEmployerMfa"
+	AuthService_ConfirmEmployerMfa_FullMethodName            = "/auth_service.v1.AuthService/ConfirmEmployerMfa"
+	AuthService_DisableEmployerMfa_FullMethodName            = "/auth_service.v1.AuthService/DisableEmployerMfa"
+	AuthService_VerifyEmployerMfa_FullMethodName             = "/auth_service.v1.AuthService/VerifyEmployerMfa"
+	AuthService_RequestEmployerEmailChange_FullMethodName    = "/auth_service.v1.AuthService/RequestEmployerEmailChange"
+	AuthService_ConfirmEmployerEmailChange_FullMethodName    = "/auth_service.v1.AuthService/ConfirmEmployerEmailChange"
+	AuthService_DeleteEmployerAccount_FullMethodName         = "/auth_service.v1.AuthService/DeleteEmployerAccount"
+	AuthService_RestoreEmployerAccount_FullMethodName        = "/auth_service.v1.AuthService/RestoreEmployerAccount"
+	AuthService_BumpEmployerSecurityVersion_FullMethodName   = "/auth_service.v1.AuthService/BumpEmployerSecurityVersion"
+	AuthService_IntrospectToken_FullMethodName               = "/auth_service.v1.AuthService/IntrospectToken"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	RegisterApplicant(ctx context.Context, in *RegisterApplicantRequest, opts ...grpc.CallOption) (*RegisterApplicantResponse, error)
+	GetNewApplicantActivationCode(ctx context.Context, in *GetNewApplicantActivationCodeRequest, opts ...grpc.CallOption) (*GetNewApplicantActivationCodeResponse, error)
+	ActivateApplicant(ctx context.Context, in *ActivateApplicantRequest, opts ...grpc.CallOption) (*ActivateApplicantResponse, error)
+	LoginApplicant(ctx context.Context, in *LoginApplicantRequest, opts ...grpc.CallOption) (*LoginApplicantResponse, error)
+	RequestApplicantLoginCode(ctx context.Context, in *RequestApplicantLoginCodeRequest, opts ...grpc.CallOption) (*RequestApplicantLoginCodeResponse, error)
+	LoginApplicantWithCode(ctx context.Context, in *LoginApplicantWithCodeRequest, opts ...grpc.CallOption) (*LoginApplicantWithCodeResponse, error)
+	StartApplicantOidcLogin(ctx context.Context, in *StartApplicantOidcLoginRequest, opts ...grpc.CallOption) (*StartApplicantOidcLoginResponse, error)
+	CompleteApplicantOidcLogin(ctx context.Context, in *CompleteApplicantOidcLoginRequest, opts ...grpc.CallOption) (*CompleteApplicantOidcLoginResponse, error)
+	RegisterApplicantWithOidc(ctx context.Context, in *RegisterApplicantWithOidcRequest, opts ...grpc.CallOption) (*RegisterApplicantWithOidcResponse, error)
+	RefreshApplicant(ctx context.Context, in *RefreshApplicantRequest, opts ...grpc.CallOption) (*RefreshApplicantResponse, error)
+	LogoutApplicant(ctx context.Context, in *LogoutApplicantRequest, opts ...grpc.CallOption) (*LogoutApplicantResponse, error)
+	GetResetApplicantPasswordCode(ctx context.Context, in *GetResetApplicantPasswordCodeRequest, opts ...grpc.CallOption) (*GetResetApplicantPasswordCodeResponse, error)
+	ResetApplicantPassword(ctx context.Context, in *ResetApplicantPasswordRequest, opts ...grpc.CallOption) (*ResetApplicantPasswordResponse, error)
+	ChangeApplicantPassword(ctx context.Context, in *ChangeApplicantPasswordRequest, opts ...grpc.CallOption) (*ChangeApplicantPasswordResponse, error)
+	ListApplicantSessions(ctx context.Context, in *ListApplicantSessionsRequest, opts ...grpc.CallOption) (*ListApplicantSessionsResponse, error)
+	RevokeApplicantSession(ctx context.Context, in *RevokeApplicantSessionRequest, opts ...grpc.CallOption) (*RevokeApplicantSessionResponse, error)
+	RevokeOtherApplicantSessions(ctx context.Context, in *RevokeOtherApplicantSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherApplicantSessionsResponse, error)
+	EnrollApplicantMfa(ctx context.Context, in *EnrollApplicantMfaRequest, opts ...grpc.CallOption) (*EnrollApplicantMfaResponse, error)
+	ConfirmApplicantMfa(ctx context.Context, in *ConfirmApplicantMfaRequest, opts ...grpc.CallOption) (*ConfirmApplicantMfaResponse, error)
+	DisableApplicantMfa(ctx context.Context, in *DisableApplicantMfaRequest, opts ...grpc.CallOption) (*DisableApplicantMfaResponse, error)
+	VerifyApplicantMfa(ctx context.Context, in *VerifyApplicantMfaRequest, opts ...grpc.CallOption) (*VerifyApplicantMfaResponse, error)
+	RequestApplicantEmailChange(ctx context.Context, in *RequestApplicantEmailChangeRequest, opts ...grpc.CallOption) (*RequestApplicantEmailChangeResponse, error)
+	ConfirmApplicantEmailChange(ctx context.Context, in *ConfirmApplicantEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmApplicantEmailChangeResponse, error)
+	DeleteApplicantAccount(ctx context.Context, in *DeleteApplicantAccountRequest, opts ...grpc.CallOption) (*DeleteApplicantAccountResponse, error)
+	RestoreApplicantAccount(ctx context.Context, in *RestoreApplicantAccountRequest, opts ...grpc.CallOption) (*RestoreApplicantAccountResponse, error)
+	// Internal: increments the security version of the applicant so that every
+	// issued access token must be refreshed. Not exposed through the http gateway.
+	BumpApplicantSecurityVersion(ctx context.Context, in *BumpApplicantSecurityVersionRequest, opts ...grpc.CallOption) (*BumpApplicantSecurityVersionResponse, error)
+	RegisterEmployer(ctx context.Context, in *RegisterEmployerRequest, opts ...grpc.CallOption) (*RegisterEmployerResponse, error)
+	GetNewEmployerActivationCode(ctx context.Context, in *GetNewEmployerActivationCodeRequest, opts ...grpc.CallOption) (*GetNewEmployerActivationCodeResponse, error)
+	ActivateEmployer(ctx context.Context, in *ActivateEmployerRequest, opts ...grpc.CallOption) (*ActivateEmployerResponse, error)
+	LoginEmployer(ctx context.Context, in *LoginEmployerRequest, opts ...grpc.CallOption) (*LoginEmployerResponse, error)
+	RequestEmployerLoginCode(ctx context.Context, in *RequestEmployerLoginCodeRequest, opts ...grpc.CallOption) (*RequestEmployerLoginCodeResponse, error)
+	LoginEmployerWithCode(ctx context.Context, in *LoginEmployerWithCodeRequest, opts ...grpc.CallOption) (*LoginEmployerWithCodeResponse, error)
+	RefreshEmployer(ctx context.Context, in *RefreshEmployerRequest, opts ...grpc.CallOption) (*RefreshEmployerResponse, error)
+	LogoutEmployer(ctx context.Context, in *LogoutEmployerRequest, opts ...grpc.CallOption) (*LogoutEmployerResponse, error)
+	GetResetEmployerPasswordCode(ctx context.Context, in *GetResetEmployerPasswordCodeRequest, opts ...grpc.CallOption) (*GetResetEmployerPasswordCodeResponse, error)
+	ResetEmployerPassword(ctx context.Context, in *ResetEmployerPasswordRequest, opts ...grpc.CallOption) (*ResetEmployerPasswordResponse, error)
+	ChangeEmployerPassword(ctx context.Context, in *ChangeEmployerPasswordRequest, opts ...grpc.CallOption) (*ChangeEmployerPasswordResponse, error)
+	ListEmployerSessions(ctx context.Context, in *ListEmployerSessionsRequest, opts ...grpc.CallOption) (*ListEmployerSessionsResponse, error)
+	RevokeEmployerSession(ctx context.Context, in *RevokeEmployerSessionRequest, opts ...grpc.CallOption) (*RevokeEmployerSessionResponse, error)
+	RevokeOtherEmployerSessions(ctx context.Context, in *RevokeOtherEmployerSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherEmployerSessionsResponse, error)
+	EnrollEmployerMfa(ctx context.Context, in *EnrollEmployerMfaRequest, opts ...grpc.CallOption) (*EnrollEmployerMfaResponse, error)
+	ConfirmEmployerMfa(ctx context.Context, in *ConfirmEmployerMfaRequest, opts ...grpc.CallOption) (*ConfirmEmployerMfaResponse, error)
+	DisableEmployerMfa(ctx context.Context, in *DisableEmployerMfaRequest, opts ...grpc.CallOption) (*DisableEmployerMfaResponse, error)
+	VerifyEmployerMfa(ctx context.Context, in *VerifyEmployerMfaRequest, opts ...grpc.CallOption) (*VerifyEmployerMfaResponse, error)
+	RequestEmployerEmailChange(ctx context.Context, in *RequestEmployerEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmployerEmailChangeResponse, error)
+	ConfirmEmployerEmailChange(ctx context.Context, in *ConfirmEmployerEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmployerEmailChangeResponse, error)
+	DeleteEmployerAccount(ctx context.Context, in *DeleteEmployerAccountRequest, opts ...grpc.CallOption) (*DeleteEmployerAccountResponse, error)
+	RestoreEmployerAccount(ctx context.Context, in *RestoreEmployerAccountRequest, opts ...grpc.CallOption) (*RestoreEmployerAccountResponse, error)
+	// Internal: increments the security version of the employer so that every
+	// issued access token must be refreshed. Not exposed through the http gateway.
+	BumpEmployerSecurityVersion(ctx context.Context, in *BumpEmployerSecurityVersionRequest, opts ...grpc.CallOption) (*BumpEmployerSecurityVersionResponse, error)
+	// Internal: tells other services whether an access token is active, in the
+	// spirit of RFC 7662. Revoked, expired and malformed tokens are reported as
+	// inactive rather than as an error. Not exposed through the http gateway.
+	IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) RegisterApplicant(ctx context.Context, in *RegisterApplicantRequest, opts ...grpc.CallOption) (*RegisterApplicantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterApplicantResponse)
+	err := c.cc.Invoke(ctx, AuthService_RegisterApplicant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetNewApplicantActivationCode(ctx context.Context, in *GetNewApplicantActivationCodeRequest, opts ...grpc.CallOption) (*GetNewApplicantActivationCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNewApplicantActivationCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetNewApplicantActivationCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ActivateApplicant(ctx context.Context, in *ActivateApplicantRequest, opts ...grpc.CallOption) (*ActivateApplicantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateApplicantResponse)
+	err := c.cc.Invoke(ctx, AuthService_ActivateApplicant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginApplicant(ctx context.Context, in *LoginApplicantRequest, opts ...grpc.CallOption) (*LoginApplicantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginApplicantResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginApplicant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestApplicantLoginCode(ctx context.Context, in *RequestApplicantLoginCodeRequest, opts ...grpc.CallOption) (*RequestApplicantLoginCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestApplicantLoginCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestApplicantLoginCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginApplicantWithCode(ctx context.Context, in *LoginApplicantWithCodeRequest, opts ...grpc.CallOption) (*LoginApplicantWithCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginApplicantWithCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginApplicantWithCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) StartApplicantOidcLogin(ctx context.Context, in *StartApplicantOidcLoginRequest, opts ...grpc.CallOption) (*StartApplicantOidcLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartApplicantOidcLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_StartApplicantOidcLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CompleteApplicantOidcLogin(ctx context.Context, in *CompleteApplicantOidcLoginRequest, opts ...grpc.CallOption) (*CompleteApplicantOidcLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteApplicantOidcLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_CompleteApplicantOidcLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RegisterApplicantWithOidc(ctx context.Context, in *RegisterApplicantWithOidcRequest, opts ...grpc.CallOption) (*RegisterApplicantWithOidcResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterApplicantWithOidcResponse)
+	err := c.cc.Invoke(ctx, AuthService_RegisterApplicantWithOidc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshApplicant(ctx context.Context, in *RefreshApplicantRequest, opts ...grpc.CallOption) (*RefreshApplicantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshApplicantResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshApplicant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LogoutApplicant(ctx context.Context, in *LogoutApplicantRequest, opts ...grpc.CallOption) (*LogoutApplicantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutApplicantResponse)
+	err := c.cc.Invoke(ctx, AuthService_LogoutApplicant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetResetApplicantPasswordCode(ctx context.Context, in *GetResetApplicantPasswordCodeRequest, opts ...grpc.CallOption) (*GetResetApplicantPasswordCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResetApplicantPasswordCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetResetApplicantPasswordCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetApplicantPassword(ctx context.Context, in *ResetApplicantPasswordRequest, opts ...grpc.CallOption) (*ResetApplicantPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetApplicantPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ResetApplicantPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangeApplicantPassword(ctx context.Context, in *ChangeApplicantPasswordRequest, opts ...grpc.CallOption) (*ChangeApplicantPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeApplicantPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangeApplicantPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListApplicantSessions(ctx context.Context, in *ListApplicantSessionsRequest, opts ...grpc.CallOption) (*ListApplicantSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApplicantSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListApplicantSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeApplicantSession(ctx context.Context, in *RevokeApplicantSessionRequest, opts ...grpc.CallOption) (*RevokeApplicantSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApplicantSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeApplicantSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeOtherApplicantSessions(ctx context.Context, in *RevokeOtherApplicantSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherApplicantSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeOtherApplicantSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeOtherApplicantSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EnrollApplicantMfa(ctx context.Context, in *EnrollApplicantMfaRequest, opts ...grpc.CallOption) (*EnrollApplicantMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollApplicantMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_EnrollApplicantMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmApplicantMfa(ctx context.Context, in *ConfirmApplicantMfaRequest, opts ...grpc.CallOption) (*ConfirmApplicantMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmApplicantMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmApplicantMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DisableApplicantMfa(ctx context.Context, in *DisableApplicantMfaRequest, opts ...grpc.CallOption) (*DisableApplicantMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableApplicantMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_DisableApplicantMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyApplicantMfa(ctx context.Context, in *VerifyApplicantMfaRequest, opts ...grpc.CallOption) (*VerifyApplicantMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyApplicantMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyApplicantMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestApplicantEmailChange(ctx context.Context, in *RequestApplicantEmailChangeRequest, opts ...grpc.CallOption) (*RequestApplicantEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestApplicantEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestApplicantEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmApplicantEmailChange(ctx context.Context, in *ConfirmApplicantEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmApplicantEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmApplicantEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmApplicantEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteApplicantAccount(ctx context.Context, in *DeleteApplicantAccountRequest, opts ...grpc.CallOption) (*DeleteApplicantAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteApplicantAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteApplicantAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RestoreApplicantAccount(ctx context.Context, in *RestoreApplicantAccountRequest, opts ...grpc.CallOption) (*RestoreApplicantAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreApplicantAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_RestoreApplicantAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BumpApplicantSecurityVersion(ctx context.Context, in *BumpApplicantSecurityVersionRequest, opts ...grpc.CallOption) (*BumpApplicantSecurityVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BumpApplicantSecurityVersionResponse)
+	err := c.cc.Invoke(ctx, AuthService_BumpApplicantSecurityVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RegisterEmployer(ctx context.Context, in *RegisterEmployerRequest, opts ...grpc.CallOption) (*RegisterEmployerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterEmployerResponse)
+	err := c.cc.Invoke(ctx, AuthService_RegisterEmployer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetNewEmployerActivationCode(ctx context.Context, in *GetNewEmployerActivationCodeRequest, opts ...grpc.CallOption) (*GetNewEmployerActivationCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNewEmployerActivationCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetNewEmployerActivationCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ActivateEmployer(ctx context.Context, in *ActivateEmployerRequest, opts ...grpc.CallOption) (*ActivateEmployerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateEmployerResponse)
+	err := c.cc.Invoke(ctx, AuthService_ActivateEmployer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginEmployer(ctx context.Context, in *LoginEmployerRequest, opts ...grpc.CallOption) (*LoginEmployerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginEmployerResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginEmployer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestEmployerLoginCode(ctx context.Context, in *RequestEmployerLoginCodeRequest, opts ...grpc.CallOption) (*RequestEmployerLoginCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmployerLoginCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestEmployerLoginCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginEmployerWithCode(ctx context.Context, in *LoginEmployerWithCodeRequest, opts ...grpc.CallOption) (*LoginEmployerWithCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginEmployerWithCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginEmployerWithCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshEmployer(ctx context.Context, in *RefreshEmployerRequest, opts ...grpc.CallOption) (*RefreshEmployerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshEmployerResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshEmployer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LogoutEmployer(ctx context.Context, in *LogoutEmployerRequest, opts ...grpc.CallOption) (*LogoutEmployerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutEmployerResponse)
+	err := c.cc.Invoke(ctx, AuthService_LogoutEmployer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetResetEmployerPasswordCode(ctx context.Context, in *GetResetEmployerPasswordCodeRequest, opts ...grpc.CallOption) (*GetResetEmployerPasswordCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResetEmployerPasswordCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetResetEmployerPasswordCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetEmployerPassword(ctx context.Context, in *ResetEmployerPasswordRequest, opts ...grpc.CallOption) (*ResetEmployerPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetEmployerPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ResetEmployerPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangeEmployerPassword(ctx context.Context, in *ChangeEmployerPasswordRequest, opts ...grpc.CallOption) (*ChangeEmployerPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeEmployerPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangeEmployerPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListEmployerSessions(ctx context.Context, in *ListEmployerSessionsRequest, opts ...grpc.CallOption) (*ListEmployerSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployerSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListEmployerSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeEmployerSession(ctx context.Context, in *RevokeEmployerSessionRequest, opts ...grpc.CallOption) (*RevokeEmployerSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeEmployerSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeEmployerSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeOtherEmployerSessions(ctx context.Context, in *RevokeOtherEmployerSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherEmployerSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeOtherEmployerSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeOtherEmployerSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EnrollEmployerMfa(ctx context.Context, in *EnrollEmployerMfaRequest, opts ...grpc.CallOption) (*EnrollEmployerMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollEmployerMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_EnrollEmployerMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmEmployerMfa(ctx context.Context, in *ConfirmEmployerMfaRequest, opts ...grpc.CallOption) (*ConfirmEmployerMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmployerMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmEmployerMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DisableEmployerMfa(ctx context.Context, in *DisableEmployerMfaRequest, opts ...grpc.CallOption) (*DisableEmployerMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableEmployerMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_DisableEmployerMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyEmployerMfa(ctx context.Context, in *VerifyEmployerMfaRequest, opts ...grpc.CallOption) (*VerifyEmployerMfaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmployerMfaResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyEmployerMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestEmployerEmailChange(ctx context.Context, in *RequestEmployerEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmployerEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmployerEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestEmployerEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmEmployerEmailChange(ctx context.Context, in *ConfirmEmployerEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmployerEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmployerEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmEmployerEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteEmployerAccount(ctx context.Context, in *DeleteEmployerAccountRequest, opts ...grpc.CallOption) (*DeleteEmployerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEmployerAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteEmployerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RestoreEmployerAccount(ctx context.Context, in *RestoreEmployerAccountRequest, opts ...grpc.CallOption) (*RestoreEmployerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreEmployerAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_RestoreEmployerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BumpEmployerSecurityVersion(ctx context.Context, in *BumpEmployerSecurityVersionRequest, opts ...grpc.CallOption) (*BumpEmployerSecurityVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BumpEmployerSecurityVersionResponse)
+	err := c.cc.Invoke(ctx, AuthService_BumpEmployerSecurityVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntrospectTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_IntrospectToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+type AuthServiceServer interface {
+	RegisterApplicant(context.Context, *RegisterApplicantRequest) (*RegisterApplicantResponse, error)
+	GetNewApplicantActivationCode(context.Context, *GetNewApplicantActivationCodeRequest) (*GetNewApplicantActivationCodeResponse, error)
+	ActivateApplicant(context.Context, *ActivateApplicantRequest) (*ActivateApplicantResponse, error)
+	LoginApplicant(context.Context, *LoginApplicantRequest) (*LoginApplicantResponse, error)
+	RequestApplicantLoginCode(context.Context, *RequestApplicantLoginCodeRequest) (*RequestApplicantLoginCodeResponse, error)
+	LoginApplicantWithCode(context.Context, *LoginApplicantWithCodeRequest) (*LoginApplicantWithCodeResponse, error)
+	StartApplicantOidcLogin(context.Context, *StartApplicantOidcLoginRequest) (*StartApplicantOidcLoginResponse, error)
+	CompleteApplicantOidcLogin(context.Context, *CompleteApplicantOidcLoginRequest) (*CompleteApplicantOidcLoginResponse, error)
+	RegisterApplicantWithOidc(context.Context, *RegisterApplicantWithOidcRequest) (*RegisterApplicantWithOidcResponse, error)
+	RefreshApplicant(context.Context, *RefreshApplicantRequest) (*RefreshApplicantResponse, error)
+	LogoutApplicant(context.Context, *LogoutApplicantRequest) (*LogoutApplicantResponse, error)
+	GetResetApplicantPasswordCode(context.Context, *GetResetApplicantPasswordCodeRequest) (*GetResetApplicantPasswordCodeResponse, error)
+	ResetApplicantPassword(context.Context, *ResetApplicantPasswordRequest) (*ResetApplicantPasswordResponse, error)
+	ChangeApplicantPassword(context.Context, *ChangeApplicantPasswordRequest) (*ChangeApplicantPasswordResponse, error)
+	ListApplicantSessions(context.Context, *ListApplicantSessionsRequest) (*ListApplicantSessionsResponse, error)
+	RevokeApplicantSession(context.Context, *RevokeApplicantSessionRequest) (*RevokeApplicantSessionResponse, error)
+	RevokeOtherApplicantSessions(context.Context, *RevokeOtherApplicantSessionsRequest) (*RevokeOtherApplicantSessionsResponse, error)
+	EnrollApplicantMfa(context.Context, *EnrollApplicantMfaRequest) (*EnrollApplicantMfaResponse, error)
+	ConfirmApplicantMfa(context.Context, *ConfirmApplicantMfaRequest) (*ConfirmApplicantMfaResponse, error)
+	DisableApplicantMfa(context.Context, *DisableApplicantMfaRequest) (*DisableApplicantMfaResponse, error)
+	VerifyApplicantMfa(context.Context, *VerifyApplicantMfaRequest) (*VerifyApplicantMfaResponse, error)
+	RequestApplicantEmailChange(context.Context, *RequestApplicantEmailChangeRequest) (*RequestApplicantEmailChangeResponse, error)
+	ConfirmApplicantEmailChange(context.Context, *ConfirmApplicantEmailChangeRequest) (*ConfirmApplicantEmailChangeResponse, error)
+	DeleteApplicantAccount(context.Context, *DeleteApplicantAccountRequest) (*DeleteApplicantAccountResponse, error)
+	RestoreApplicantAccount(context.Context, *RestoreApplicantAccountRequest) (*RestoreApplicantAccountResponse, error)
+	// Internal: increments the security version of the applicant so that every
+	// issued access token must be refreshed. Not exposed through the http gateway.
+	BumpApplicantSecurityVersion(context.Context, *BumpApplicantSecurityVersionRequest) (*BumpApplicantSecurityVersionResponse, error)
+	RegisterEmployer(context.Context, *RegisterEmployerRequest) (*RegisterEmployerResponse, error)
+	GetNewEmployerActivationCode(context.Context, *GetNewEmployerActivationCodeRequest) (*GetNewEmployerActivationCodeResponse, error)
+	ActivateEmployer(context.Context, *ActivateEmployerRequest) (*ActivateEmployerResponse, error)
+	LoginEmployer(context.Context, *LoginEmployerRequest) (*LoginEmployerResponse, error)
+	RequestEmployerLoginCode(context.Context, *RequestEmployerLoginCodeRequest) (*RequestEmployerLoginCodeResponse, error)
+	LoginEmployerWithCode(context.Context, *LoginEmployerWithCodeRequest) (*LoginEmployerWithCodeResponse, error)
+	RefreshEmployer(context.Context, *RefreshEmployerRequest) (*RefreshEmployerResponse, error)
+	LogoutEmployer(context.Context, *LogoutEmployerRequest) (*LogoutEmployerResponse, error)
+	GetResetEmployerPasswordCode(context.Context, *GetResetEmployerPasswordCodeRequest) (*GetResetEmployerPasswordCodeResponse, error)
+	ResetEmployerPassword(context.Context, *ResetEmployerPasswordRequest) (*ResetEmployerPasswordResponse, error)
+	ChangeEmployerPassword(context.Context, *ChangeEmployerPasswordRequest) (*ChangeEmployerPasswordResponse, error)
+	ListEmployerSessions(context.Context, *ListEmployerSessionsRequest) (*ListEmployerSessionsResponse, error)
+	RevokeEmployerSession(context.Context, *RevokeEmployerSessionRequest) (*RevokeEmployerSessionResponse, error)
+	RevokeOtherEmployerSessions(context.Context, *RevokeOtherEmployerSessionsRequest) (*RevokeOtherEmployerSessionsResponse, error)
+	EnrollEmployerMfa(context.Context, *EnrollEmployerMfaRequest) (*EnrollEmployerMfaResponse, error)
+	ConfirmEmployerMfa(context.Context, *ConfirmEmployerMfaRequest) (*ConfirmEmployerMfaResponse, error)
+	DisableEmployerMfa(context.Context, *DisableEmployerMfaRequest) (*DisableEmployerMfaResponse, error)
+	VerifyEmployerMfa(context.Context, *VerifyEmployerMfaRequest) (*VerifyEmployerMfaResponse, error)
+	RequestEmployerEmailChange(context.Context, *RequestEmployerEmailChangeRequest) (*RequestEmployerEmailChangeResponse, error)
+	ConfirmEmployerEmailChange(context.Context, *ConfirmEmployerEmailChangeRequest) (*ConfirmEmployerEmailChangeResponse, error)
+	DeleteEmployerAccount(context.Context, *DeleteEmployerAccountRequest) (*DeleteEmployerAccountResponse, error)
+	RestoreEmployerAccount(context.Context, *RestoreEmployerAccountRequest) (*RestoreEmployerAccountResponse, error)
+	// Internal: increments the security version of the employer so that every
+	// issued access token must be refreshed. Not exposed through the http gateway.
+	BumpEmployerSecurityVersion(context.Context, *BumpEmployerSecurityVersionRequest) (*BumpEmployerSecurityVersionResponse, error)
+	// Internal: tells other services whether an access token is active, in the
+	// spirit of RFC 7662. Revoked, expired and malformed tokens are reported as
+	// inactive rather than as an error. Not exposed through the http gateway.
+	IntrospectToken(context.Context, *IntrospectTokenRequest) (*IntrospectTokenResponse, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) RegisterApplicant(context.Context, *RegisterApplicantRequest) (*RegisterApplicantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterApplicant not implemented")
+}
+func (UnimplementedAuthServiceServer) GetNewApplicantActivationCode(context.Context, *GetNewApplicantActivationCodeRequest) (*GetNewApplicantActivationCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewApplicantActivationCode not implemented")
+}
+func (UnimplementedAuthServiceServer) ActivateApplicant(context.Context, *ActivateApplicantRequest) (*ActivateApplicantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateApplicant not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginApplicant(context.Context, *LoginApplicantRequest) (*LoginApplicantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginApplicant not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestApplicantLoginCode(context.Context, *RequestApplicantLoginCodeRequest) (*RequestApplicantLoginCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestApplicantLoginCode not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginApplicantWithCode(context.Context, *LoginApplicantWithCodeRequest) (*LoginApplicantWithCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginApplicantWithCode not implemented")
+}
+func (UnimplementedAuthServiceServer) StartApplicantOidcLogin(context.Context, *StartApplicantOidcLoginRequest) (*StartApplicantOidcLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartApplicantOidcLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CompleteApplicantOidcLogin(context.Context, *CompleteApplicantOidcLoginRequest) (*CompleteApplicantOidcLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteApplicantOidcLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) RegisterApplicantWithOidc(context.Context, *RegisterApplicantWithOidcRequest) (*RegisterApplicantWithOidcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterApplicantWithOidc not implemented")
+}
+func (UnimplementedAuthServiceServer) RefreshApplicant(context.Context, *RefreshApplicantRequest) (*RefreshApplicantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshApplicant not implemented")
+}
+func (UnimplementedAuthServiceServer) LogoutApplicant(context.Context, *LogoutApplicantRequest) (*LogoutApplicantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutApplicant not implemented")
+}
+func (UnimplementedAuthServiceServer) GetResetApplicantPasswordCode(context.Context, *GetResetApplicantPasswordCodeRequest) (*GetResetApplicantPasswordCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResetApplicantPasswordCode not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetApplicantPassword(context.Context, *ResetApplicantPasswordRequest) (*ResetApplicantPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetApplicantPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangeApplicantPassword(context.Context, *ChangeApplicantPasswordRequest) (*ChangeApplicantPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeApplicantPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) ListApplicantSessions(context.Context, *ListApplicantSessionsRequest) (*ListApplicantSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplicantSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeApplicantSession(context.Context, *RevokeApplicantSessionRequest) (*RevokeApplicantSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApplicantSession not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeOtherApplicantSessions(context.Context, *RevokeOtherApplicantSessionsRequest) (*RevokeOtherApplicantSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherApplicantSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) EnrollApplicantMfa(context.Context, *EnrollApplicantMfaRequest) (*EnrollApplicantMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollApplicantMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmApplicantMfa(context.Context, *ConfirmApplicantMfaRequest) (*ConfirmApplicantMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmApplicantMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) DisableApplicantMfa(context.Context, *DisableApplicantMfaRequest) (*DisableApplicantMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableApplicantMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyApplicantMfa(context.Context, *VerifyApplicantMfaRequest) (*VerifyApplicantMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyApplicantMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestApplicantEmailChange(context.Context, *RequestApplicantEmailChangeRequest) (*RequestApplicantEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestApplicantEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmApplicantEmailChange(context.Context, *ConfirmApplicantEmailChangeRequest) (*ConfirmApplicantEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmApplicantEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteApplicantAccount(context.Context, *DeleteApplicantAccountRequest) (*DeleteApplicantAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplicantAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) RestoreApplicantAccount(context.Context, *RestoreApplicantAccountRequest) (*RestoreApplicantAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreApplicantAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) BumpApplicantSecurityVersion(context.Context, *BumpApplicantSecurityVersionRequest) (*BumpApplicantSecurityVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpApplicantSecurityVersion not implemented")
+}
+func (UnimplementedAuthServiceServer) RegisterEmployer(context.Context, *RegisterEmployerRequest) (*RegisterEmployerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterEmployer not implemented")
+}
+func (UnimplementedAuthServiceServer) GetNewEmployerActivationCode(context.Context, *GetNewEmployerActivationCodeRequest) (*GetNewEmployerActivationCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewEmployerActivationCode not implemented")
+}
+func (UnimplementedAuthServiceServer) ActivateEmployer(context.Context, *ActivateEmployerRequest) (*ActivateEmployerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateEmployer not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginEmployer(context.Context, *LoginEmployerRequest) (*LoginEmployerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginEmployer not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestEmployerLoginCode(context.Context, *RequestEmployerLoginCodeRequest) (*RequestEmployerLoginCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmployerLoginCode not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginEmployerWithCode(context.Context, *LoginEmployerWithCodeRequest) (*LoginEmployerWithCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginEmployerWithCode not implemented")
+}
+func (UnimplementedAuthServiceServer) RefreshEmployer(context.Context, *RefreshEmployerRequest) (*RefreshEmployerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshEmployer not implemented")
+}
+func (UnimplementedAuthServiceServer) LogoutEmployer(context.Context, *LogoutEmployerRequest) (*LogoutEmployerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutEmployer not implemented")
+}
+func (UnimplementedAuthServiceServer) GetResetEmployerPasswordCode(context.Context, *GetResetEmployerPasswordCodeRequest) (*GetResetEmployerPasswordCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResetEmployerPasswordCode not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetEmployerPassword(context.Context, *ResetEmployerPasswordRequest) (*ResetEmployerPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetEmployerPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangeEmployerPassword(context.Context, *ChangeEmployerPasswordRequest) (*ChangeEmployerPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmployerPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) ListEmployerSessions(context.Context, *ListEmployerSessionsRequest) (*ListEmployerSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployerSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeEmployerSession(context.Context, *RevokeEmployerSessionRequest) (*RevokeEmployerSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEmployerSession not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeOtherEmployerSessions(context.Context, *RevokeOtherEmployerSessionsRequest) (*RevokeOtherEmployerSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherEmployerSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) EnrollEmployerMfa(context.Context, *EnrollEmployerMfaRequest) (*EnrollEmployerMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollEmployerMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmEmployerMfa(context.Context, *ConfirmEmployerMfaRequest) (*ConfirmEmployerMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmployerMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) DisableEmployerMfa(context.Context, *DisableEmployerMfaRequest) (*DisableEmployerMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableEmployerMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyEmployerMfa(context.Context, *VerifyEmployerMfaRequest) (*VerifyEmployerMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmployerMfa not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestEmployerEmailChange(context.Context, *RequestEmployerEmailChangeRequest) (*RequestEmployerEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmployerEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmEmployerEmailChange(context.Context, *ConfirmEmployerEmailChangeRequest) (*ConfirmEmployerEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmployerEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteEmployerAccount(context.Context, *DeleteEmployerAccountRequest) (*DeleteEmployerAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployerAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) RestoreEmployerAccount(context.Context, *RestoreEmployerAccountRequest) (*RestoreEmployerAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEmployerAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) BumpEmployerSecurityVersion(context.Context, *BumpEmployerSecurityVersionRequest) (*BumpEmployerSecurityVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpEmployerSecurityVersion not implemented")
+}
+func (UnimplementedAuthServiceServer) IntrospectToken(context.Context, *IntrospectTokenRequest) (*IntrospectTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntrospectToken not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_RegisterApplicant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterApplicantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegisterApplicant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegisterApplicant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegisterApplicant(ctx, req.(*RegisterApplicantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetNewApplicantActivationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewApplicantActivationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetNewApplicantActivationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetNewApplicantActivationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetNewApplicantActivationCode(ctx, req.(*GetNewApplicantActivationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ActivateApplicant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateApplicantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ActivateApplicant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ActivateApplicant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ActivateApplicant(ctx, req.(*ActivateApplicantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginApplicant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginApplicantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginApplicant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginApplicant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginApplicant(ctx, req.(*LoginApplicantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestApplicantLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestApplicantLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestApplicantLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestApplicantLoginCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestApplicantLoginCode(ctx, req.(*RequestApplicantLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginApplicantWithCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginApplicantWithCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginApplicantWithCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginApplicantWithCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginApplicantWithCode(ctx, req.(*LoginApplicantWithCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_StartApplicantOidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartApplicantOidcLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).StartApplicantOidcLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_StartApplicantOidcLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).StartApplicantOidcLogin(ctx, req.(*StartApplicantOidcLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CompleteApplicantOidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteApplicantOidcLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CompleteApplicantOidcLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CompleteApplicantOidcLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CompleteApplicantOidcLogin(ctx, req.(*CompleteApplicantOidcLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RegisterApplicantWithOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterApplicantWithOidcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegisterApplicantWithOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegisterApplicantWithOidc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegisterApplicantWithOidc(ctx, req.(*RegisterApplicantWithOidcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshApplicant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshApplicantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshApplicant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshApplicant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshApplicant(ctx, req.(*RefreshApplicantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LogoutApplicant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutApplicantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LogoutApplicant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LogoutApplicant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LogoutApplicant(ctx, req.(*LogoutApplicantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetResetApplicantPasswordCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResetApplicantPasswordCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetResetApplicantPasswordCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetResetApplicantPasswordCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetResetApplicantPasswordCode(ctx, req.(*GetResetApplicantPasswordCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetApplicantPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetApplicantPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetApplicantPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetApplicantPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetApplicantPassword(ctx, req.(*ResetApplicantPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangeApplicantPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeApplicantPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangeApplicantPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangeApplicantPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangeApplicantPassword(ctx, req.(*ChangeApplicantPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListApplicantSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicantSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListApplicantSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListApplicantSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListApplicantSessions(ctx, req.(*ListApplicantSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeApplicantSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApplicantSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeApplicantSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeApplicantSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeApplicantSession(ctx, req.(*RevokeApplicantSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeOtherApplicantSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherApplicantSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeOtherApplicantSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeOtherApplicantSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeOtherApplicantSessions(ctx, req.(*RevokeOtherApplicantSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EnrollApplicantMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollApplicantMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EnrollApplicantMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EnrollApplicantMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EnrollApplicantMfa(ctx, req.(*EnrollApplicantMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmApplicantMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmApplicantMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmApplicantMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmApplicantMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmApplicantMfa(ctx, req.(*ConfirmApplicantMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DisableApplicantMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableApplicantMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DisableApplicantMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DisableApplicantMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DisableApplicantMfa(ctx, req.(*DisableApplicantMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyApplicantMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyApplicantMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyApplicantMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyApplicantMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyApplicantMfa(ctx, req.(*VerifyApplicantMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestApplicantEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestApplicantEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestApplicantEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestApplicantEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestApplicantEmailChange(ctx, req.(*RequestApplicantEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmApplicantEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmApplicantEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmApplicantEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmApplicantEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmApplicantEmailChange(ctx, req.(*ConfirmApplicantEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteApplicantAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicantAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteApplicantAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteApplicantAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteApplicantAccount(ctx, req.(*DeleteApplicantAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RestoreApplicantAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreApplicantAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RestoreApplicantAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RestoreApplicantAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RestoreApplicantAccount(ctx, req.(*RestoreApplicantAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BumpApplicantSecurityVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpApplicantSecurityVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BumpApplicantSecurityVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BumpApplicantSecurityVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BumpApplicantSecurityVersion(ctx, req.(*BumpApplicantSecurityVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RegisterEmployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterEmployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegisterEmployer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegisterEmployer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegisterEmployer(ctx, req.(*RegisterEmployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetNewEmployerActivationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewEmployerActivationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetNewEmployerActivationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetNewEmployerActivationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetNewEmployerActivationCode(ctx, req.(*GetNewEmployerActivationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ActivateEmployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateEmployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ActivateEmployer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ActivateEmployer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ActivateEmployer(ctx, req.(*ActivateEmployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginEmployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginEmployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginEmployer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginEmployer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginEmployer(ctx, req.(*LoginEmployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestEmployerLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmployerLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestEmployerLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestEmployerLoginCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestEmployerLoginCode(ctx, req.(*RequestEmployerLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginEmployerWithCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginEmployerWithCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginEmployerWithCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginEmployerWithCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginEmployerWithCode(ctx, req.(*LoginEmployerWithCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshEmployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshEmployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshEmployer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshEmployer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshEmployer(ctx, req.(*RefreshEmployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LogoutEmployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutEmployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LogoutEmployer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LogoutEmployer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LogoutEmployer(ctx, req.(*LogoutEmployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetResetEmployerPasswordCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResetEmployerPasswordCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetResetEmployerPasswordCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetResetEmployerPasswordCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetResetEmployerPasswordCode(ctx, req.(*GetResetEmployerPasswordCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetEmployerPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetEmployerPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetEmployerPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetEmployerPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetEmployerPassword(ctx, req.(*ResetEmployerPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangeEmployerPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmployerPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangeEmployerPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangeEmployerPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangeEmployerPassword(ctx, req.(*ChangeEmployerPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListEmployerSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployerSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListEmployerSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListEmployerSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListEmployerSessions(ctx, req.(*ListEmployerSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeEmployerSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeEmployerSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeEmployerSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeEmployerSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeEmployerSession(ctx, req.(*RevokeEmployerSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeOtherEmployerSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherEmployerSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeOtherEmployerSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeOtherEmployerSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeOtherEmployerSessions(ctx, req.(*RevokeOtherEmployerSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EnrollEmployerMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollEmployerMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EnrollEmployerMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EnrollEmployerMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EnrollEmployerMfa(ctx, req.(*EnrollEmployerMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmEmployerMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmployerMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmEmployerMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmEmployerMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmEmployerMfa(ctx, req.(*ConfirmEmployerMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DisableEmployerMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableEmployerMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DisableEmployerMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DisableEmployerMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DisableEmployerMfa(ctx, req.(*DisableEmployerMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyEmployerMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmployerMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyEmployerMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyEmployerMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyEmployerMfa(ctx, req.(*VerifyEmployerMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestEmployerEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmployerEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestEmployerEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestEmployerEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestEmployerEmailChange(ctx, req.(*RequestEmployerEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmEmployerEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmployerEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmEmployerEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmEmployerEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmEmployerEmailChange(ctx, req.(*ConfirmEmployerEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteEmployerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteEmployerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteEmployerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteEmployerAccount(ctx, req.(*DeleteEmployerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RestoreEmployerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEmployerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RestoreEmployerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RestoreEmployerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RestoreEmployerAccount(ctx, req.(*RestoreEmployerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BumpEmployerSecurityVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpEmployerSecurityVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BumpEmployerSecurityVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_BumpEmployerSecurityVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BumpEmployerSecurityVersion(ctx, req.(*BumpEmployerSecurityVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_IntrospectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IntrospectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_IntrospectToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IntrospectToken(ctx, req.(*IntrospectTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth_service.v1.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterApplicant",
+			Handler:    _AuthService_RegisterApplicant_Handler,
+		},
+		{
+			MethodName: "GetNewApplicantActivationCode",
+			Handler:    _AuthService_GetNewApplicantActivationCode_Handler,
+		},
+		{
+			MethodName: "ActivateApplicant",
+			Handler:    _AuthService_ActivateApplicant_Handler,
+		},
+		{
+			MethodName: "LoginApplicant",
+			Handler:    _AuthService_LoginApplicant_Handler,
+		},
+		{
+			MethodName: "RequestApplicantLoginCode",
+			Handler:    _AuthService_RequestApplicantLoginCode_Handler,
+		},
+		{
+			MethodName: "LoginApplicantWithCode",
+			Handler:    _AuthService_LoginApplicantWithCode_Handler,
+		},
+		{
+			MethodName: "StartApplicantOidcLogin",
+			Handler:    _AuthService_StartApplicantOidcLogin_Handler,
+		},
+		{
+			MethodName: "CompleteApplicantOidcLogin",
+			Handler:    _AuthService_CompleteApplicantOidcLogin_Handler,
+		},
+		{
+			MethodName: "RegisterApplicantWithOidc",
+			Handler:    _AuthService_RegisterApplicantWithOidc_Handler,
+		},
+		{
+			MethodName: "RefreshApplicant",
+			Handler:    _AuthService_RefreshApplicant_Handler,
+		},
+		{
+			MethodName: "LogoutApplicant",
+			Handler:    _AuthService_LogoutApplicant_Handler,
+		},
+		{
+			MethodName: "GetResetApplicantPasswordCode",
+			Handler:    _AuthService_GetResetApplicantPasswordCode_Handler,
+		},
+		{
+			MethodName: "ResetApplicantPassword",
+			Handler:    _AuthService_ResetApplicantPassword_Handler,
+		},
+		{
+			MethodName: "ChangeApplicantPassword",
+			Handler:    _AuthService_ChangeApplicantPassword_Handler,
+		},
+		{
+			MethodName: "ListApplicantSessions",
+			Handler:    _AuthService_ListApplicantSessions_Handler,
+		},
+		{
+			MethodName: "RevokeApplicantSession",
+			Handler:    _AuthService_RevokeApplicantSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherApplicantSessions",
+			Handler:    _AuthService_RevokeOtherApplicantSessions_Handler,
+		},
+		{
+			MethodName: "EnrollApplicantMfa",
+			Handler:    _AuthService_EnrollApplicantMfa_Handler,
+		},
+		{
+			MethodName: "ConfirmApplicantMfa",
+			Handler:    _AuthService_ConfirmApplicantMfa_Handler,
+		},
+		{
+			MethodName: "DisableApplicantMfa",
+			Handler:    _AuthService_DisableApplicantMfa_Handler,
+		},
+		{
+			MethodName: "VerifyApplicantMfa",
+			Handler:    _AuthService_VerifyApplicantMfa_Handler,
+		},
+		{
+			MethodName: "RequestApplicantEmailChange",
+			Handler:    _AuthService_RequestApplicantEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmApplicantEmailChange",
+			Handler:    _AuthService_ConfirmApplicantEmailChange_Handler,
+		},
+		{
+			MethodName: "DeleteApplicantAccount",
+			Handler:    _AuthService_DeleteApplicantAccount_Handler,
+		},
+		{
+			MethodName: "RestoreApplicantAccount",
+			Handler:    _AuthService_RestoreApplicantAccount_Handler,
+		},
+		{
+			MethodName: "BumpApplicantSecurityVersion",
+			Handler:    _AuthService_BumpApplicantSecurityVersion_Handler,
+		},
+		{
+			MethodName: "RegisterEmployer",
+			Handler:    _AuthService_RegisterEmployer_Handler,
+		},
+		{
+			MethodName: "GetNewEmployerActivationCode",
+			Handler:    _AuthService_GetNewEmployerActivationCode_Handler,
+		},
+		{
+			MethodName: "ActivateEmployer",
+			Handler:    _AuthService_ActivateEmployer_Handler,
+		},
+		{
+			MethodName: "LoginEmployer",
+			Handler:    _AuthService_LoginEmployer_Handler,
+		},
+		{
+			MethodName: "RequestEmployerLoginCode",
+			Handler:    _AuthService_RequestEmployerLoginCode_Handler,
+		},
+		{
+			MethodName: "LoginEmployerWithCode",
+			Handler:    _AuthService_LoginEmployerWithCode_Handler,
+		},
+		{
+			MethodName: "RefreshEmployer",
+			Handler:    _AuthService_RefreshEmployer_Handler,
+		},
+		{
+			MethodName: "LogoutEmployer",
+			Handler:    _AuthService_LogoutEmployer_Handler,
+		},
+		{
+			MethodName: "GetResetEmployerPasswordCode",
+			Handler:    _AuthService_GetResetEmployerPasswordCode_Handler,
+		},
+		{
+			MethodName: "ResetEmployerPassword",
+			Handler:    _AuthService_ResetEmployerPassword_Handler,
+		},
+		{
+			MethodName: "ChangeEmployerPassword",
+			Handler:    _AuthService_ChangeEmployerPassword_Handler,
+		},
+		{
+			MethodName: "ListEmployerSessions",
+			Handler:    _AuthService_ListEmployerSessions_Handler,
+		},
+		{
+			MethodName: "RevokeEmployerSession",
+			Handler:    _AuthService_RevokeEmployerSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherEmployerSessions",
+			Handler:    _AuthService_RevokeOtherEmployerSessions_Handler,
+		},
+		{
+			MethodName: "EnrollEmployerMfa",
+			Handler:    _AuthService_EnrollEmployerMfa_Handler,
+		},
+		{
+			MethodName: "ConfirmEmployerMfa",
+			Handler:    _AuthService_ConfirmEmployerMfa_Handler,
+		},
+		{
+			MethodName: "DisableEmployerMfa",
+			Handler:    _AuthService_DisableEmployerMfa_Handler,
+		},
+		{
+			MethodName: "VerifyEmployerMfa",
+			Handler:    _AuthService_VerifyEmployerMfa_Handler,
+		},
+		{
+			MethodName: "RequestEmployerEmailChange",
+			Handler:    _AuthService_RequestEmployerEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmployerEmailChange",
+			Handler:    _AuthService_ConfirmEmployerEmailChange_Handler,
+		},
+		{
+			MethodName: "DeleteEmployerAccount",
+			Handler:    _AuthService_DeleteEmployerAccount_Handler,
+		},
+		{
+			MethodName: "RestoreEmployerAccount",
+			Handler:    _AuthService_RestoreEmployerAccount_Handler,
+		},
+		{
+			MethodName: "BumpEmployerSecurityVersion",
+			Handler:    _AuthService_BumpEmployerSecurityVersion_Handler,
+		},
+		{
+			MethodName: "IntrospectToken",
+			Handler:    _AuthService_IntrospectToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth_service/v1/auth_service.proto",
+}
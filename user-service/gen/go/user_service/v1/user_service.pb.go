@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -77,19 +78,22 @@ func (x *Contacts) GetTelegram() string {
 }
 
 type Applicant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Patronymic    *string                `protobuf:"bytes,4,opt,name=patronymic,proto3,oneof" json:"patronymic,omitempty"`
-	BirthDate     string                 `protobuf:"bytes,5,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
-	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-	Contacts      *Contacts              `protobuf:"bytes,8,opt,name=contacts,proto3" json:"contacts,omitempty"`
-	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsDeleted     bool                   `protobuf:"varint,10,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName  string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName   string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Patronymic *string                `protobuf:"bytes,4,opt,name=patronymic,proto3,oneof" json:"patronymic,omitempty"`
+	BirthDate  string                 `protobuf:"bytes,5,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	City       string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	Email      string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	Contacts   *Contacts              `protobuf:"bytes,8,opt,name=contacts,proto3" json:"contacts,omitempty"`
+	IsActive   bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsDeleted  bool                   `protobuf:"varint,10,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// etag changes with every update of the applicant.
+	Etag          string `protobuf:"bytes,14,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -208,6 +212,20 @@ func (x *Applicant) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Applicant) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Applicant) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateApplicantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Applicant     *Applicant             `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
@@ -385,8 +403,14 @@ func (x *ActivateApplicantResponse) GetApplicant() *Applicant {
 }
 
 type UpdateApplicantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// applicant.id selects the applicant, only the fields listed in
+	// update_mask are changed. The email is changed through the
+	// authorization microservice. applicant.etag must be the etag the applicant
+	// was read with: without it the update fails with FAILED_PRECONDITION,
+	// with a stale one with ABORTED.
 	Applicant     *Applicant             `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -428,6 +452,13 @@ func (x *UpdateApplicantRequest) GetApplicant() *Applicant {
 	return nil
 }
 
+func (x *UpdateApplicantRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type UpdateApplicantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Applicant     *Applicant             `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
@@ -904,35 +935,28 @@ func (x *GetApplicantByEmailResponse) GetApplicant() *Applicant {
 	return nil
 }
 
-type Employer struct {
+type ChangeApplicantEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CompanyName   string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
-	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Contacts      *Contacts              `protobuf:"bytes,5,opt,name=contacts,proto3" json:"contacts,omitempty"`
-	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsDeleted     bool                   `protobuf:"varint,7,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Employer) Reset() {
-	*x = Employer{}
+func (x *ChangeApplicantEmailRequest) Reset() {
+	*x = ChangeApplicantEmailRequest{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Employer) String() string {
+func (x *ChangeApplicantEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Employer) ProtoMessage() {}
+func (*ChangeApplicantEmailRequest) ProtoMessage() {}
 
-func (x *Employer) ProtoReflect() protoreflect.Message {
+func (x *ChangeApplicantEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -944,95 +968,46 @@ func (x *Employer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Employer.ProtoReflect.Descriptor instead.
-func (*Employer) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeApplicantEmailRequest.ProtoReflect.Descriptor instead.
+func (*ChangeApplicantEmailRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Employer) GetId() int64 {
+func (x *ChangeApplicantEmailRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Employer) GetCompanyName() string {
-	if x != nil {
-		return x.CompanyName
-	}
-	return ""
-}
-
-func (x *Employer) GetCity() string {
-	if x != nil {
-		return x.City
-	}
-	return ""
-}
-
-func (x *Employer) GetEmail() string {
+func (x *ChangeApplicantEmailRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *Employer) GetContacts() *Contacts {
-	if x != nil {
-		return x.Contacts
-	}
-	return nil
-}
-
-func (x *Employer) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-func (x *Employer) GetIsDeleted() bool {
-	if x != nil {
-		return x.IsDeleted
-	}
-	return false
-}
-
-func (x *Employer) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Employer) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type CreateEmployerRequest struct {
+type ChangeApplicantEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	Applicant     *Applicant             `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEmployerRequest) Reset() {
-	*x = CreateEmployerRequest{}
+func (x *ChangeApplicantEmailResponse) Reset() {
+	*x = ChangeApplicantEmailResponse{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEmployerRequest) String() string {
+func (x *ChangeApplicantEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmployerRequest) ProtoMessage() {}
+func (*ChangeApplicantEmailResponse) ProtoMessage() {}
 
-func (x *CreateEmployerRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeApplicantEmailResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1044,39 +1019,39 @@ func (x *CreateEmployerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmployerRequest.ProtoReflect.Descriptor instead.
-func (*CreateEmployerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeApplicantEmailResponse.ProtoReflect.Descriptor instead.
+func (*ChangeApplicantEmailResponse) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateEmployerRequest) GetEmployer() *Employer {
+func (x *ChangeApplicantEmailResponse) GetApplicant() *Applicant {
 	if x != nil {
-		return x.Employer
+		return x.Applicant
 	}
 	return nil
 }
 
-type CreateEmployerResponse struct {
+type RestoreApplicantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEmployerResponse) Reset() {
-	*x = CreateEmployerResponse{}
+func (x *RestoreApplicantRequest) Reset() {
+	*x = RestoreApplicantRequest{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEmployerResponse) String() string {
+func (x *RestoreApplicantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmployerResponse) ProtoMessage() {}
+func (*RestoreApplicantRequest) ProtoMessage() {}
 
-func (x *CreateEmployerResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreApplicantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1088,39 +1063,39 @@ func (x *CreateEmployerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmployerResponse.ProtoReflect.Descriptor instead.
-func (*CreateEmployerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreApplicantRequest.ProtoReflect.Descriptor instead.
+func (*RestoreApplicantRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateEmployerResponse) GetEmployer() *Employer {
+func (x *RestoreApplicantRequest) GetId() int64 {
 	if x != nil {
-		return x.Employer
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ActivateEmployerRequest struct {
+type RestoreApplicantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Applicant     *Applicant             `protobuf:"bytes,1,opt,name=applicant,proto3" json:"applicant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateEmployerRequest) Reset() {
-	*x = ActivateEmployerRequest{}
+func (x *RestoreApplicantResponse) Reset() {
+	*x = RestoreApplicantResponse{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateEmployerRequest) String() string {
+func (x *RestoreApplicantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateEmployerRequest) ProtoMessage() {}
+func (*RestoreApplicantResponse) ProtoMessage() {}
 
-func (x *ActivateEmployerRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreApplicantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1132,39 +1107,39 @@ func (x *ActivateEmployerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateEmployerRequest.ProtoReflect.Descriptor instead.
-func (*ActivateEmployerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreApplicantResponse.ProtoReflect.Descriptor instead.
+func (*RestoreApplicantResponse) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ActivateEmployerRequest) GetId() int64 {
+func (x *RestoreApplicantResponse) GetApplicant() *Applicant {
 	if x != nil {
-		return x.Id
+		return x.Applicant
 	}
-	return 0
+	return nil
 }
 
-type ActivateEmployerResponse struct {
+type GetRestorableApplicantsByEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateEmployerResponse) Reset() {
-	*x = ActivateEmployerResponse{}
+func (x *GetRestorableApplicantsByEmailRequest) Reset() {
+	*x = GetRestorableApplicantsByEmailRequest{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateEmployerResponse) String() string {
+func (x *GetRestorableApplicantsByEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateEmployerResponse) ProtoMessage() {}
+func (*GetRestorableApplicantsByEmailRequest) ProtoMessage() {}
 
-func (x *ActivateEmployerResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRestorableApplicantsByEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1176,39 +1151,39 @@ func (x *ActivateEmployerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateEmployerResponse.ProtoReflect.Descriptor instead.
-func (*ActivateEmployerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRestorableApplicantsByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetRestorableApplicantsByEmailRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ActivateEmployerResponse) GetEmployer() *Employer {
+func (x *GetRestorableApplicantsByEmailRequest) GetEmail() string {
 	if x != nil {
-		return x.Employer
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type UpdateEmployerRequest struct {
+type GetRestorableApplicantsByEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	Applicants    []*Applicant           `protobuf:"bytes,1,rep,name=applicants,proto3" json:"applicants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployerRequest) Reset() {
-	*x = UpdateEmployerRequest{}
+func (x *GetRestorableApplicantsByEmailResponse) Reset() {
+	*x = GetRestorableApplicantsByEmailResponse{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployerRequest) String() string {
+func (x *GetRestorableApplicantsByEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployerRequest) ProtoMessage() {}
+func (*GetRestorableApplicantsByEmailResponse) ProtoMessage() {}
 
-func (x *UpdateEmployerRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRestorableApplicantsByEmailResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1220,39 +1195,50 @@ func (x *UpdateEmployerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEmployerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRestorableApplicantsByEmailResponse.ProtoReflect.Descriptor instead.
+func (*GetRestorableApplicantsByEmailResponse) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateEmployerRequest) GetEmployer() *Employer {
+func (x *GetRestorableApplicantsByEmailResponse) GetApplicants() []*Applicant {
 	if x != nil {
-		return x.Employer
+		return x.Applicants
 	}
 	return nil
 }
 
-type UpdateEmployerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+type Employer struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyName string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	City        string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Email       string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Contacts    *Contacts              `protobuf:"bytes,5,opt,name=contacts,proto3" json:"contacts,omitempty"`
+	IsActive    bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsDeleted   bool                   `protobuf:"varint,7,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// etag changes with every update of the employer.
+	Etag          string `protobuf:"bytes,11,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployerResponse) Reset() {
-	*x = UpdateEmployerResponse{}
+func (x *Employer) Reset() {
+	*x = Employer{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployerResponse) String() string {
+func (x *Employer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployerResponse) ProtoMessage() {}
+func (*Employer) ProtoMessage() {}
 
-func (x *UpdateEmployerResponse) ProtoReflect() protoreflect.Message {
+func (x *Employer) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1264,39 +1250,109 @@ func (x *UpdateEmployerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployerResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEmployerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Employer.ProtoReflect.Descriptor instead.
+func (*Employer) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateEmployerResponse) GetEmployer() *Employer {
+func (x *Employer) GetId() int64 {
 	if x != nil {
-		return x.Employer
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Employer) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *Employer) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Employer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Employer) GetContacts() *Contacts {
+	if x != nil {
+		return x.Contacts
 	}
 	return nil
 }
 
-type DeleteEmployerRequest struct {
+func (x *Employer) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Employer) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *Employer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Employer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Employer) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Employer) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CreateEmployerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEmployerRequest) Reset() {
-	*x = DeleteEmployerRequest{}
+func (x *CreateEmployerRequest) Reset() {
+	*x = CreateEmployerRequest{}
 	mi := &file_user_service_v1_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployerRequest) String() string {
+func (x *CreateEmployerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployerRequest) ProtoMessage() {}
+func (*CreateEmployerRequest) ProtoMessage() {}
 
-func (x *DeleteEmployerRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateEmployerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_v1_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1308,12 +1364,289 @@ func (x *DeleteEmployerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEmployerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateEmployerRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmployerRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteEmployerRequest) GetId() int64 {
+func (x *CreateEmployerRequest) GetEmployer() *Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type CreateEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmployerResponse) Reset() {
+	*x = CreateEmployerResponse{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployerResponse) ProtoMessage() {}
+
+func (x *CreateEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployerResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateEmployerResponse) GetEmployer() *Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type ActivateEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateEmployerRequest) Reset() {
+	*x = ActivateEmployerRequest{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEmployerRequest) ProtoMessage() {}
+
+func (x *ActivateEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEmployerRequest.ProtoReflect.Descriptor instead.
+func (*ActivateEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ActivateEmployerRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ActivateEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateEmployerResponse) Reset() {
+	*x = ActivateEmployerResponse{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEmployerResponse) ProtoMessage() {}
+
+func (x *ActivateEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEmployerResponse.ProtoReflect.Descriptor instead.
+func (*ActivateEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ActivateEmployerResponse) GetEmployer() *Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type UpdateEmployerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// employer.id selects the employer, only the fields listed in
+	// update_mask are changed. The email is changed through the
+	// authorization microservice. employer.etag must be the etag the employer
+	// was read with: without it the update fails with FAILED_PRECONDITION,
+	// with a stale one with ABORTED.
+	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployerRequest) Reset() {
+	*x = UpdateEmployerRequest{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployerRequest) ProtoMessage() {}
+
+func (x *UpdateEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateEmployerRequest) GetEmployer() *Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+func (x *UpdateEmployerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployerResponse) Reset() {
+	*x = UpdateEmployerResponse{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployerResponse) ProtoMessage() {}
+
+func (x *UpdateEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateEmployerResponse) GetEmployer() *Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type DeleteEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployerRequest) Reset() {
+	*x = DeleteEmployerRequest{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployerRequest) ProtoMessage() {}
+
+func (x *DeleteEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteEmployerRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
@@ -1329,7 +1662,7 @@ type DeleteEmployerResponse struct {
 
 func (x *DeleteEmployerResponse) Reset() {
 	*x = DeleteEmployerResponse{}
-	mi := &file_user_service_v1_user_service_proto_msgTypes[24]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1341,7 +1674,7 @@ func (x *DeleteEmployerResponse) String() string {
 func (*DeleteEmployerResponse) ProtoMessage() {}
 
 func (x *DeleteEmployerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_v1_user_service_proto_msgTypes[24]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1687,7 @@ func (x *DeleteEmployerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEmployerResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEmployerResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{24}
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteEmployerResponse) GetEmployer() *Employer {
@@ -1385,7 +1718,7 @@ type QueryEmployersRequest struct {
 
 func (x *QueryEmployersRequest) Reset() {
 	*x = QueryEmployersRequest{}
-	mi := &file_user_service_v1_user_service_proto_msgTypes[25]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1397,7 +1730,7 @@ func (x *QueryEmployersRequest) String() string {
 func (*QueryEmployersRequest) ProtoMessage() {}
 
 func (x *QueryEmployersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_v1_user_service_proto_msgTypes[25]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,7 +1743,7 @@ func (x *QueryEmployersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryEmployersRequest.ProtoReflect.Descriptor instead.
 func (*QueryEmployersRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{25}
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryEmployersRequest) GetIds() []int64 {
@@ -1513,7 +1846,7 @@ type QueryEmployersResponse struct {
 
 func (x *QueryEmployersResponse) Reset() {
 	*x = QueryEmployersResponse{}
-	mi := &file_user_service_v1_user_service_proto_msgTypes[26]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1525,7 +1858,7 @@ func (x *QueryEmployersResponse) String() string {
 func (*QueryEmployersResponse) ProtoMessage() {}
 
 func (x *QueryEmployersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_v1_user_service_proto_msgTypes[26]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +1871,7 @@ func (x *QueryEmployersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryEmployersResponse.ProtoReflect.Descriptor instead.
 func (*QueryEmployersResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{26}
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QueryEmployersResponse) GetEmployers() []*Employer {
@@ -1557,7 +1890,7 @@ type GetEmployerRequest struct {
 
 func (x *GetEmployerRequest) Reset() {
 	*x = GetEmployerRequest{}
-	mi := &file_user_service_v1_user_service_proto_msgTypes[27]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1569,7 +1902,7 @@ func (x *GetEmployerRequest) String() string {
 func (*GetEmployerRequest) ProtoMessage() {}
 
 func (x *GetEmployerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_v1_user_service_proto_msgTypes[27]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +1915,7 @@ func (x *GetEmployerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployerRequest.ProtoReflect.Descriptor instead.
 func (*GetEmployerRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{27}
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetEmployerRequest) GetId() int64 {
@@ -1601,7 +1934,7 @@ type GetEmployerResponse struct {
 
 func (x *GetEmployerResponse) Reset() {
 	*x = GetEmployerResponse{}
-	mi := &file_user_service_v1_user_service_proto_msgTypes[28]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1613,7 +1946,7 @@ func (x *GetEmployerResponse) String() string {
 func (*GetEmployerResponse) ProtoMessage() {}
 
 func (x *GetEmployerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_v1_user_service_proto_msgTypes[28]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +1959,7 @@ func (x *GetEmployerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployerResponse.ProtoReflect.Descriptor instead.
 func (*GetEmployerResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{28}
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetEmployerResponse) GetEmployer() *Employer {
@@ -1645,7 +1978,7 @@ type GetEmployerByEmailRequest struct {
 
 func (x *GetEmployerByEmailRequest) Reset() {
 	*x = GetEmployerByEmailRequest{}
-	mi := &file_user_service_v1_user_service_proto_msgTypes[29]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1657,7 +1990,7 @@ func (x *GetEmployerByEmailRequest) String() string {
 func (*GetEmployerByEmailRequest) ProtoMessage() {}
 
 func (x *GetEmployerByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_v1_user_service_proto_msgTypes[29]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,7 +2003,7 @@ func (x *GetEmployerByEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployerByEmailRequest.ProtoReflect.Descriptor instead.
 func (*GetEmployerByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{29}
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetEmployerByEmailRequest) GetEmail() string {
@@ -1689,7 +2022,7 @@ type GetEmployerByEmailResponse struct {
 
 func (x *GetEmployerByEmailResponse) Reset() {
 	*x = GetEmployerByEmailResponse{}
-	mi := &file_user_service_v1_user_service_proto_msgTypes[30]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +2034,7 @@ func (x *GetEmployerByEmailResponse) String() string {
 func (*GetEmployerByEmailResponse) ProtoMessage() {}
 
 func (x *GetEmployerByEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_v1_user_service_proto_msgTypes[30]
+	mi := &file_user_service_v1_user_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2047,7 @@ func (x *GetEmployerByEmailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployerByEmailResponse.ProtoReflect.Descriptor instead.
 func (*GetEmployerByEmailResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{30}
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetEmployerByEmailResponse) GetEmployer() *Employer {
@@ -1724,16 +2057,288 @@ func (x *GetEmployerByEmailResponse) GetEmployer() *Employer {
 	return nil
 }
 
+type ChangeEmployerEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEmployerEmailRequest) Reset() {
+	*x = ChangeEmployerEmailRequest{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmployerEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmployerEmailRequest) ProtoMessage() {}
+
+func (x *ChangeEmployerEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmployerEmailRequest.ProtoReflect.Descriptor instead.
+func (*ChangeEmployerEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ChangeEmployerEmailRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeEmployerEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ChangeEmployerEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEmployerEmailResponse) Reset() {
+	*x = ChangeEmployerEmailResponse{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmployerEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmployerEmailResponse) ProtoMessage() {}
+
+func (x *ChangeEmployerEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmployerEmailResponse.ProtoReflect.Descriptor instead.
+func (*ChangeEmployerEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChangeEmployerEmailResponse) GetEmployer() *Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type RestoreEmployerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployerRequest) Reset() {
+	*x = RestoreEmployerRequest{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployerRequest) ProtoMessage() {}
+
+func (x *RestoreEmployerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployerRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEmployerRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreEmployerRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreEmployerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employer      *Employer              `protobuf:"bytes,1,opt,name=employer,proto3" json:"employer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployerResponse) Reset() {
+	*x = RestoreEmployerResponse{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployerResponse) ProtoMessage() {}
+
+func (x *RestoreEmployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployerResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEmployerResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreEmployerResponse) GetEmployer() *Employer {
+	if x != nil {
+		return x.Employer
+	}
+	return nil
+}
+
+type GetRestorableEmployersByEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestorableEmployersByEmailRequest) Reset() {
+	*x = GetRestorableEmployersByEmailRequest{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestorableEmployersByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestorableEmployersByEmailRequest) ProtoMessage() {}
+
+func (x *GetRestorableEmployersByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestorableEmployersByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetRestorableEmployersByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetRestorableEmployersByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetRestorableEmployersByEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employers     []*Employer            `protobuf:"bytes,1,rep,name=employers,proto3" json:"employers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestorableEmployersByEmailResponse) Reset() {
+	*x = GetRestorableEmployersByEmailResponse{}
+	mi := &file_user_service_v1_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestorableEmployersByEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestorableEmployersByEmailResponse) ProtoMessage() {}
+
+func (x *GetRestorableEmployersByEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_v1_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestorableEmployersByEmailResponse.ProtoReflect.Descriptor instead.
+func (*GetRestorableEmployersByEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_v1_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetRestorableEmployersByEmailResponse) GetEmployers() []*Employer {
+	if x != nil {
+		return x.Employers
+	}
+	return nil
+}
+
 var File_user_service_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_user_service_v1_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\"user_service/v1/user_service.proto\x12\x0fuser_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"q\n" +
+	"\"user_service/v1/user_service.proto\x12\x0fuser_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\"q\n" +
 	"\bContacts\x12&\n" +
 	"\fphone_number\x18\x01 \x01(\tH\x00R\vphoneNumber\x88\x01\x01\x12\x1f\n" +
 	"\btelegram\x18\x02 \x01(\tH\x01R\btelegram\x88\x01\x01B\x0f\n" +
 	"\r_phone_numberB\v\n" +
-	"\t_telegram\"\xce\x03\n" +
+	"\t_telegram\"\xb1\x04\n" +
 	"\tApplicant\x12\x1f\n" +
 	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\x12\x1d\n" +
 	"\n" +
@@ -1754,8 +2359,12 @@ const file_user_service_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
-	"\v_patronymic\"R\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tdeletedAt\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x0e \x01(\tR\x04etagB\r\n" +
+	"\v_patronymicB\r\n" +
+	"\v_deleted_at\"R\n" +
 	"\x16CreateApplicantRequest\x128\n" +
 	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"S\n" +
 	"\x17CreateApplicantResponse\x128\n" +
@@ -1763,9 +2372,11 @@ const file_user_service_v1_user_service_proto_rawDesc = "" +
 	"\x18ActivateApplicantRequest\x12\x1f\n" +
 	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\"U\n" +
 	"\x19ActivateApplicantResponse\x128\n" +
-	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"R\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"\x8f\x01\n" +
 	"\x16UpdateApplicantRequest\x128\n" +
-	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"S\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"S\n" +
 	"\x17UpdateApplicantResponse\x128\n" +
 	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"9\n" +
 	"\x16DeleteApplicantRequest\x12\x1f\n" +
@@ -1807,7 +2418,22 @@ const file_user_service_v1_user_service_proto_rawDesc = "" +
 	"\x1aGetApplicantByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"W\n" +
 	"\x1bGetApplicantByEmailResponse\x128\n" +
-	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"\xe1\x02\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"T\n" +
+	"\x1bChangeApplicantEmailRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"X\n" +
+	"\x1cChangeApplicantEmailResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\":\n" +
+	"\x17RestoreApplicantRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\"T\n" +
+	"\x18RestoreApplicantResponse\x128\n" +
+	"\tapplicant\x18\x01 \x01(\v2\x1a.user_service.v1.ApplicantR\tapplicant\"=\n" +
+	"%GetRestorableApplicantsByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"d\n" +
+	"&GetRestorableApplicantsByEmailResponse\x12:\n" +
+	"\n" +
+	"applicants\x18\x01 \x03(\v2\x1a.user_service.v1.ApplicantR\n" +
+	"applicants\"\xc4\x03\n" +
 	"\bEmployer\x12\x1f\n" +
 	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\x12!\n" +
 	"\fcompany_name\x18\x02 \x01(\tR\vcompanyName\x12\x12\n" +
@@ -1820,7 +2446,12 @@ const file_user_service_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"N\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\v \x01(\tR\x04etagB\r\n" +
+	"\v_deleted_at\"N\n" +
 	"\x15CreateEmployerRequest\x125\n" +
 	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"O\n" +
 	"\x16CreateEmployerResponse\x125\n" +
@@ -1828,9 +2459,11 @@ const file_user_service_v1_user_service_proto_rawDesc = "" +
 	"\x17ActivateEmployerRequest\x12\x1f\n" +
 	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\"Q\n" +
 	"\x18ActivateEmployerResponse\x125\n" +
-	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"N\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"\x8b\x01\n" +
 	"\x15UpdateEmployerRequest\x125\n" +
-	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"O\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"O\n" +
 	"\x16UpdateEmployerResponse\x125\n" +
 	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"8\n" +
 	"\x15DeleteEmployerRequest\x12\x1f\n" +
@@ -1872,43 +2505,59 @@ const file_user_service_v1_user_service_proto_rawDesc = "" +
 	"\x19GetEmployerByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"S\n" +
 	"\x1aGetEmployerByEmailResponse\x125\n" +
-	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer2\xfa\x1b\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"S\n" +
+	"\x1aChangeEmployerEmailRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"T\n" +
+	"\x1bChangeEmployerEmailResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"9\n" +
+	"\x16RestoreEmployerRequest\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\x92A\f\x9a\x02\x01\x03\xa2\x02\x05int64R\x02id\"P\n" +
+	"\x17RestoreEmployerResponse\x125\n" +
+	"\bemployer\x18\x01 \x01(\v2\x19.user_service.v1.EmployerR\bemployer\"<\n" +
+	"$GetRestorableEmployersByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"`\n" +
+	"%GetRestorableEmployersByEmailResponse\x127\n" +
+	"\temployers\x18\x01 \x03(\v2\x19.user_service.v1.EmployerR\temployers2\xef\"\n" +
 	"\vUserService\x12\xf5\x01\n" +
 	"\x0fCreateApplicant\x12'.user_service.v1.CreateApplicantRequest\x1a(.user_service.v1.CreateApplicantResponse\"\x8e\x01\x92Ao\n" +
 	"\n" +
 	"applicants\x12\x10Create applicant\x1aOCreates applicant. Required for registration in the authorization microservice.\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/applicant\x12\x88\x02\n" +
 	"\x11ActivateApplicant\x12).user_service.v1.ActivateApplicantRequest\x1a*.user_service.v1.ActivateApplicantResponse\"\x9b\x01\x92Aq\n" +
 	"\n" +
-	"applicants\x12\x12Activate applicant\x1aOActivates applicant. Required for activation in the authorization microservice.\x82\xd3\xe4\x93\x02!\"\x1f/api/v1/applicant/activate/{id}\x12\xfa\x01\n" +
-	"\x0fUpdateApplicant\x12'.user_service.v1.UpdateApplicantRequest\x1a(.user_service.v1.UpdateApplicantResponse\"\x93\x01\x92At\n" +
-	"\n" +
-	"applicants\x12\x10Update applicant\x1aTUpdates applicant. Can only be called by an authorized user to update their profile.\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/v1/applicant\x12\xf9\x01\n" +
-	"\x0fDeleteApplicant\x12'.user_service.v1.DeleteApplicantRequest\x1a(.user_service.v1.DeleteApplicantResponse\"\x92\x01\x92Aq\n" +
+	"applicants\x12\x12Activate applicant\x1aOActivates applicant. Required for activation in the authorization microservice.\x82\xd3\xe4\x93\x02!\"\x1f/api/v1/applicant/activate/{id}\x12\xfe\x02\n" +
+	"\x0fUpdateApplicant\x12'.user_service.v1.UpdateApplicantRequest\x1a(.user_service.v1.UpdateApplicantResponse\"\x97\x02\x92A\xf7\x01\n" +
 	"\n" +
-	"applicants\x12\x10Delete applicant\x1aQDeletes applicant. Only an authorized user can call this to delete their profile.\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/applicant/{id}\x12\xef\x01\n" +
-	"\x0fQueryApplicants\x12'.user_service.v1.QueryApplicantsRequest\x1a(.user_service.v1.QueryApplicantsResponse\"\x88\x01\x92Ac\n" +
+	"applicants\x12\x10Update applicant\x1a\xd6\x01Updates the fields of the applicant listed in update_mask. Can only be called by an authorized user to update their profile. Requires the etag the applicant was read with, so a concurrent update is not overwritten.\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/v1/applicant\x12d\n" +
+	"\x0fDeleteApplicant\x12'.user_service.v1.DeleteApplicantRequest\x1a(.user_service.v1.DeleteApplicantResponse\x12\xab\x02\n" +
+	"\x0fQueryApplicants\x12'.user_service.v1.QueryApplicantsRequest\x1a(.user_service.v1.QueryApplicantsResponse\"\xc4\x01\x92A\x9e\x01\n" +
 	"\n" +
-	"applicants\x12\x10Query applicants\x1aCReturns applicants. Needed to retrieve data in other microservices.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/applicant/query\x12\x83\x02\n" +
-	"\fGetApplicant\x12$.user_service.v1.GetApplicantRequest\x1a%.user_service.v1.GetApplicantResponse\"\xa5\x01\x92A\x83\x01\n" +
+	"applicants\x12\x10Query applicants\x1a~Returns applicants. Needed to retrieve data in other microservices. End users need an access token and cannot filter by email.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/applicant/query\x12\xa4\x02\n" +
+	"\fGetApplicant\x12$.user_service.v1.GetApplicantRequest\x1a%.user_service.v1.GetApplicantResponse\"\xc6\x01\x92A\xa4\x01\n" +
 	"\n" +
-	"applicants\x12\rGet applicant\x1afReturns applicant by id including deeleted applicants. Needed to retrieve data in other microservices.\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/applicant/{id}\x12\x99\x02\n" +
+	"applicants\x12\rGet applicant\x1a\x86\x01Returns applicant by id including deeleted applicants. Needed to retrieve data in other microservices. End users need an access token.\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/applicant/{id}\x12\x99\x02\n" +
 	"\x13GetApplicantByEmail\x12+.user_service.v1.GetApplicantByEmailRequest\x1a,.user_service.v1.GetApplicantByEmailResponse\"\xa6\x01\x92Ay\n" +
 	"\n" +
-	"applicants\x12\x16Get applicant by email\x1aSReturns not deleted applicant by email. Required in the authorization microservice.\x82\xd3\xe4\x93\x02$\x12\"/api/v1/applicant/by-email/{email}\x12\xee\x01\n" +
+	"applicants\x12\x16Get applicant by email\x1aSReturns not deleted applicant by email. Required in the authorization microservice.\x82\xd3\xe4\x93\x02$\x12\"/api/v1/applicant/by-email/{email}\x12s\n" +
+	"\x14ChangeApplicantEmail\x12,.user_service.v1.ChangeApplicantEmailRequest\x1a-.user_service.v1.ChangeApplicantEmailResponse\x12g\n" +
+	"\x10RestoreApplicant\x12(.user_service.v1.RestoreApplicantRequest\x1a).user_service.v1.RestoreApplicantResponse\x12\x91\x01\n" +
+	"\x1eGetRestorableApplicantsByEmail\x126.user_service.v1.GetRestorableApplicantsByEmailRequest\x1a7.user_service.v1.GetRestorableApplicantsByEmailResponse\x12\xee\x01\n" +
 	"\x0eCreateEmployer\x12&.user_service.v1.CreateEmployerRequest\x1a'.user_service.v1.CreateEmployerResponse\"\x8a\x01\x92Al\n" +
 	"\temployers\x12\x0fCreate employer\x1aNCreates employer. Required for registration in the authorization microservice.\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/employer\x12\x81\x02\n" +
 	"\x10ActivateEmployer\x12(.user_service.v1.ActivateEmployerRequest\x1a).user_service.v1.ActivateEmployerResponse\"\x97\x01\x92An\n" +
-	"\temployers\x12\x11Activate employer\x1aNActivates employer. Required for activation in the authorization microservice.\x82\xd3\xe4\x93\x02 \"\x1e/api/v1/employer/activate/{id}\x12\xf3\x01\n" +
-	"\x0eUpdateEmployer\x12&.user_service.v1.UpdateEmployerRequest\x1a'.user_service.v1.UpdateEmployerResponse\"\x8f\x01\x92Aq\n" +
-	"\temployers\x12\x0fUpdate employer\x1aSUpdates employer. Can only be called by an authorized user to update their profile.\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/api/v1/employer\x12\xf2\x01\n" +
-	"\x0eDeleteEmployer\x12&.user_service.v1.DeleteEmployerRequest\x1a'.user_service.v1.DeleteEmployerResponse\"\x8e\x01\x92An\n" +
-	"\temployers\x12\x0fDelete employer\x1aPDeletes employer. Only an authorized user can call this to delete their profile.\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/employer/{id}\x12\xe8\x01\n" +
-	"\x0eQueryEmployers\x12&.user_service.v1.QueryEmployersRequest\x1a'.user_service.v1.QueryEmployersResponse\"\x84\x01\x92A`\n" +
-	"\temployers\x12\x0fQuery employers\x1aBReturns employers. Needed to retrieve data in other microservices.\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/employer/query\x12\xfa\x01\n" +
-	"\vGetEmployer\x12#.user_service.v1.GetEmployerRequest\x1a$.user_service.v1.GetEmployerResponse\"\x9f\x01\x92A\x7f\n" +
-	"\temployers\x12\fGet employer\x1adReturns employer by id including deeleted employers. Needed to retrieve data in other microservices.\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/employer/{id}\x12\x92\x02\n" +
+	"\temployers\x12\x11Activate employer\x1aNActivates employer. Required for activation in the authorization microservice.\x82\xd3\xe4\x93\x02 \"\x1e/api/v1/employer/activate/{id}\x12\xf6\x02\n" +
+	"\x0eUpdateEmployer\x12&.user_service.v1.UpdateEmployerRequest\x1a'.user_service.v1.UpdateEmployerResponse\"\x92\x02\x92A\xf3\x01\n" +
+	"\temployers\x12\x0fUpdate employer\x1a\xd4\x01Updates the fields of the employer listed in update_mask. Can only be called by an authorized user to update their profile. Requires the etag the employer was read with, so a concurrent update is not overwritten.\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/api/v1/employer\x12a\n" +
+	"\x0eDeleteEmployer\x12&.user_service.v1.DeleteEmployerRequest\x1a'.user_service.v1.DeleteEmployerResponse\x12\xa4\x02\n" +
+	"\x0eQueryEmployers\x12&.user_service.v1.QueryEmployersRequest\x1a'.user_service.v1.QueryEmployersResponse\"\xc0\x01\x92A\x9b\x01\n" +
+	"\temployers\x12\x0fQuery employers\x1a}Returns employers. Needed to retrieve data in other microservices. End users need an access token and cannot filter by email.\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/employer/query\x12\x9c\x02\n" +
+	"\vGetEmployer\x12#.user_service.v1.GetEmployerRequest\x1a$.user_service.v1.GetEmployerResponse\"\xc1\x01\x92A\xa0\x01\n" +
+	"\temployers\x12\fGet employer\x1a\x84\x01Returns employer by id including deeleted employers. Needed to retrieve data in other microservices. End users need an access token.\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/employer/{id}\x12\x92\x02\n" +
 	"\x12GetEmployerByEmail\x12*.user_service.v1.GetEmployerByEmailRequest\x1a+.user_service.v1.GetEmployerByEmailResponse\"\xa2\x01\x92Av\n" +
-	"\temployers\x12\x15Get employer by email\x1aRReturns not deleted employer by email. Required in the authorization microservice.\x82\xd3\xe4\x93\x02#\x12!/api/v1/employer/by-email/{email}B\xc0\x01\x92Aj\x120\n" +
+	"\temployers\x12\x15Get employer by email\x1aRReturns not deleted employer by email. Required in the authorization microservice.\x82\xd3\xe4\x93\x02#\x12!/api/v1/employer/by-email/{email}\x12p\n" +
+	"\x13ChangeEmployerEmail\x12+.user_service.v1.ChangeEmployerEmailRequest\x1a,.user_service.v1.ChangeEmployerEmailResponse\x12d\n" +
+	"\x0fRestoreEmployer\x12'.user_service.v1.RestoreEmployerRequest\x1a(.user_service.v1.RestoreEmployerResponse\x12\x8e\x01\n" +
+	"\x1dGetRestorableEmployersByEmail\x125.user_service.v1.GetRestorableEmployersByEmailRequest\x1a6.user_service.v1.GetRestorableEmployersByEmailResponseB\xc0\x01\x92Aj\x120\n" +
 	"\x10User Service API\x12\x17API for user management2\x031.0\x1a\x0elocalhost:8081*\x02\x01\x022\x10application/json:\x10application/jsonZQgithub.com/ZaiiiRan/job_search_service/user-service/gen/go/user-service/v1;userv1b\x06proto3"
 
 var (
@@ -1923,107 +2572,142 @@ func file_user_service_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_user_service_v1_user_service_proto_rawDescData
 }
 
-var file_user_service_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_user_service_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_user_service_v1_user_service_proto_goTypes = []any{
-	(*Contacts)(nil),                    // 0: user_service.v1.Contacts
-	(*Applicant)(nil),                   // 1: user_service.v1.Applicant
-	(*CreateApplicantRequest)(nil),      // 2: user_service.v1.CreateApplicantRequest
-	(*CreateApplicantResponse)(nil),     // 3: user_service.v1.CreateApplicantResponse
-	(*ActivateApplicantRequest)(nil),    // 4: user_service.v1.ActivateApplicantRequest
-	(*ActivateApplicantResponse)(nil),   // 5: user_service.v1.ActivateApplicantResponse
-	(*UpdateApplicantRequest)(nil),      // 6: user_service.v1.UpdateApplicantRequest
-	(*UpdateApplicantResponse)(nil),     // 7: user_service.v1.UpdateApplicantResponse
-	(*DeleteApplicantRequest)(nil),      // 8: user_service.v1.DeleteApplicantRequest
-	(*DeleteApplicantResponse)(nil),     // 9: user_service.v1.DeleteApplicantResponse
-	(*QueryApplicantsRequest)(nil),      // 10: user_service.v1.QueryApplicantsRequest
-	(*QueryApplicantsResponse)(nil),     // 11: user_service.v1.QueryApplicantsResponse
-	(*GetApplicantRequest)(nil),         // 12: user_service.v1.GetApplicantRequest
-	(*GetApplicantResponse)(nil),        // 13: user_service.v1.GetApplicantResponse
-	(*GetApplicantByEmailRequest)(nil),  // 14: user_service.v1.GetApplicantByEmailRequest
-	(*GetApplicantByEmailResponse)(nil), // 15: user_service.v1.GetApplicantByEmailResponse
-	(*Employer)(nil),                    // 16: user_service.v1.Employer
-	(*CreateEmployerRequest)(nil),       // 17: user_service.v1.CreateEmployerRequest
-	(*CreateEmployerResponse)(nil),      // 18: user_service.v1.CreateEmployerResponse
-	(*ActivateEmployerRequest)(nil),     // 19: user_service.v1.ActivateEmployerRequest
-	(*ActivateEmployerResponse)(nil),    // 20: user_service.v1.ActivateEmployerResponse
-	(*UpdateEmployerRequest)(nil),       // 21: user_service.v1.UpdateEmployerRequest
-	(*UpdateEmployerResponse)(nil),      // 22: user_service.v1.UpdateEmployerResponse
-	(*DeleteEmployerRequest)(nil),       // 23: user_service.v1.DeleteEmployerRequest
-	(*DeleteEmployerResponse)(nil),      // 24: user_service.v1.DeleteEmployerResponse
-	(*QueryEmployersRequest)(nil),       // 25: user_service.v1.QueryEmployersRequest
-	(*QueryEmployersResponse)(nil),      // 26: user_service.v1.QueryEmployersResponse
-	(*GetEmployerRequest)(nil),          // 27: user_service.v1.GetEmployerRequest
-	(*GetEmployerResponse)(nil),         // 28: user_service.v1.GetEmployerResponse
-	(*GetEmployerByEmailRequest)(nil),   // 29: user_service.v1.GetEmployerByEmailRequest
-	(*GetEmployerByEmailResponse)(nil),  // 30: user_service.v1.GetEmployerByEmailResponse
-	(*timestamppb.Timestamp)(nil),       // 31: google.protobuf.Timestamp
+	(*Contacts)(nil),                               // 0: user_service.v1.Contacts
+	(*Applicant)(nil),                              // 1: user_service.v1.Applicant
+	(*CreateApplicantRequest)(nil),                 // 2: user_service.v1.CreateApplicantRequest
+	(*CreateApplicantResponse)(nil),                // 3: user_service.v1.CreateApplicantResponse
+	(*ActivateApplicantRequest)(nil),               // 4: user_service.v1.ActivateApplicantRequest
+	(*ActivateApplicantResponse)(nil),              // 5: user_service.v1.ActivateApplicantResponse
+	(*UpdateApplicantRequest)(nil),                 // 6: user_service.v1.UpdateApplicantRequest
+	(*UpdateApplicantResponse)(nil),                // 7: user_service.v1.UpdateApplicantResponse
+	(*DeleteApplicantRequest)(nil),                 // 8: user_service.v1.DeleteApplicantRequest
+	(*DeleteApplicantResponse)(nil),                // 9: user_service.v1.DeleteApplicantResponse
+	(*QueryApplicantsRequest)(nil),                 // 10: user_service.v1.QueryApplicantsRequest
+	(*QueryApplicantsResponse)(nil),                // 11: user_service.v1.QueryApplicantsResponse
+	(*GetApplicantRequest)(nil),                    // 12: user_service.v1.GetApplicantRequest
+	(*GetApplicantResponse)(nil),                   // 13: user_service.v1.GetApplicantResponse
+	(*GetApplicantByEmailRequest)(nil),             // 14: user_service.v1.GetApplicantByEmailRequest
+	(*GetApplicantByEmailResponse)(nil),            // 15: user_service.v1.GetApplicantByEmailResponse
+	(*ChangeApplicantEmailRequest)(nil),            // 16: user_service.v1.ChangeApplicantEmailRequest
+	(*ChangeApplicantEmailResponse)(nil),           // 17: user_service.v1.ChangeApplicantEmailResponse
+	(*RestoreApplicantRequest)(nil),                // 18: user_service.v1.RestoreApplicantRequest
+	(*RestoreApplicantResponse)(nil),               // 19: user_service.v1.RestoreApplicantResponse
+	(*GetRestorableApplicantsByEmailRequest)(nil),  // 20: user_service.v1.GetRestorableApplicantsByEmailRequest
+	(*GetRestorableApplicantsByEmailResponse)(nil), // 21: user_service.v1.GetRestorableApplicantsByEmailResponse
+	(*Employer)(nil),                               // 22: user_service.v1.Employer
+	(*CreateEmployerRequest)(nil),                  // 23: user_service.v1.CreateEmployerRequest
+	(*CreateEmployerResponse)(nil),                 // 24: user_service.v1.CreateEmployerResponse
+	(*ActivateEmployerRequest)(nil),                // 25: user_service.v1.ActivateEmployerRequest
+	(*ActivateEmployerResponse)(nil),               // 26: user_service.v1.ActivateEmployerResponse
+	(*UpdateEmployerRequest)(nil),                  // 27: user_service.v1.UpdateEmployerRequest
+	(*UpdateEmployerResponse)(nil),                 // 28: user_service.v1.UpdateEmployerResponse
+	(*DeleteEmployerRequest)(nil),                  // 29: user_service.v1.DeleteEmployerRequest
+	(*DeleteEmployerResponse)(nil),                 // 30: user_service.v1.DeleteEmployerResponse
+	(*QueryEmployersRequest)(nil),                  // 31: user_service.v1.QueryEmployersRequest
+	(*QueryEmployersResponse)(nil),                 // 32: user_service.v1.QueryEmployersResponse
+	(*GetEmployerRequest)(nil),                     // 33: user_service.v1.GetEmployerRequest
+	(*GetEmployerResponse)(nil),                    // 34: user_service.v1.GetEmployerResponse
+	(*GetEmployerByEmailRequest)(nil),              // 35: user_service.v1.GetEmployerByEmailRequest
+	(*GetEmployerByEmailResponse)(nil),             // 36: user_service.v1.GetEmployerByEmailResponse
+	(*ChangeEmployerEmailRequest)(nil),             // 37: user_service.v1.ChangeEmployerEmailRequest
+	(*ChangeEmployerEmailResponse)(nil),            // 38: user_service.v1.ChangeEmployerEmailResponse
+	(*RestoreEmployerRequest)(nil),                 // 39: user_service.v1.RestoreEmployerRequest
+	(*RestoreEmployerResponse)(nil),                // 40: user_service.v1.RestoreEmployerResponse
+	(*GetRestorableEmployersByEmailRequest)(nil),   // 41: user_service.v1.GetRestorableEmployersByEmailRequest
+	(*GetRestorableEmployersByEmailResponse)(nil),  // 42: user_service.v1.GetRestorableEmployersByEmailResponse
+	(*timestamppb.Timestamp)(nil),                  // 43: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 44: google.protobuf.FieldMask
 }
 var file_user_service_v1_user_service_proto_depIdxs = []int32{
 	0,  // 0: user_service.v1.Applicant.contacts:type_name -> user_service.v1.Contacts
-	31, // 1: user_service.v1.Applicant.created_at:type_name -> google.protobuf.Timestamp
-	31, // 2: user_service.v1.Applicant.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: user_service.v1.CreateApplicantRequest.applicant:type_name -> user_service.v1.Applicant
-	1,  // 4: user_service.v1.CreateApplicantResponse.applicant:type_name -> user_service.v1.Applicant
-	1,  // 5: user_service.v1.ActivateApplicantResponse.applicant:type_name -> user_service.v1.Applicant
-	1,  // 6: user_service.v1.UpdateApplicantRequest.applicant:type_name -> user_service.v1.Applicant
-	1,  // 7: user_service.v1.UpdateApplicantResponse.applicant:type_name -> user_service.v1.Applicant
-	1,  // 8: user_service.v1.DeleteApplicantResponse.applicant:type_name -> user_service.v1.Applicant
-	31, // 9: user_service.v1.QueryApplicantsRequest.created_from:type_name -> google.protobuf.Timestamp
-	31, // 10: user_service.v1.QueryApplicantsRequest.created_to:type_name -> google.protobuf.Timestamp
-	31, // 11: user_service.v1.QueryApplicantsRequest.updated_from:type_name -> google.protobuf.Timestamp
-	31, // 12: user_service.v1.QueryApplicantsRequest.updated_to:type_name -> google.protobuf.Timestamp
-	1,  // 13: user_service.v1.QueryApplicantsResponse.applicants:type_name -> user_service.v1.Applicant
-	1,  // 14: user_service.v1.GetApplicantResponse.applicant:type_name -> user_service.v1.Applicant
-	1,  // 15: user_service.v1.GetApplicantByEmailResponse.applicant:type_name -> user_service.v1.Applicant
-	0,  // 16: user_service.v1.Employer.contacts:type_name -> user_service.v1.Contacts
-	31, // 17: user_service.v1.Employer.created_at:type_name -> google.protobuf.Timestamp
-	31, // 18: user_service.v1.Employer.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 19: user_service.v1.CreateEmployerRequest.employer:type_name -> user_service.v1.Employer
-	16, // 20: user_service.v1.CreateEmployerResponse.employer:type_name -> user_service.v1.Employer
-	16, // 21: user_service.v1.ActivateEmployerResponse.employer:type_name -> user_service.v1.Employer
-	16, // 22: user_service.v1.UpdateEmployerRequest.employer:type_name -> user_service.v1.Employer
-	16, // 23: user_service.v1.UpdateEmployerResponse.employer:type_name -> user_service.v1.Employer
-	16, // 24: user_service.v1.DeleteEmployerResponse.employer:type_name -> user_service.v1.Employer
-	31, // 25: user_service.v1.QueryEmployersRequest.created_from:type_name -> google.protobuf.Timestamp
-	31, // 26: user_service.v1.QueryEmployersRequest.created_to:type_name -> google.protobuf.Timestamp
-	31, // 27: user_service.v1.QueryEmployersRequest.updated_from:type_name -> google.protobuf.Timestamp
-	31, // 28: user_service.v1.QueryEmployersRequest.updated_to:type_name -> google.protobuf.Timestamp
-	16, // 29: user_service.v1.QueryEmployersResponse.employers:type_name -> user_service.v1.Employer
-	16, // 30: user_service.v1.GetEmployerResponse.employer:type_name -> user_service.v1.Employer
-	16, // 31: user_service.v1.GetEmployerByEmailResponse.employer:type_name -> user_service.v1.Employer
-	2,  // 32: user_service.v1.UserService.CreateApplicant:input_type -> user_service.v1.CreateApplicantRequest
-	4,  // 33: user_service.v1.UserService.ActivateApplicant:input_type -> user_service.v1.ActivateApplicantRequest
-	6,  // 34: user_service.v1.UserService.UpdateApplicant:input_type -> user_service.v1.UpdateApplicantRequest
-	8,  // 35: user_service.v1.UserService.DeleteApplicant:input_type -> user_service.v1.DeleteApplicantRequest
-	10, // 36: user_service.v1.UserService.QueryApplicants:input_type -> user_service.v1.QueryApplicantsRequest
-	12, // 37: user_service.v1.UserService.GetApplicant:input_type -> user_service.v1.GetApplicantRequest
-	14, // 38: user_service.v1.UserService.GetApplicantByEmail:input_type -> user_service.v1.GetApplicantByEmailRequest
-	17, // 39: user_service.v1.UserService.CreateEmployer:input_type -> user_service.v1.CreateEmployerRequest
-	19, // 40: user_service.v1.UserService.ActivateEmployer:input_type -> user_service.v1.ActivateEmployerRequest
-	21, // 41: user_service.v1.UserService.UpdateEmployer:input_type -> user_service.v1.UpdateEmployerRequest
-	23, // 42: user_service.v1.UserService.DeleteEmployer:input_type -> user_service.v1.DeleteEmployerRequest
-	25, // 43: user_service.v1.UserService.QueryEmployers:input_type -> user_service.v1.QueryEmployersRequest
-	27, // 44: user_service.v1.UserService.GetEmployer:input_type -> user_service.v1.GetEmployerRequest
-	29, // 45: user_service.v1.UserService.GetEmployerByEmail:input_type -> user_service.v1.GetEmployerByEmailRequest
-	3,  // 46: user_service.v1.UserService.CreateApplicant:output_type -> user_service.v1.CreateApplicantResponse
-	5,  // 47: user_service.v1.UserService.ActivateApplicant:output_type -> user_service.v1.ActivateApplicantResponse
-	7,  // 48: user_service.v1.UserService.UpdateApplicant:output_type -> user_service.v1.UpdateApplicantResponse
-	9,  // 49: user_service.v1.UserService.DeleteApplicant:output_type -> user_service.v1.DeleteApplicantResponse
-	11, // 50: user_service.v1.UserService.QueryApplicants:output_type -> user_service.v1.QueryApplicantsResponse
-	13, // 51: user_service.v1.UserService.GetApplicant:output_type -> user_service.v1.GetApplicantResponse
-	15, // 52: user_service.v1.UserService.GetApplicantByEmail:output_type -> user_service.v1.GetApplicantByEmailResponse
-	18, // 53: user_service.v1.UserService.CreateEmployer:output_type -> user_service.v1.CreateEmployerResponse
-	20, // 54: user_service.v1.UserService.ActivateEmployer:output_type -> user_service.v1.ActivateEmployerResponse
-	22, // 55: user_service.v1.UserService.UpdateEmployer:output_type -> user_service.v1.UpdateEmployerResponse
-	24, // 56: user_service.v1.UserService.DeleteEmployer:output_type -> user_service.v1.DeleteEmployerResponse
-	26, // 57: user_service.v1.UserService.QueryEmployers:output_type -> user_service.v1.QueryEmployersResponse
-	28, // 58: user_service.v1.UserService.GetEmployer:output_type -> user_service.v1.GetEmployerResponse
-	30, // 59: user_service.v1.UserService.GetEmployerByEmail:output_type -> user_service.v1.GetEmployerByEmailResponse
-	46, // [46:60] is the sub-list for method output_type
-	32, // [32:46] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	43, // 1: user_service.v1.Applicant.created_at:type_name -> google.protobuf.Timestamp
+	43, // 2: user_service.v1.Applicant.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 3: user_service.v1.Applicant.deleted_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: user_service.v1.CreateApplicantRequest.applicant:type_name -> user_service.v1.Applicant
+	1,  // 5: user_service.v1.CreateApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	1,  // 6: user_service.v1.ActivateApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	1,  // 7: user_service.v1.UpdateApplicantRequest.applicant:type_name -> user_service.v1.Applicant
+	44, // 8: user_service.v1.UpdateApplicantRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 9: user_service.v1.UpdateApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	1,  // 10: user_service.v1.DeleteApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	43, // 11: user_service.v1.QueryApplicantsRequest.created_from:type_name -> google.protobuf.Timestamp
+	43, // 12: user_service.v1.QueryApplicantsRequest.created_to:type_name -> google.protobuf.Timestamp
+	43, // 13: user_service.v1.QueryApplicantsRequest.updated_from:type_name -> google.protobuf.Timestamp
+	43, // 14: user_service.v1.QueryApplicantsRequest.updated_to:type_name -> google.protobuf.Timestamp
+	1,  // 15: user_service.v1.QueryApplicantsResponse.applicants:type_name -> user_service.v1.Applicant
+	1,  // 16: user_service.v1.GetApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	1,  // 17: user_service.v1.GetApplicantByEmailResponse.applicant:type_name -> user_service.v1.Applicant
+	1,  // 18: user_service.v1.ChangeApplicantEmailResponse.applicant:type_name -> user_service.v1.Applicant
+	1,  // 19: user_service.v1.RestoreApplicantResponse.applicant:type_name -> user_service.v1.Applicant
+	1,  // 20: user_service.v1.GetRestorableApplicantsByEmailResponse.applicants:type_name -> user_service.v1.Applicant
+	0,  // 21: user_service.v1.Employer.contacts:type_name -> user_service.v1.Contacts
+	43, // 22: user_service.v1.Employer.created_at:type_name -> google.protobuf.Timestamp
+	43, // 23: user_service.v1.Employer.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 24: user_service.v1.Employer.deleted_at:type_name -> google.protobuf.Timestamp
+	22, // 25: user_service.v1.CreateEmployerRequest.employer:type_name -> user_service.v1.Employer
+	22, // 26: user_service.v1.CreateEmployerResponse.employer:type_name -> user_service.v1.Employer
+	22, // 27: user_service.v1.ActivateEmployerResponse.employer:type_name -> user_service.v1.Employer
+	22, // 28: user_service.v1.UpdateEmployerRequest.employer:type_name -> user_service.v1.Employer
+	44, // 29: user_service.v1.UpdateEmployerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	22, // 30: user_service.v1.UpdateEmployerResponse.employer:type_name -> user_service.v1.Employer
+	22, // 31: user_service.v1.DeleteEmployerResponse.employer:type_name -> user_service.v1.Employer
+	43, // 32: user_service.v1.QueryEmployersRequest.created_from:type_name -> google.protobuf.Timestamp
+	43, // 33: user_service.v1.QueryEmployersRequest.created_to:type_name -> google.protobuf.Timestamp
+	43, // 34: user_service.v1.QueryEmployersRequest.updated_from:type_name -> google.protobuf.Timestamp
+	43, // 35: user_service.v1.QueryEmployersRequest.updated_to:type_name -> google.protobuf.Timestamp
+	22, // 36: user_service.v1.QueryEmployersResponse.employers:type_name -> user_service.v1.Employer
+	22, // 37: user_service.v1.GetEmployerResponse.employer:type_name -> user_service.v1.Employer
+	22, // 38: user_service.v1.GetEmployerByEmailResponse.employer:type_name -> user_service.v1.Employer
+	22, // 39: user_service.v1.ChangeEmployerEmailResponse.employer:type_name -> user_service.v1.Employer
+	22, // 40: user_service.v1.RestoreEmployerResponse.employer:type_name -> user_service.v1.Employer
+	22, // 41: user_service.v1.GetRestorableEmployersByEmailResponse.employers:type_name -> user_service.v1.Employer
+	2,  // 42: user_service.v1.UserService.CreateApplicant:input_type -> user_service.v1.CreateApplicantRequest
+	4,  // 43: user_service.v1.UserService.ActivateApplicant:input_type -> user_service.v1.ActivateApplicantRequest
+	6,  // 44: user_service.v1.UserService.UpdateApplicant:input_type -> user_service.v1.UpdateApplicantRequest
+	8,  // 45: user_service.v1.UserService.DeleteApplicant:input_type -> user_service.v1.DeleteApplicantRequest
+	10, // 46: user_service.v1.UserService.QueryApplicants:input_type -> user_service.v1.QueryApplicantsRequest
+	12, // 47: user_service.v1.UserService.GetApplicant:input_type -> user_service.v1.GetApplicantRequest
+	14, // 48: user_service.v1.UserService.GetApplicantByEmail:input_type -> user_service.v1.GetApplicantByEmailRequest
+	16, // 49: user_service.v1.UserService.ChangeApplicantEmail:input_type -> user_service.v1.ChangeApplicantEmailRequest
+	18, // 50: user_service.v1.UserService.RestoreApplicant:input_type -> user_service.v1.RestoreApplicantRequest
+	20, // 51: user_service.v1.UserService.GetRestorableApplicantsByEmail:input_type -> user_service.v1.GetRestorableApplicantsByEmailRequest
+	23, // 52: user_service.v1.UserService.CreateEmployer:input_type -> user_service.v1.CreateEmployerRequest
+	25, // 53: user_service.v1.UserService.ActivateEmployer:input_type -> user_service.v1.ActivateEmployerRequest
+	27, // 54: user_service.v1.UserService.UpdateEmployer:input_type -> user_service.v1.UpdateEmployerRequest
+	29, // 55: user_service.v1.UserService.DeleteEmployer:input_type -> user_service.v1.DeleteEmployerRequest
+	31, // 56: user_service.v1.UserService.QueryEmployers:input_type -> user_service.v1.QueryEmployersRequest
+	33, // 57: user_service.v1.UserService.GetEmployer:input_type -> user_service.v1.GetEmployerRequest
+	35, // 58: user_service.v1.UserService.GetEmployerByEmail:input_type -> user_service.v1.GetEmployerByEmailRequest
+	37, // 59: user_service.v1.UserService.ChangeEmployerEmail:input_type -> user_service.v1.ChangeEmployerEmailRequest
+	39, // 60: user_service.v1.UserService.RestoreEmployer:input_type -> user_service.v1.RestoreEmployerRequest
+	41, // 61: user_service.v1.UserService.GetRestorableEmployersByEmail:input_type -> user_service.v1.GetRestorableEmployersByEmailRequest
+	3,  // 62: user_service.v1.UserService.CreateApplicant:output_type -> user_service.v1.CreateApplicantResponse
+	5,  // 63: user_service.v1.UserService.ActivateApplicant:output_type -> user_service.v1.ActivateApplicantResponse
+	7,  // 64: user_service.v1.UserService.UpdateApplicant:output_type -> user_service.v1.UpdateApplicantResponse
+	9,  // 65: user_service.v1.UserService.DeleteApplicant:output_type -> user_service.v1.DeleteApplicantResponse
+	11, // 66: user_service.v1.UserService.QueryApplicants:output_type -> user_service.v1.QueryApplicantsResponse
+	13, // 67: user_service.v1.UserService.GetApplicant:output_type -> user_service.v1.GetApplicantResponse
+	15, // 68: user_service.v1.UserService.GetApplicantByEmail:output_type -> user_service.v1.GetApplicantByEmailResponse
+	17, // 69: user_service.v1.UserService.ChangeApplicantEmail:output_type -> user_service.v1.ChangeApplicantEmailResponse
+	19, // 70: user_service.v1.UserService.RestoreApplicant:output_type -> user_service.v1.RestoreApplicantResponse
+	21, // 71: user_service.v1.UserService.GetRestorableApplicantsByEmail:output_type -> user_service.v1.GetRestorableApplicantsByEmailResponse
+	24, // 72: user_service.v1.UserService.CreateEmployer:output_type -> user_service.v1.CreateEmployerResponse
+	26, // 73: user_service.v1.UserService.ActivateEmployer:output_type -> user_service.v1.ActivateEmployerResponse
+	28, // 74: user_service.v1.UserService.UpdateEmployer:output_type -> user_service.v1.UpdateEmployerResponse
+	30, // 75: user_service.v1.UserService.DeleteEmployer:output_type -> user_service.v1.DeleteEmployerResponse
+	32, // 76: user_service.v1.UserService.QueryEmployers:output_type -> user_service.v1.QueryEmployersResponse
+	34, // 77: user_service.v1.UserService.GetEmployer:output_type -> user_service.v1.GetEmployerResponse
+	36, // 78: user_service.v1.UserService.GetEmployerByEmail:output_type -> user_service.v1.GetEmployerByEmailResponse
+	38, // 79: user_service.v1.UserService.ChangeEmployerEmail:output_type -> user_service.v1.ChangeEmployerEmailResponse
+	40, // 80: user_service.v1.UserService.RestoreEmployer:output_type -> user_service.v1.RestoreEmployerResponse
+	42, // 81: user_service.v1.UserService.GetRestorableEmployersByEmail:output_type -> user_service.v1.GetRestorableEmployersByEmailResponse
+	62, // [62:82] is the sub-list for method output_type
+	42, // [42:62] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_user_service_v1_user_service_proto_init() }
@@ -2034,14 +2718,15 @@ func file_user_service_v1_user_service_proto_init() {
 	file_user_service_v1_user_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_user_service_v1_user_service_proto_msgTypes[1].OneofWrappers = []any{}
 	file_user_service_v1_user_service_proto_msgTypes[10].OneofWrappers = []any{}
-	file_user_service_v1_user_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_user_service_v1_user_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_user_service_v1_user_service_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_service_v1_user_service_proto_rawDesc), len(file_user_service_v1_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
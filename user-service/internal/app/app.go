@@ -7,10 +7,15 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt/denylist"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/logger"
+	clientmiddleware "github.com/ZaiiiRan/job_search_service/common/pkg/middleware/grpc/client"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/config"
 	applicantservice "github.com/ZaiiiRan/job_search_service/user-service/internal/services/applicant"
+	authservice "github.com/ZaiiiRan/job_search_service/user-service/internal/services/auth_service"
 	employerservice "github.com/ZaiiiRan/job_search_service/user-service/internal/services/employer"
+	authgrpcclient "github.com/ZaiiiRan/job_search_service/user-service/internal/transport/client/grpc/auth_client"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/transport/postgres"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/transport/redis"
 	grpcserver "github.com/ZaiiiRan/job_search_service/user-service/internal/transport/server/grpc"
@@ -25,12 +30,21 @@ type App struct {
 
 	postgresClient *postgres.PostgresClient
 	redisClient    *redis.RedisClient
+	// denylistRedisClient connects to the redis of the auth service, which
+	// keeps the revoked access tokens.
+	denylistRedisClient *redis.RedisClient
+	authGrpcClient      *authgrpcclient.Client
+
+	denylist    denylist.Denylist
+	authService authservice.AuthService
 
 	applicantService applicantservice.ApplicantService
 	employerService  employerservice.EmployerService
 
 	grpcServer  *grpcserver.Server
 	httpGateway *httpgateway.Server
+
+	stopRetention context.CancelFunc
 }
 
 func New() (*App, error) {
@@ -55,6 +69,16 @@ func (a *App) Run(ctx context.Context) error {
 		return err
 	}
 
+	if err := a.initDenylistRedisClient(ctx); err != nil {
+		return err
+	}
+	if err := a.initAuthGrpcClient(ctx); err != nil {
+		return err
+	}
+
+	a.initDenylist()
+
+	a.initAuthService()
 	a.initApplicantService()
 	a.initEmployerService()
 
@@ -66,6 +90,8 @@ func (a *App) Run(ctx context.Context) error {
 		return err
 	}
 	a.startHttpGateway()
+	a.startRetentionJob(ctx)
+
 	a.log.Infow("app.started")
 	return nil
 }
@@ -76,8 +102,11 @@ func (a *App) Stop(ctx context.Context) {
 	shCtx, cancel := context.WithTimeout(ctx, time.Duration(a.cfg.Shutdown.ShutdownTimeout)*time.Second)
 	defer cancel()
 
+	a.stopRetention()
 	a.postgresClient.Close()
 	a.redisClient.Close()
+	a.denylistRedisClient.Close()
+	a.authGrpcClient.Close()
 	a.grpcServer.Stop(shCtx)
 	a.httpGateway.Stop(shCtx)
 
@@ -118,16 +147,68 @@ func (a *App) initRedisClient(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initDenylistRedisClient(ctx context.Context) error {
+	redisClient, err := redis.New(ctx, a.cfg.DenylistRedis)
+	if err != nil {
+		a.log.Errorw("app.denylist_redis_connect_failed", "err", err)
+		return err
+	}
+	a.denylistRedisClient = redisClient
+
+	a.log.Infow("app.denylist_redis_connected")
+	return nil
+}
+
+func (a *App) initDenylist() {
+	a.denylist = denylist.NewRedis(a.denylistRedisClient.GetClient())
+}
+
+// initAuthGrpcClient does not wait for the auth service, which itself waits for
+// the user service on startup.
+func (a *App) initAuthGrpcClient(ctx context.Context) error {
+	a.log.Infow("auth grpc addr", "addr", a.cfg.AuthServiceGRPCClient.Address)
+	tokenCfg := a.cfg.AuthServiceToken
+	signer, err := jwt.NewServiceTokenSigner(
+		tokenCfg.Service, tokenCfg.Audience,
+		[]byte(tokenCfg.Secret), time.Duration(tokenCfg.TTL)*time.Second,
+	)
+	if err != nil {
+		a.log.Errorw("app.auth_grpc_client_init_failed", "err", err)
+		return err
+	}
+
+	authClient, err := authgrpcclient.New(
+		ctx, a.cfg.AuthServiceGRPCClient,
+		[]grpc.UnaryClientInterceptor{clientmiddleware.ServiceTokenUnary(signer)}, nil,
+	)
+	if err != nil {
+		a.log.Errorw("app.auth_grpc_client_init_failed", "err", err)
+		return err
+	}
+	a.authGrpcClient = authClient
+
+	if a.cfg.AuthServiceGRPCClient.AutoConnect {
+		a.log.Infow("app.auth_grpc_client_connected")
+	} else {
+		a.log.Infow("app.auth_grpc_client_initialized")
+	}
+	return nil
+}
+
+func (a *App) initAuthService() {
+	a.authService = authservice.New(a.authGrpcClient, a.log)
+}
+
 func (a *App) initApplicantService() {
-	a.applicantService = applicantservice.New(a.postgresClient, a.redisClient, a.log)
+	a.applicantService = applicantservice.New(a.postgresClient, a.redisClient, a.authService, a.cfg.Retention, a.log)
 }
 
 func (a *App) initEmployerService() {
-	a.employerService = employerservice.New(a.postgresClient, a.redisClient, a.log)
+	a.employerService = employerservice.New(a.postgresClient, a.redisClient, a.authService, a.cfg.Retention, a.log)
 }
 
 func (a *App) initGrpcServer() error {
-	srv, err := grpcserver.New(a.cfg.GRPCServer, a.applicantService, a.employerService, a.log)
+	srv, err := grpcserver.New(a.cfg.GRPCServer, a.cfg.ServiceAuth, a.cfg.EndUserAuth, a.denylist, a.applicantService, a.employerService, a.log)
 	if err != nil {
 		a.log.Errorw("app.grpc_server_init_failed", "err", err)
 		return err
@@ -164,3 +245,27 @@ func (a *App) startHttpGateway() {
 		}
 	}()
 }
+
+// startRetentionJob anonymizes the users deleted longer than the grace period
+// ago until the app is stopped.
+func (a *App) startRetentionJob(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	a.stopRetention = cancel
+
+	go func() {
+		interval := time.Duration(a.cfg.Retention.AnonymizeInterval) * time.Second
+		a.log.Infow("app.retention_job_start", "interval", interval)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.applicantService.AnonymizeDeleted(ctx)
+				a.employerService.AnonymizeDeleted(ctx)
+			}
+		}
+	}()
+}
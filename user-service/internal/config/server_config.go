@@ -9,12 +9,18 @@ import (
 )
 
 type ServerConfig struct {
-	GRPCServer        settings.GRPCServerSettings `mapstructure:"grpc_server"`
-	HTTPGatewayServer settings.HTTPServerSettings `mapstructure:"http_gateway_server"`
-	DB                settings.PostgresSettings   `mapstructure:"db"`
-	Migrate           settings.MigrateSettings    `mapstructure:"migrate"`
-	Redis             settings.RedisSettings      `mapstructure:"redis"`
-	Shutdown          settings.ShutdownSettings   `mapstructure:"shutdown"`
+	GRPCServer            settings.GRPCServerSettings   `mapstructure:"grpc_server"`
+	ServiceAuth           settings.ServiceAuthSettings  `mapstructure:"service_auth"`
+	EndUserAuth           settings.EndUserAuthSettings  `mapstructure:"end_user_auth"`
+	AuthServiceGRPCClient settings.GRPCClientSettings   `mapstructure:"auth_service_grpc_client"`
+	AuthServiceToken      settings.ServiceTokenSettings `mapstructure:"auth_service_token"`
+	HTTPGatewayServer     settings.HTTPServerSettings   `mapstructure:"http_gateway_server"`
+	DB                    settings.PostgresSettings     `mapstructure:"db"`
+	Migrate               settings.MigrateSettings      `mapstructure:"migrate"`
+	Redis                 settings.RedisSettings        `mapstructure:"redis"`
+	DenylistRedis         settings.RedisSettings        `mapstructure:"denylist_redis"`
+	Shutdown              settings.ShutdownSettings     `mapstructure:"shutdown"`
+	Retention             settings.RetentionSettings    `mapstructure:"retention"`
 }
 
 func LoadServerConfig() (*ServerConfig, error) {
@@ -31,7 +37,8 @@ func LoadServerConfig() (*ServerConfig, error) {
 		return nil, err
 	}
 
-	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	// service names are part of some keys, SERVICE_AUTH_SERVICES_AUTH_SERVICE sets services.auth-service
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
 	v.AutomaticEnv()
 
 	setServerDefaults(v)
@@ -46,9 +53,16 @@ func LoadServerConfig() (*ServerConfig, error) {
 
 func setServerDefaults(v *viper.Viper) {
 	settings.SetGRPCServerDefaults(v, "grpc_server", ":50051")
+	settings.SetServiceAuthDefaults(v, "service_auth")
+	settings.SetEndUserAuthDefaults(v, "end_user_auth")
+	settings.SetGRPCClientDefaults(v, "auth_service_grpc_client", "localhost:50052")
+	v.SetDefault("auth_service_grpc_client.auto_connect", false)
+	settings.SetServiceTokenDefaults(v, "auth_service_token")
 	settings.SetHTTPServerDefaults(v, "http_gateway_server", ":8081")
 	settings.SetPostgresDefaults(v, "db")
 	settings.SetMigrateDefaults(v, "migrate")
 	settings.SetRedisDefaults(v, "redis")
+	settings.SetRedisDefaults(v, "denylist_redis")
 	settings.SetShutdownDefaults(v, "shutdown")
+	settings.SetRetentionDefaults(v, "retention")
 }
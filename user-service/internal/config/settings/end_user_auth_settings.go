@@ -0,0 +1,17 @@
+package settings
+
+import "github.com/spf13/viper"
+
+// EndUserAuthSettings point to the JWKS document of the auth service, the
+// access tokens of end users are verified with its keys.
+type EndUserAuthSettings struct {
+	JwksURL      string `mapstructure:"jwks_url"`
+	JwksCacheTTL uint   `mapstructure:"jwks_cache_ttl"`
+	JwksTimeout  uint   `mapstructure:"jwks_timeout"`
+}
+
+func SetEndUserAuthDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".jwks_url", "http://localhost:8082/.well-known/jwks.json")
+	v.SetDefault(prefix+".jwks_cache_ttl", 3600) // 1 hour in seconds
+	v.SetDefault(prefix+".jwks_timeout", 5000)   // 5 seconds in milliseconds
+}
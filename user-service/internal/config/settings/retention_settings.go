@@ -0,0 +1,17 @@
+package settings
+
+import "github.com/spf13/viper"
+
+type RetentionSettings struct {
+	// GracePeriod is how long a deleted user can be restored, the personal
+	// data is anonymized once it is over.
+	GracePeriod        uint `mapstructure:"grace_period"`
+	AnonymizeInterval  uint `mapstructure:"anonymize_interval"`
+	AnonymizeBatchSize uint `mapstructure:"anonymize_batch_size"`
+}
+
+func SetRetentionDefaults(v *viper.Viper, prefix string) {
+	v.SetDefault(prefix+".grace_period", 2592000)    // 30 days in seconds
+	v.SetDefault(prefix+".anonymize_interval", 3600) // 1 hour in seconds
+	v.SetDefault(prefix+".anonymize_batch_size", 100)
+}
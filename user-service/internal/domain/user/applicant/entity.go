@@ -21,6 +21,14 @@ type Applicant struct {
 	isDeleted  bool
 	createdAt  time.Time
 	updatedAt  time.Time
+
+	// deletedAt starts the grace period a deleted applicant can be restored in,
+	// the personal data of an anonymized one is gone.
+	deletedAt    *time.Time
+	isAnonymized bool
+
+	// version is incremented by every update, an update of a stale version fails.
+	version int64
 }
 
 func New(
@@ -76,6 +84,8 @@ func FromStorage(
 	phoneNumber, telegram *string,
 	isActive, isDeleted bool,
 	createdAt, updatedAt time.Time,
+	deletedAt *time.Time, isAnonymized bool,
+	version int64,
 ) *Applicant {
 	return &Applicant{
 		id:         id,
@@ -90,6 +100,10 @@ func FromStorage(
 		isDeleted:  isDeleted,
 		createdAt:  createdAt,
 		updatedAt:  updatedAt,
+
+		deletedAt:    deletedAt,
+		isAnonymized: isAnonymized,
+		version:      version,
 	}
 }
 
@@ -107,12 +121,25 @@ func (a *Applicant) IsDeleted() bool      { return a.isDeleted }
 func (a *Applicant) CreatedAt() time.Time { return a.createdAt }
 func (a *Applicant) UpdatedAt() time.Time { return a.updatedAt }
 
+func (a *Applicant) DeletedAt() *time.Time { return a.deletedAt }
+func (a *Applicant) IsAnonymized() bool    { return a.isAnonymized }
+func (a *Applicant) Version() int64        { return a.version }
+
 func (a *Applicant) SetId(id int64) {
 	if a.Id() == 0 {
 		a.id = id
 	}
 }
 
+// Replace makes the new applicant overwrite the stored one, it takes over the id and
+// the version the update is checked against.
+func (a *Applicant) Replace(stored *Applicant) {
+	if a.Id() == 0 {
+		a.id = stored.id
+		a.version = stored.version
+	}
+}
+
 func (a *Applicant) SetFirstName(firstName string) error {
 	if err := user.ValidateName("first_name", firstName, true); err != nil {
 		return err
@@ -186,8 +213,15 @@ func (a *Applicant) SetIsActive(isActive bool) {
 	a.isActive = isActive
 }
 
+// SetIsDeleted starts the grace period of a deleted applicant and ends it on restore.
 func (a *Applicant) SetIsDeleted(isDeleted bool) {
 	a.isDeleted = isDeleted
+	if !isDeleted {
+		a.deletedAt = nil
+	} else if a.deletedAt == nil {
+		now := time.Now()
+		a.deletedAt = &now
+	}
 }
 
 func (a *Applicant) SetUpdatedAt(updatedAt time.Time) {
@@ -0,0 +1,33 @@
+package applicant
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReplace(t *testing.T) {
+	now := time.Now()
+	stored := FromStorage(7, "John", "Doe", nil, "01.01.1990", "Moscow", "john@example.com",
+		nil, nil, false, false, now, now, nil, false, 3)
+
+	a, verr := New("Jane", "Doe", nil, "02.02.1992", "Kazan", "john@example.com", nil, nil, false, false)
+	if len(verr) > 0 {
+		t.Fatalf("new applicant: %v", verr)
+	}
+
+	// the update of the stored applicant is checked against its version
+	a.Replace(stored)
+	if a.Id() != 7 || a.Version() != 3 {
+		t.Fatalf("id, version = %d, %d, want 7, 3", a.Id(), a.Version())
+	}
+	if a.FirstName() != "Jane" {
+		t.Errorf("first name = %q, want the new one", a.FirstName())
+	}
+
+	other := FromStorage(8, "John", "Doe", nil, "01.01.1990", "Moscow", "john@example.com",
+		nil, nil, false, false, now, now, nil, false, 5)
+	a.Replace(other)
+	if a.Id() != 7 || a.Version() != 3 {
+		t.Errorf("stored applicant replaced twice: id, version = %d, %d", a.Id(), a.Version())
+	}
+}
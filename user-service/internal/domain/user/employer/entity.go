@@ -18,6 +18,14 @@ type Employer struct {
 	isDeleted   bool
 	createdAt   time.Time
 	updatedAt   time.Time
+
+	// deletedAt starts the grace period a deleted employer can be restored in,
+	// the personal data of an anonymized one is gone.
+	deletedAt    *time.Time
+	isAnonymized bool
+
+	// version is incremented by every update, an update of a stale version fails.
+	version int64
 }
 
 func New(
@@ -60,6 +68,8 @@ func FromStorage(
 	phoneNumber, telegram *string,
 	isActive, isDeleted bool,
 	createdAt, updatedAt time.Time,
+	deletedAt *time.Time, isAnonymized bool,
+	version int64,
 ) *Employer {
 	return &Employer{
 		id:          id,
@@ -71,6 +81,10 @@ func FromStorage(
 		isDeleted:   isDeleted,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
+
+		deletedAt:    deletedAt,
+		isAnonymized: isAnonymized,
+		version:      version,
 	}
 }
 
@@ -85,12 +99,25 @@ func (e *Employer) IsDeleted() bool      { return e.isDeleted }
 func (e *Employer) CreatedAt() time.Time { return e.createdAt }
 func (e *Employer) UpdatedAt() time.Time { return e.updatedAt }
 
+func (e *Employer) DeletedAt() *time.Time { return e.deletedAt }
+func (e *Employer) IsAnonymized() bool    { return e.isAnonymized }
+func (e *Employer) Version() int64        { return e.version }
+
 func (e *Employer) SetId(id int64) {
 	if e.Id() == 0 {
 		e.id = id
 	}
 }
 
+// Replace makes the new employer overwrite the stored one, it takes over the id and
+// the version the update is checked against.
+func (e *Employer) Replace(stored *Employer) {
+	if e.Id() == 0 {
+		e.id = stored.id
+		e.version = stored.version
+	}
+}
+
 func (e *Employer) SetCompanyName(companyName string) error {
 	if err := user.ValidateCompanyName(companyName); err != nil {
 		return err
@@ -133,8 +160,15 @@ func (e *Employer) SetIsActive(isActive bool) {
 	e.isActive = isActive
 }
 
+// SetIsDeleted starts the grace period of a deleted employer and ends it on restore.
 func (e *Employer) SetIsDeleted(isDeleted bool) {
 	e.isDeleted = isDeleted
+	if !isDeleted {
+		e.deletedAt = nil
+	} else if e.deletedAt == nil {
+		now := time.Now()
+		e.deletedAt = &now
+	}
 }
 
 func (e *Employer) SetUpdatedAt(updatedAt time.Time) {
@@ -0,0 +1,31 @@
+package employer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReplace(t *testing.T) {
+	now := time.Now()
+	stored := FromStorage(7, "Acme", "Moscow", "hr@acme.com", nil, nil, false, false, now, now, nil, false, 3)
+
+	e, verr := New("Acme Corp", "Kazan", "hr@acme.com", nil, nil, false, false)
+	if len(verr) > 0 {
+		t.Fatalf("new employer: %v", verr)
+	}
+
+	// the update of the stored employer is checked against its version
+	e.Replace(stored)
+	if e.Id() != 7 || e.Version() != 3 {
+		t.Fatalf("id, version = %d, %d, want 7, 3", e.Id(), e.Version())
+	}
+	if e.CompanyName() != "Acme Corp" {
+		t.Errorf("company name = %q, want the new one", e.CompanyName())
+	}
+
+	other := FromStorage(8, "Acme", "Moscow", "hr@acme.com", nil, nil, false, false, now, now, nil, false, 5)
+	e.Replace(other)
+	if e.Id() != 7 || e.Version() != 3 {
+		t.Errorf("stored employer replaced twice: id, version = %d, %d", e.Id(), e.Version())
+	}
+}
@@ -0,0 +1,10 @@
+package impl
+
+import "errors"
+
+// ErrEmailAlreadyExists is returned when a write violates the unique email
+// index of not deleted users.
+var ErrEmailAlreadyExists = errors.New("email already exists")
+
+// ErrVersionConflict is returned when a user was changed since it was read.
+var ErrVersionConflict = errors.New("version conflict")
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/applicant"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/interfaces"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -66,7 +68,10 @@ func (r *ApplicantRepository) Create(ctx context.Context, applicant *applicant.A
 			is_active,
 			is_deleted,
 			created_at,
-			updated_at
+			updated_at,
+			deleted_at,
+			is_anonymized,
+			version
 	`
 
 	rows, err := r.conn.Query(ctx, sql, []models.V1ApplicantDal{dal})
@@ -91,6 +96,9 @@ func (r *ApplicantRepository) Create(ctx context.Context, applicant *applicant.A
 			&res.IsDeleted,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.DeletedAt,
+			&res.IsAnonymized,
+			&res.Version,
 		); err != nil {
 			return fmt.Errorf("scan applicant: %w", err)
 		}
@@ -117,9 +125,11 @@ func (r *ApplicantRepository) Update(ctx context.Context, applicant *applicant.A
 			telegram     = u.telegram,
 			is_active    = u.is_active,
 			is_deleted   = u.is_deleted,
-			updated_at   = u.updated_at
+			updated_at   = u.updated_at,
+			deleted_at   = u.deleted_at,
+			version      = t.version + 1
 		FROM unnest($1::v1_applicant[]) AS u
-		WHERE t.id = u.id
+		WHERE t.id = u.id AND t.version = u.version AND t.is_anonymized = FALSE
 		RETURNING
 			t.id,
 			t.first_name,
@@ -133,7 +143,10 @@ func (r *ApplicantRepository) Update(ctx context.Context, applicant *applicant.A
 			t.is_active,
 			t.is_deleted,
 			t.created_at,
-			t.updated_at;
+			t.updated_at,
+			t.deleted_at,
+			t.is_anonymized,
+			t.version;
 	`
 
 	rows, err := r.conn.Query(ctx, sql, []models.V1ApplicantDal{dal})
@@ -158,14 +171,24 @@ func (r *ApplicantRepository) Update(ctx context.Context, applicant *applicant.A
 			&res.IsDeleted,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.DeletedAt,
+			&res.IsAnonymized,
+			&res.Version,
 		); err != nil {
 			return fmt.Errorf("scan applicant: %w", err)
 		}
 		*applicant = *res.ToDomain()
 		return nil
 	}
+	if err := rows.Err(); err != nil {
+		if isUniqueViolation(err, "idx_applicants_email_active_unique") {
+			return fmt.Errorf("update applicant: %w", impl.ErrEmailAlreadyExists)
+		}
+		return fmt.Errorf("update applicant: %w", err)
+	}
 
-	return fmt.Errorf("no applicant updated")
+	// the applicant was changed since it was read, or anonymized
+	return fmt.Errorf("update applicant: %w", impl.ErrVersionConflict)
 }
 
 func (r *ApplicantRepository) Query(ctx context.Context, query *models.QueryApplicantsDal) ([]*applicant.Applicant, error) {
@@ -183,7 +206,7 @@ func (r *ApplicantRepository) Query(ctx context.Context, query *models.QueryAppl
 		SELECT
 			id, first_name, last_name, patronymic, birth_date, city,
 			email, phone_number, telegram, is_active, is_deleted,
-			created_at, updated_at
+			created_at, updated_at, deleted_at, is_anonymized, version
 		FROM applicants
 		WHERE 1=1
 	`)
@@ -210,7 +233,7 @@ func (r *ApplicantRepository) Query(ctx context.Context, query *models.QueryAppl
 		if err := rows.Scan(
 			&dal.Id, &dal.FirstName, &dal.LastName, &dal.Patronymic, &dal.BirthDate, &dal.City,
 			&dal.Email, &dal.PhoneNumber, &dal.Telegram, &dal.IsActive, &dal.IsDeleted,
-			&dal.CreatedAt, &dal.UpdatedAt,
+			&dal.CreatedAt, &dal.UpdatedAt, &dal.DeletedAt, &dal.IsAnonymized, &dal.Version,
 		); err != nil {
 			return nil, fmt.Errorf("scan applicant: %w", err)
 		}
@@ -219,3 +242,54 @@ func (r *ApplicantRepository) Query(ctx context.Context, query *models.QueryAppl
 
 	return result, nil
 }
+
+// Anonymize erases the personal data of at most limit applicants deleted before the given
+// time and returns their ids. Only the tombstone row with the id and timestamps is kept.
+func (r *ApplicantRepository) Anonymize(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	sql := `
+		UPDATE applicants AS t
+		SET
+			first_name    = '',
+			last_name     = '',
+			patronymic    = NULL,
+			birth_date    = NULL,
+			city          = '',
+			email         = '',
+			phone_number  = NULL,
+			telegram      = NULL,
+			is_anonymized = TRUE,
+			version       = t.version + 1,
+			updated_at    = NOW()
+		WHERE t.id IN (
+			SELECT id
+			FROM applicants
+			WHERE is_deleted = TRUE
+				AND is_anonymized = FALSE
+				AND deleted_at < $1
+			ORDER BY deleted_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING t.id;
+	`
+
+	rows, err := r.conn.Query(ctx, sql, deletedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("anonymize applicants: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan applicant id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("anonymize applicants: %w", err)
+	}
+
+	return ids, nil
+}
@@ -1,10 +1,20 @@
 package postgresimpl
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+const uniqueViolation = "23505"
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == constraint
+}
+
 func appendILike(sb *strings.Builder, col string, values []string, args *[]any, argPos *int) {
 	if len(values) == 0 {
 		return
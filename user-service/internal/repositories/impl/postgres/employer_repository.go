@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/employer"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/interfaces"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -57,7 +59,10 @@ func (r *EmployerRepository) Create(ctx context.Context, e *employer.Employer) e
 			is_active,
 			is_deleted,
 			created_at,
-			updated_at;
+			updated_at,
+			deleted_at,
+			is_anonymized,
+			version;
 	`
 
 	rows, err := r.conn.Query(ctx, sql, []models.V1EmployerDal{dal})
@@ -79,6 +84,9 @@ func (r *EmployerRepository) Create(ctx context.Context, e *employer.Employer) e
 			&res.IsDeleted,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.DeletedAt,
+			&res.IsAnonymized,
+			&res.Version,
 		); err != nil {
 			return fmt.Errorf("scan employer: %w", err)
 		}
@@ -102,9 +110,11 @@ func (r *EmployerRepository) Update(ctx context.Context, e *employer.Employer) e
 			telegram     = u.telegram,
 			is_active    = u.is_active,
 			is_deleted   = u.is_deleted,
-			updated_at   = u.updated_at
+			updated_at   = u.updated_at,
+			deleted_at   = u.deleted_at,
+			version      = t.version + 1
 		FROM UNNEST($1::v1_employer[]) AS u
-		WHERE t.id = u.id
+		WHERE t.id = u.id AND t.version = u.version AND t.is_anonymized = FALSE
 		RETURNING
 			t.id,
 			t.company_name,
@@ -115,7 +125,10 @@ func (r *EmployerRepository) Update(ctx context.Context, e *employer.Employer) e
 			t.is_active,
 			t.is_deleted,
 			t.created_at,
-			t.updated_at;
+			t.updated_at,
+			t.deleted_at,
+			t.is_anonymized,
+			t.version;
 	`
 
 	rows, err := r.conn.Query(ctx, sql, []models.V1EmployerDal{dal})
@@ -137,14 +150,24 @@ func (r *EmployerRepository) Update(ctx context.Context, e *employer.Employer) e
 			&res.IsDeleted,
 			&res.CreatedAt,
 			&res.UpdatedAt,
+			&res.DeletedAt,
+			&res.IsAnonymized,
+			&res.Version,
 		); err != nil {
 			return fmt.Errorf("scan employer: %w", err)
 		}
 		*e = *res.ToDomain()
 		return nil
 	}
+	if err := rows.Err(); err != nil {
+		if isUniqueViolation(err, "idx_employers_email_active_unique") {
+			return fmt.Errorf("update employer: %w", impl.ErrEmailAlreadyExists)
+		}
+		return fmt.Errorf("update employer: %w", err)
+	}
 
-	return fmt.Errorf("no employer updated")
+	// the employer was changed since it was read, or anonymized
+	return fmt.Errorf("update employer: %w", impl.ErrVersionConflict)
 }
 
 func (r *EmployerRepository) Query(ctx context.Context, q *models.QueryEmployersDal) ([]*employer.Employer, error) {
@@ -169,7 +192,10 @@ func (r *EmployerRepository) Query(ctx context.Context, q *models.QueryEmployers
 			is_active,
 			is_deleted,
 			created_at,
-			updated_at
+			updated_at,
+			deleted_at,
+			is_anonymized,
+			version
 		FROM employers
 		WHERE 1=1
 	`)
@@ -206,6 +232,9 @@ func (r *EmployerRepository) Query(ctx context.Context, q *models.QueryEmployers
 			&dal.IsDeleted,
 			&dal.CreatedAt,
 			&dal.UpdatedAt,
+			&dal.DeletedAt,
+			&dal.IsAnonymized,
+			&dal.Version,
 		); err != nil {
 			return nil, fmt.Errorf("scan employer: %w", err)
 		}
@@ -214,3 +243,51 @@ func (r *EmployerRepository) Query(ctx context.Context, q *models.QueryEmployers
 
 	return result, nil
 }
+
+// Anonymize erases the personal data of at most limit employers deleted before the given
+// time and returns their ids. Only the tombstone row with the id and timestamps is kept.
+func (r *EmployerRepository) Anonymize(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	sql := `
+		UPDATE employers AS t
+		SET
+			company_name  = '',
+			city          = '',
+			email         = '',
+			phone_number  = NULL,
+			telegram      = NULL,
+			is_anonymized = TRUE,
+			version       = t.version + 1,
+			updated_at    = NOW()
+		WHERE t.id IN (
+			SELECT id
+			FROM employers
+			WHERE is_deleted = TRUE
+				AND is_anonymized = FALSE
+				AND deleted_at < $1
+			ORDER BY deleted_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING t.id;
+	`
+
+	rows, err := r.conn.Query(ctx, sql, deletedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("anonymize employers: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan employer id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("anonymize employers: %w", err)
+	}
+
+	return ids, nil
+}
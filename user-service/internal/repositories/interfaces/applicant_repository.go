@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/applicant"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
@@ -11,4 +12,5 @@ type ApplicantRepository interface {
 	Create(ctx context.Context, applicant *applicant.Applicant) error
 	Update(ctx context.Context, applicant *applicant.Applicant) error
 	Query(ctx context.Context, query *models.QueryApplicantsDal) ([]*applicant.Applicant, error)
+	Anonymize(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error)
 }
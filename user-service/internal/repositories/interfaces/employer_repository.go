@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/employer"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
@@ -11,4 +12,5 @@ type EmployerRepository interface {
 	Create(ctx context.Context, employer *employer.Employer) error
 	Update(ctx context.Context, employer *employer.Employer) error
 	Query(ctx context.Context, query *models.QueryEmployersDal) ([]*employer.Employer, error)
+	Anonymize(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error)
 }
@@ -7,19 +7,23 @@ import (
 )
 
 type V1ApplicantDal struct {
-	Id          int64     `db:"id" json:"id"`
-	FirstName   string    `db:"first_name" json:"first_name"`
-	LastName    string    `db:"last_name" json:"last_name"`
-	Patronymic  *string   `db:"patronymic" json:"patronymic"`
-	BirthDate   time.Time `db:"birth_date" json:"birth_date"`
-	City        string    `db:"city" json:"city"`
-	Email       string    `db:"email" json:"email"`
-	PhoneNumber *string   `db:"phone_number" json:"phone_number"`
-	Telegram    *string   `db:"telegram" json:"telegram"`
-	IsActive    bool      `db:"is_active" json:"is_active"`
-	IsDeleted   bool      `db:"is_deleted" json:"is_deleted"`
-	CreatedAt   time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+	Id          int64      `db:"id" json:"id"`
+	FirstName   string     `db:"first_name" json:"first_name"`
+	LastName    string     `db:"last_name" json:"last_name"`
+	Patronymic  *string    `db:"patronymic" json:"patronymic"`
+	BirthDate   *time.Time `db:"birth_date" json:"birth_date"`
+	City        string     `db:"city" json:"city"`
+	Email       string     `db:"email" json:"email"`
+	PhoneNumber *string    `db:"phone_number" json:"phone_number"`
+	Telegram    *string    `db:"telegram" json:"telegram"`
+	IsActive    bool       `db:"is_active" json:"is_active"`
+	IsDeleted   bool       `db:"is_deleted" json:"is_deleted"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at"`
+
+	DeletedAt    *time.Time `db:"deleted_at" json:"deleted_at"`
+	IsAnonymized bool       `db:"is_anonymized" json:"is_anonymized"`
+	Version      int64      `db:"version" json:"version"`
 }
 
 func V1ApplicantDalFromDomain(a *applicant.Applicant) V1ApplicantDal {
@@ -27,7 +31,11 @@ func V1ApplicantDalFromDomain(a *applicant.Applicant) V1ApplicantDal {
 		return V1ApplicantDal{}
 	}
 
-	date, _ := time.Parse("02.01.2006", a.BirthDate())
+	// anonymized applicants have no birth date
+	var date *time.Time
+	if d, err := time.Parse("02.01.2006", a.BirthDate()); err == nil {
+		date = &d
+	}
 
 	return V1ApplicantDal{
 		Id:          a.Id(),
@@ -43,6 +51,10 @@ func V1ApplicantDalFromDomain(a *applicant.Applicant) V1ApplicantDal {
 		IsDeleted:   a.IsDeleted(),
 		CreatedAt:   a.CreatedAt(),
 		UpdatedAt:   a.UpdatedAt(),
+
+		DeletedAt:    a.DeletedAt(),
+		IsAnonymized: a.IsAnonymized(),
+		Version:      a.Version(),
 	}
 }
 
@@ -75,19 +87,32 @@ func (a V1ApplicantDal) Index(i int) any {
 		return a.CreatedAt
 	case 12:
 		return a.UpdatedAt
+	case 13:
+		return a.DeletedAt
+	case 14:
+		return a.IsAnonymized
+	case 15:
+		return a.Version
 	default:
 		return nil
 	}
 }
 
 func (a V1ApplicantDal) ToDomain() *applicant.Applicant {
+	var birthDate string
+	if a.BirthDate != nil {
+		birthDate = a.BirthDate.Format("02.01.2006")
+	}
+
 	return applicant.FromStorage(
 		a.Id,
 		a.FirstName, a.LastName,
 		a.Patronymic,
-		a.BirthDate.Format("02.01.2006"), a.City, a.Email,
+		birthDate, a.City, a.Email,
 		a.PhoneNumber, a.Telegram,
 		a.IsActive, a.IsDeleted,
 		a.CreatedAt, a.UpdatedAt,
+		a.DeletedAt, a.IsAnonymized,
+		a.Version,
 	)
 }
@@ -17,6 +17,10 @@ type V1EmployerDal struct {
 	IsDeleted   bool      `db:"is_deleted" json:"is_deleted"`
 	CreatedAt   time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+
+	DeletedAt    *time.Time `db:"deleted_at" json:"deleted_at"`
+	IsAnonymized bool       `db:"is_anonymized" json:"is_anonymized"`
+	Version      int64      `db:"version" json:"version"`
 }
 
 func V1EmployerDalFromDomain(e *employer.Employer) V1EmployerDal {
@@ -35,6 +39,10 @@ func V1EmployerDalFromDomain(e *employer.Employer) V1EmployerDal {
 		IsDeleted:   e.IsDeleted(),
 		CreatedAt:   e.CreatedAt(),
 		UpdatedAt:   e.UpdatedAt(),
+
+		DeletedAt:    e.DeletedAt(),
+		IsAnonymized: e.IsAnonymized(),
+		Version:      e.Version(),
 	}
 }
 
@@ -61,6 +69,12 @@ func (e V1EmployerDal) Index(i int) any {
 		return e.CreatedAt
 	case 9:
 		return e.UpdatedAt
+	case 10:
+		return e.DeletedAt
+	case 11:
+		return e.IsAnonymized
+	case 12:
+		return e.Version
 	default:
 		return nil
 	}
@@ -73,5 +87,7 @@ func (e V1EmployerDal) ToDomain() *employer.Employer {
 		e.PhoneNumber, e.Telegram,
 		e.IsActive, e.IsDeleted,
 		e.CreatedAt, e.UpdatedAt,
+		e.DeletedAt, e.IsAnonymized,
+		e.Version,
 	)
 }
@@ -2,8 +2,11 @@ package applicantservice
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/applicant"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl"
 	repo "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl/postgres"
 	cache "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl/redis"
 	dal "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
@@ -51,6 +54,23 @@ func (p *applicantDataProvider) GetByEmail(ctx context.Context, email string) (*
 	return list[0], err
 }
 
+// maxRestorable bounds the deleted applicants looked up by email, the email is
+// rarely reused within the grace period.
+const maxRestorable = 10
+
+// GetDeletedByEmail bypasses the cache, which only holds the not deleted applicant of an email.
+func (p *applicantDataProvider) GetDeletedByEmail(ctx context.Context, email string) ([]*applicant.Applicant, error) {
+	pgConn, err := p.pg.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer pgConn.Release()
+
+	dbRepo := repo.NewApplicantRepository(pgConn)
+	query := dal.NewQueryApplicantsDal(nil, []string{email}, nil, nil, utils.BoolPtr(true), nil, nil, nil, nil, 1, maxRestorable)
+	return dbRepo.Query(ctx, query)
+}
+
 func (p *applicantDataProvider) GetById(ctx context.Context, id int64) (*applicant.Applicant, error) {
 	cacheRepo := cache.NewApplicantCacheRepository(p.redis)
 	a, err := cacheRepo.GetApplicant(ctx, id)
@@ -93,6 +113,12 @@ func (p *applicantDataProvider) Save(ctx context.Context, a *applicant.Applicant
 		}
 	} else {
 		if err := dbRepo.Update(ctx, a); err != nil {
+			if errors.Is(err, impl.ErrVersionConflict) {
+				// the cached applicant may be the stale one
+				cacheRepo := cache.NewApplicantCacheRepository(p.redis)
+				cacheRepo.DeleteApplicant(ctx, a.Id())
+				cacheRepo.DeleteApplicantByEmail(ctx, a.Email())
+			}
 			return err
 		}
 	}
@@ -105,6 +131,58 @@ func (p *applicantDataProvider) Save(ctx context.Context, a *applicant.Applicant
 	return nil
 }
 
+// ChangeEmail saves the applicant with the new email and forgets the by-email
+// cache entry of the old one, which would otherwise still resolve to the applicant.
+func (p *applicantDataProvider) ChangeEmail(ctx context.Context, a *applicant.Applicant, oldEmail string) error {
+	if err := p.Save(ctx, a); err != nil {
+		return err
+	}
+
+	cacheRepo := cache.NewApplicantCacheRepository(p.redis)
+	cacheRepo.DeleteApplicantByEmail(ctx, oldEmail)
+
+	return nil
+}
+
+// Delete saves the soft deleted applicant and forgets the by-email cache entry,
+// the email no longer resolves to a applicant.
+func (p *applicantDataProvider) Delete(ctx context.Context, a *applicant.Applicant) error {
+	if err := p.Save(ctx, a); err != nil {
+		return err
+	}
+
+	cacheRepo := cache.NewApplicantCacheRepository(p.redis)
+	cacheRepo.DeleteApplicantByEmail(ctx, a.Email())
+
+	return nil
+}
+
+// AnonymizeDeleted erases the personal data of the applicants deleted before the given
+// time and forgets their cache entries.
+func (p *applicantDataProvider) AnonymizeDeleted(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	pgConn, err := p.pg.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer pgConn.Release()
+
+	dbRepo := repo.NewApplicantRepository(pgConn)
+	ids, err := dbRepo.Anonymize(ctx, deletedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) > 0 {
+		cacheRepo := cache.NewApplicantCacheRepository(p.redis)
+		for _, id := range ids {
+			cacheRepo.DeleteApplicant(ctx, id)
+		}
+		cacheRepo.InvalidateApplicantList(ctx)
+	}
+
+	return ids, nil
+}
+
 func (p *applicantDataProvider) QueryList(ctx context.Context, query *dal.QueryApplicantsDal) ([]*applicant.Applicant, error) {
 	cacheRepo := cache.NewApplicantCacheRepository(p.redis)
 	list, err := cacheRepo.GetApplicantList(ctx, query)
@@ -2,14 +2,19 @@ package applicantservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/errors/validationerror"
 	pb "github.com/ZaiiiRan/job_search_service/user-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/config/settings"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/applicant"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl"
 	dal "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
+	authservice "github.com/ZaiiiRan/job_search_service/user-service/internal/services/auth_service"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/transport/postgres"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/transport/redis"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/utils"
@@ -25,16 +30,34 @@ type ApplicantService interface {
 	GetApplicantByEmail(ctx context.Context, req *pb.GetApplicantByEmailRequest) (*pb.GetApplicantByEmailResponse, error)
 	QueryApplicants(ctx context.Context, req *pb.QueryApplicantsRequest) (*pb.QueryApplicantsResponse, error)
 	ActivateApplicant(ctx context.Context, req *pb.ActivateApplicantRequest) (*pb.ActivateApplicantResponse, error)
+	UpdateApplicant(ctx context.Context, req *pb.UpdateApplicantRequest) (*pb.UpdateApplicantResponse, error)
+	ChangeApplicantEmail(ctx context.Context, req *pb.ChangeApplicantEmailRequest) (*pb.ChangeApplicantEmailResponse, error)
+	DeleteApplicant(ctx context.Context, req *pb.DeleteApplicantRequest) (*pb.DeleteApplicantResponse, error)
+	RestoreApplicant(ctx context.Context, req *pb.RestoreApplicantRequest) (*pb.RestoreApplicantResponse, error)
+	GetRestorableApplicantsByEmail(ctx context.Context, req *pb.GetRestorableApplicantsByEmailRequest) (*pb.GetRestorableApplicantsByEmailResponse, error)
+	AnonymizeDeleted(ctx context.Context)
 }
 
 type service struct {
 	log          *zap.SugaredLogger
 	dataProvider *applicantDataProvider
+	authService  authservice.AuthService
+	gracePeriod  time.Duration
+	batchSize    int
 }
 
-func New(pgClient *postgres.PostgresClient, redisClient *redis.RedisClient, log *zap.SugaredLogger) ApplicantService {
+func New(
+	pgClient *postgres.PostgresClient,
+	redisClient *redis.RedisClient,
+	authService authservice.AuthService,
+	cfg settings.RetentionSettings,
+	log *zap.SugaredLogger,
+) ApplicantService {
 	return &service{
 		dataProvider: newApplicantDataProvider(pgClient, redisClient),
+		authService:  authService,
+		gracePeriod:  time.Duration(cfg.GracePeriod) * time.Second,
+		batchSize:    int(cfg.AnonymizeBatchSize),
 		log:          log,
 	}
 }
@@ -60,12 +83,11 @@ func (s *service) CreateApplicant(ctx context.Context, req *pb.CreateApplicantRe
 			return nil, status.Errorf(codes.AlreadyExists, "applicant with this email already exists")
 		}
 		l.Infow("applicant.create_applicant.restoring_inactive_applicant", "id", existed.Id())
-		a.SetId(existed.Id())
+		a.Replace(existed)
 	}
 
 	if err := s.dataProvider.Save(ctx, a); err != nil {
-		l.Errorw("applicant.create_applicant_failed.save_failed", "err", err)
-		return nil, status.Errorf(codes.Internal, "internal server error")
+		return nil, saveFailed(l, "applicant.create_applicant_failed.save_failed", err)
 	}
 
 	l.Infow("applicant.create_applicant.created")
@@ -97,14 +119,260 @@ func (s *service) ActivateApplicant(ctx context.Context, req *pb.ActivateApplica
 
 	a.SetIsActive(true)
 	if err := s.dataProvider.Save(ctx, a); err != nil {
-		l.Errorw("applicant.activate_applicant_failed", "err", err)
-		return nil, status.Errorf(codes.Internal, "internal server error")
+		return nil, saveFailed(l, "applicant.activate_applicant_failed", err)
 	}
 
 	l.Infow("applicant.activate_applicant.success")
 	return &pb.ActivateApplicantResponse{Applicant: toPbApplicant(a)}, nil
 }
 
+// UpdateApplicant changes the fields listed in the update mask, only they are validated.
+// A changed name is part of the access token claims, so the security version of the
+// applicant is bumped and the authorization microservice issues fresh tokens.
+func (s *service) UpdateApplicant(ctx context.Context, req *pb.UpdateApplicantRequest) (*pb.UpdateApplicantResponse, error) {
+	l := s.log.With("op", "update_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if req.Applicant == nil {
+		l.Errorw("applicant.update_applicant_failed", "err", "applicant is required")
+		return nil, status.Errorf(codes.InvalidArgument, "applicant is required")
+	}
+	l = l.With("id", req.Applicant.Id)
+
+	if req.Applicant.Id < 1 {
+		l.Errorw("applicant.update_applicant_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+	if err := authorize(ctx, req.Applicant.Id); err != nil {
+		l.Warnw("applicant.update_applicant_denied", "err", err)
+		return nil, err
+	}
+
+	paths, verr := utils.UpdateMaskPaths(req.UpdateMask, updatableFields)
+	if len(verr) > 0 {
+		l.Errorw("applicant.update_applicant_failed.validation_error", "err", verr)
+		return nil, verr.ToStatus()
+	}
+
+	a, err := s.dataProvider.GetById(ctx, req.Applicant.Id)
+	if err != nil {
+		l.Errorw("applicant.update_applicant_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if a == nil {
+		return nil, status.Errorf(codes.NotFound, "applicant not found")
+	}
+	if a.IsDeleted() {
+		return nil, status.Errorf(codes.FailedPrecondition, "applicant is deleted")
+	}
+	if err := checkEtag(req.Applicant.Etag, a); err != nil {
+		l.Warnw("applicant.update_applicant_failed", "err", err)
+		return nil, err
+	}
+
+	claimsChanged, verr := applyUpdate(a, req.Applicant, paths)
+	if len(verr) > 0 {
+		l.Errorw("applicant.update_applicant_failed.validation_error", "err", verr)
+		return nil, verr.ToStatus()
+	}
+
+	a.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.Save(ctx, a); err != nil {
+		return nil, saveFailed(l, "applicant.update_applicant_failed", err)
+	}
+
+	if claimsChanged {
+		// the update is stored, stale claims only live until the access tokens expire
+		if err := s.authService.BumpApplicantSecurityVersion(ctx, a.Id()); err != nil {
+			l.Warnw("applicant.update_applicant.bump_security_version_failed", "err", err)
+		}
+	}
+
+	l.Infow("applicant.update_applicant.success", "fields", paths)
+	return &pb.UpdateApplicantResponse{Applicant: toPbApplicant(a)}, nil
+}
+
+func (s *service) ChangeApplicantEmail(ctx context.Context, req *pb.ChangeApplicantEmailRequest) (*pb.ChangeApplicantEmailResponse, error) {
+	l := s.log.With("op", "change_applicant_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
+
+	if req.Id < 1 {
+		l.Errorw("applicant.change_applicant_email_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+
+	a, err := s.dataProvider.GetById(ctx, req.Id)
+	if err != nil {
+		l.Errorw("applicant.change_applicant_email_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if a == nil {
+		return nil, status.Errorf(codes.NotFound, "applicant not found")
+	}
+	if a.IsDeleted() {
+		return nil, status.Errorf(codes.FailedPrecondition, "applicant is deleted")
+	}
+
+	oldEmail := a.Email()
+	if err := a.SetEmail(req.Email); err != nil {
+		l.Errorw("applicant.change_applicant_email_failed.validation_error", "err", err)
+		return nil, validationerror.ValidationError{"email": err.Error()}.ToStatus()
+	}
+	if a.Email() == oldEmail {
+		return &pb.ChangeApplicantEmailResponse{Applicant: toPbApplicant(a)}, nil
+	}
+
+	existed, err := s.dataProvider.GetByEmail(ctx, a.Email())
+	if err != nil {
+		l.Errorw("applicant.change_applicant_email_failed.check_existing_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if existed != nil && existed.Id() != a.Id() {
+		l.Warnw("applicant.change_applicant_email_failed", "err", "email already exists")
+		return nil, status.Errorf(codes.AlreadyExists, "applicant with this email already exists")
+	}
+
+	a.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.ChangeEmail(ctx, a, oldEmail); err != nil {
+		// the partial unique index still guards against a concurrent change
+		if errors.Is(err, impl.ErrEmailAlreadyExists) {
+			l.Warnw("applicant.change_applicant_email_failed", "err", err)
+			return nil, status.Errorf(codes.AlreadyExists, "applicant with this email already exists")
+		}
+		return nil, saveFailed(l, "applicant.change_applicant_email_failed", err)
+	}
+
+	l.Infow("applicant.change_applicant_email.success")
+	return &pb.ChangeApplicantEmailResponse{Applicant: toPbApplicant(a)}, nil
+}
+
+// DeleteApplicant soft deletes the applicant. Deleting a deleted applicant succeeds, so the
+// authorization microservice can retry a failed account deletion.
+func (s *service) DeleteApplicant(ctx context.Context, req *pb.DeleteApplicantRequest) (*pb.DeleteApplicantResponse, error) {
+	l := s.log.With("op", "delete_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
+
+	if req.Id < 1 {
+		l.Errorw("applicant.delete_applicant_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+
+	a, err := s.dataProvider.GetById(ctx, req.Id)
+	if err != nil {
+		l.Errorw("applicant.delete_applicant_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if a == nil {
+		return nil, status.Errorf(codes.NotFound, "applicant not found")
+	}
+	if a.IsDeleted() {
+		return &pb.DeleteApplicantResponse{Applicant: toPbApplicant(a)}, nil
+	}
+
+	a.SetIsDeleted(true)
+	a.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.Delete(ctx, a); err != nil {
+		return nil, saveFailed(l, "applicant.delete_applicant_failed", err)
+	}
+
+	l.Infow("applicant.delete_applicant.success")
+	return &pb.DeleteApplicantResponse{Applicant: toPbApplicant(a)}, nil
+}
+
+func (s *service) RestoreApplicant(ctx context.Context, req *pb.RestoreApplicantRequest) (*pb.RestoreApplicantResponse, error) {
+	l := s.log.With("op", "restore_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
+
+	if req.Id < 1 {
+		l.Errorw("applicant.restore_applicant_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+
+	a, err := s.dataProvider.GetById(ctx, req.Id)
+	if err != nil {
+		l.Errorw("applicant.restore_applicant_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if a == nil {
+		return nil, status.Errorf(codes.NotFound, "applicant not found")
+	}
+	if !a.IsDeleted() {
+		return &pb.RestoreApplicantResponse{Applicant: toPbApplicant(a)}, nil
+	}
+	if !s.canRestore(a) {
+		l.Warnw("applicant.restore_applicant_failed", "err", "grace period is over")
+		return nil, status.Errorf(codes.FailedPrecondition, "grace period is over")
+	}
+
+	existed, err := s.dataProvider.GetByEmail(ctx, a.Email())
+	if err != nil {
+		l.Errorw("applicant.restore_applicant_failed.check_existing_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if existed != nil {
+		l.Warnw("applicant.restore_applicant_failed", "err", "email already exists")
+		return nil, status.Errorf(codes.AlreadyExists, "applicant with this email already exists")
+	}
+
+	a.SetIsDeleted(false)
+	a.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.Save(ctx, a); err != nil {
+		if errors.Is(err, impl.ErrEmailAlreadyExists) {
+			l.Warnw("applicant.restore_applicant_failed", "err", err)
+			return nil, status.Errorf(codes.AlreadyExists, "applicant with this email already exists")
+		}
+		return nil, saveFailed(l, "applicant.restore_applicant_failed", err)
+	}
+
+	l.Infow("applicant.restore_applicant.success")
+	return &pb.RestoreApplicantResponse{Applicant: toPbApplicant(a)}, nil
+}
+
+func (s *service) GetRestorableApplicantsByEmail(ctx context.Context, req *pb.GetRestorableApplicantsByEmailRequest) (*pb.GetRestorableApplicantsByEmailResponse, error) {
+	l := s.log.With("op", "get_restorable_applicants_by_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if req.Email == "" {
+		l.Errorw("applicant.get_restorable_applicants_by_email_failed", "err", "email cannot be empty")
+		return nil, status.Errorf(codes.InvalidArgument, "email cannot be empty")
+	}
+
+	list, err := s.dataProvider.GetDeletedByEmail(ctx, req.Email)
+	if err != nil {
+		l.Errorw("applicant.get_restorable_applicants_by_email_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	list = slices.DeleteFunc(list, func(a *applicant.Applicant) bool { return !s.canRestore(a) })
+	slices.SortFunc(list, func(x, y *applicant.Applicant) int {
+		return compareDeletedAt(y, x)
+	})
+
+	res := make([]*pb.Applicant, 0, len(list))
+	for _, a := range list {
+		res = append(res, toPbApplicant(a))
+	}
+
+	l.Infow("applicant.get_restorable_applicants_by_email.success", "count", len(res))
+	return &pb.GetRestorableApplicantsByEmailResponse{Applicants: res}, nil
+}
+
+// AnonymizeDeleted erases the personal data of the applicants deleted longer
+// than the grace period ago. Only the id of such applicant stays.
+func (s *service) AnonymizeDeleted(ctx context.Context) {
+	l := s.log.With("op", "anonymize_deleted_applicants")
+
+	deletedBefore := time.Now().Add(-s.gracePeriod)
+	for {
+		ids, err := s.dataProvider.AnonymizeDeleted(ctx, deletedBefore, s.batchSize)
+		if err != nil {
+			l.Errorw("applicant.anonymize_deleted_failed", "err", err)
+			return
+		}
+		if len(ids) > 0 {
+			l.Infow("applicant.anonymize_deleted.success", "ids", ids)
+		}
+		if len(ids) < s.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
 func (s *service) GetApplicant(ctx context.Context, req *pb.GetApplicantRequest) (*pb.GetApplicantResponse, error) {
 	l := s.log.With("op", "get_applicant", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
 
@@ -150,6 +418,12 @@ func (s *service) GetApplicantByEmail(ctx context.Context, req *pb.GetApplicantB
 func (s *service) QueryApplicants(ctx context.Context, req *pb.QueryApplicantsRequest) (*pb.QueryApplicantsResponse, error) {
 	l := s.log.With("op", "query_applicants", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "query", req)
 
+	// an email filter would tell end users which addresses have accounts
+	if _, ok := ctxmetadata.GetServiceClaimsFromContext(ctx); !ok && (len(req.FullEmails) > 0 || len(req.SubstrEmails) > 0) {
+		l.Warnw("applicant.query_applicants_denied", "err", "email filters are internal")
+		return nil, status.Errorf(codes.PermissionDenied, "filtering by email is not allowed")
+	}
+
 	query, verr := s.createQuery(req)
 	if len(verr) > 0 {
 		l.Errorw("applicant.query_applicants.validation_error", "err", verr)
@@ -223,8 +497,105 @@ func (s *service) createQuery(req *pb.QueryApplicantsRequest) (*dal.QueryApplica
 	), nil
 }
 
+// updatableFields maps the update mask paths to whether the field is part of
+// the access token claims. The email is changed through the authorization microservice.
+var updatableFields = map[string]bool{
+	"first_name":            true,
+	"last_name":             true,
+	"patronymic":            true,
+	"birth_date":            false,
+	"city":                  false,
+	"contacts.phone_number": false,
+	"contacts.telegram":     false,
+}
+
+// applyUpdate sets the fields of paths from r. A field in the mask without a value
+// clears the optional fields.
+func applyUpdate(a *applicant.Applicant, r *pb.Applicant, paths []string) (bool, validationerror.ValidationError) {
+	verr := make(validationerror.ValidationError)
+	contacts := r.Contacts
+	if contacts == nil {
+		contacts = &pb.Contacts{}
+	}
+
+	claimsChanged := false
+	for _, path := range paths {
+		var err error
+		switch path {
+		case "first_name":
+			claimsChanged = claimsChanged || a.FirstName() != r.FirstName
+			err = a.SetFirstName(r.FirstName)
+		case "last_name":
+			claimsChanged = claimsChanged || a.LastName() != r.LastName
+			err = a.SetLastName(r.LastName)
+		case "patronymic":
+			patronymic := utils.StringPtrOrEmpty(r.Patronymic)
+			claimsChanged = claimsChanged || *utils.StringPtrOrEmpty(a.Patronymic()) != *patronymic
+			err = a.SetPatronymic(patronymic)
+		case "birth_date":
+			err = a.SetBirthDate(r.BirthDate)
+		case "city":
+			err = a.SetCity(r.City)
+		case "contacts.phone_number":
+			err = a.SetPhoneNumber(utils.StringPtrOrEmpty(contacts.PhoneNumber))
+		case "contacts.telegram":
+			err = a.SetTelegram(utils.StringPtrOrEmpty(contacts.Telegram))
+		}
+		if err != nil {
+			verr[path] = err.Error()
+		}
+	}
+
+	return claimsChanged, verr
+}
+
+// compareDeletedAt orders the applicants by the deletion time, unknown times first.
+func compareDeletedAt(x, y *applicant.Applicant) int {
+	switch {
+	case x.DeletedAt() == nil && y.DeletedAt() == nil:
+		return 0
+	case x.DeletedAt() == nil:
+		return -1
+	case y.DeletedAt() == nil:
+		return 1
+	default:
+		return x.DeletedAt().Compare(*y.DeletedAt())
+	}
+}
+
+// canRestore reports whether the deleted applicant is still within the grace period.
+func (s *service) canRestore(a *applicant.Applicant) bool {
+	if a.IsAnonymized() {
+		return false
+	}
+	return a.DeletedAt() == nil || time.Since(*a.DeletedAt()) < s.gracePeriod
+}
+
+// checkEtag compares the etag the client read the applicant with to the stored version,
+// so an update cannot overwrite changes the client has not seen.
+func checkEtag(etag string, a *applicant.Applicant) error {
+	if etag == "" {
+		return status.Errorf(codes.FailedPrecondition, "etag is required")
+	}
+	if etag != utils.Etag(a.Version()) {
+		return status.Errorf(codes.Aborted, "applicant was changed since it was read")
+	}
+	return nil
+}
+
+// saveFailed logs a failed write and returns the status for the client. A version
+// conflict means a concurrent request changed the applicant first, the client may retry.
+func saveFailed(l *zap.SugaredLogger, msg string, err error) error {
+	if errors.Is(err, impl.ErrVersionConflict) {
+		l.Warnw(msg, "err", err)
+		return status.Errorf(codes.Aborted, "applicant was changed concurrently")
+	}
+	l.Errorw(msg, "err", err)
+	return status.Errorf(codes.Internal, "internal server error")
+}
+
 func toPbApplicant(a *applicant.Applicant) *pb.Applicant {
-	return &pb.Applicant{
+	res := &pb.Applicant{
 		Id:         a.Id(),
 		FirstName:  a.FirstName(),
 		LastName:   a.LastName(),
@@ -240,7 +611,12 @@ func toPbApplicant(a *applicant.Applicant) *pb.Applicant {
 		IsDeleted: a.IsDeleted(),
 		CreatedAt: timestamppb.New(a.CreatedAt()),
 		UpdatedAt: timestamppb.New(a.UpdatedAt()),
+		Etag:      utils.Etag(a.Version()),
 	}
+	if a.DeletedAt() != nil {
+		res.DeletedAt = timestamppb.New(*a.DeletedAt())
+	}
+	return res
 }
 
 func validateQuery(req *pb.QueryApplicantsRequest) validationerror.ValidationError {
@@ -271,3 +647,14 @@ func validateQuery(req *pb.QueryApplicantsRequest) validationerror.ValidationErr
 
 	return verr
 }
+
+// authorize lets internal callers act on any applicant, end users only on their own profile.
+func authorize(ctx context.Context, id int64) error {
+	if _, ok := ctxmetadata.GetServiceClaimsFromContext(ctx); ok {
+		return nil
+	}
+	if claims, ok := ctxmetadata.GetApplicantClaimsFromContext(ctx); ok && claims.Id == id {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "permission denied")
+}
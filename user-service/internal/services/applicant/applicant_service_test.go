@@ -0,0 +1,78 @@
+package applicantservice
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/applicant"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func storedApplicant(version int64) *applicant.Applicant {
+	now := time.Now()
+	return applicant.FromStorage(7, "John", "Doe", nil, "01.01.1990", "Moscow", "john@example.com",
+		nil, nil, true, false, now, now, nil, false, version)
+}
+
+func TestCheckEtag(t *testing.T) {
+	a := storedApplicant(3)
+
+	tests := []struct {
+		name     string
+		etag     string
+		wantCode codes.Code
+	}{
+		{name: "current version", etag: "3", wantCode: codes.OK},
+		{name: "missing etag", etag: "", wantCode: codes.FailedPrecondition},
+		{name: "older version", etag: "2", wantCode: codes.Aborted},
+		{name: "newer version", etag: "4", wantCode: codes.Aborted},
+		{name: "garbage", etag: "W/\"3\"", wantCode: codes.Aborted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(checkEtag(tt.etag, a)); got != tt.wantCode {
+				t.Errorf("code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestEtagOfResponseIsAccepted(t *testing.T) {
+	a := storedApplicant(3)
+
+	// the etag a client reads must be the one an update expects
+	if err := checkEtag(toPbApplicant(a).Etag, a); err != nil {
+		t.Fatalf("etag of the response rejected: %v", err)
+	}
+	if err := checkEtag(toPbApplicant(a).Etag, storedApplicant(4)); status.Code(err) != codes.Aborted {
+		t.Fatalf("etag of an older read: err = %v, want %v", err, codes.Aborted)
+	}
+}
+
+func TestSaveFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "version conflict", err: impl.ErrVersionConflict, wantCode: codes.Aborted},
+		{name: "wrapped version conflict", err: fmt.Errorf("update applicant: %w", impl.ErrVersionConflict), wantCode: codes.Aborted},
+		{name: "database failure", err: errors.New("connection refused"), wantCode: codes.Internal},
+		{name: "email conflict", err: fmt.Errorf("update applicant: %w", impl.ErrEmailAlreadyExists), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := saveFailed(zap.NewNop().Sugar(), "applicant.update_applicant_failed", tt.err)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
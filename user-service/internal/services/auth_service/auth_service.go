@@ -0,0 +1,55 @@
+package authservice
+
+import (
+	"context"
+
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	pb "github.com/ZaiiiRan/job_search_service/user-service/gen/go/auth_service/v1"
+	authgrpcclient "github.com/ZaiiiRan/job_search_service/user-service/internal/transport/client/grpc/auth_client"
+	"go.uber.org/zap"
+)
+
+// AuthService bumps the security version of a user whose token claims went
+// stale, the issued access tokens then have to be refreshed.
+type AuthService interface {
+	BumpApplicantSecurityVersion(ctx context.Context, applicantId int64) error
+	BumpEmployerSecurityVersion(ctx context.Context, employerId int64) error
+}
+
+type service struct {
+	authClient *authgrpcclient.Client
+	log        *zap.SugaredLogger
+}
+
+func New(authClient *authgrpcclient.Client, log *zap.SugaredLogger) AuthService {
+	return &service{
+		authClient: authClient,
+		log:        log,
+	}
+}
+
+func (s *service) BumpApplicantSecurityVersion(ctx context.Context, applicantId int64) error {
+	l := s.log.With("op", "bump_applicant_security_version", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "applicant_id", applicantId)
+
+	resp, err := s.authClient.AuthClient().BumpApplicantSecurityVersion(ctx, &pb.BumpApplicantSecurityVersionRequest{ApplicantId: applicantId})
+	if err != nil {
+		l.Errorw("auth.bump_applicant_security_version_failed", "err", err)
+		return err
+	}
+
+	l.Infow("auth.bump_applicant_security_version.success", "version", resp.Version)
+	return nil
+}
+
+func (s *service) BumpEmployerSecurityVersion(ctx context.Context, employerId int64) error {
+	l := s.log.With("op", "bump_employer_security_version", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "employer_id", employerId)
+
+	resp, err := s.authClient.AuthClient().BumpEmployerSecurityVersion(ctx, &pb.BumpEmployerSecurityVersionRequest{EmployerId: employerId})
+	if err != nil {
+		l.Errorw("auth.bump_employer_security_version_failed", "err", err)
+		return err
+	}
+
+	l.Infow("auth.bump_employer_security_version.success", "version", resp.Version)
+	return nil
+}
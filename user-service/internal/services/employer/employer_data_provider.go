@@ -2,8 +2,11 @@ package employerservice
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/employer"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl"
 	repo "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl/postgres"
 	cache "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl/redis"
 	dal "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
@@ -54,6 +57,23 @@ func (p *employerDataProvider) GetByEmail(ctx context.Context, email string) (*e
 	return list[0], err
 }
 
+// maxRestorable bounds the deleted employers looked up by email, the email is
+// rarely reused within the grace period.
+const maxRestorable = 10
+
+// GetDeletedByEmail bypasses the cache, which only holds the not deleted employer of an email.
+func (p *employerDataProvider) GetDeletedByEmail(ctx context.Context, email string) ([]*employer.Employer, error) {
+	pgConn, err := p.pg.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer pgConn.Release()
+
+	dbRepo := repo.NewEmployerRepository(pgConn)
+	query := dal.NewQueryEmployersDal(nil, []string{email}, nil, nil, nil, nil, utils.BoolPtr(true), nil, nil, nil, nil, 1, maxRestorable)
+	return dbRepo.Query(ctx, query)
+}
+
 func (p *employerDataProvider) GetById(ctx context.Context, id int64) (*employer.Employer, error) {
 	cacheRepo := cache.NewEmployerCacheRepository(p.redis)
 	e, err := cacheRepo.GetEmployer(ctx, id)
@@ -96,6 +116,12 @@ func (p *employerDataProvider) Save(ctx context.Context, e *employer.Employer) e
 		}
 	} else {
 		if err := dbRepo.Update(ctx, e); err != nil {
+			if errors.Is(err, impl.ErrVersionConflict) {
+				// the cached employer may be the stale one
+				cacheRepo := cache.NewEmployerCacheRepository(p.redis)
+				cacheRepo.DeleteEmployer(ctx, e.Id())
+				cacheRepo.DeleteEmployerByEmail(ctx, e.Email())
+			}
 			return err
 		}
 	}
@@ -108,6 +134,58 @@ func (p *employerDataProvider) Save(ctx context.Context, e *employer.Employer) e
 	return nil
 }
 
+// ChangeEmail saves the employer with the new email and forgets the by-email
+// cache entry of the old one, which would otherwise still resolve to the employer.
+func (p *employerDataProvider) ChangeEmail(ctx context.Context, e *employer.Employer, oldEmail string) error {
+	if err := p.Save(ctx, e); err != nil {
+		return err
+	}
+
+	cacheRepo := cache.NewEmployerCacheRepository(p.redis)
+	cacheRepo.DeleteEmployerByEmail(ctx, oldEmail)
+
+	return nil
+}
+
+// Delete saves the soft deleted employer and forgets the by-email cache entry,
+// the email no longer resolves to a employer.
+func (p *employerDataProvider) Delete(ctx context.Context, e *employer.Employer) error {
+	if err := p.Save(ctx, e); err != nil {
+		return err
+	}
+
+	cacheRepo := cache.NewEmployerCacheRepository(p.redis)
+	cacheRepo.DeleteEmployerByEmail(ctx, e.Email())
+
+	return nil
+}
+
+// AnonymizeDeleted erases the personal data of the employers deleted before the given
+// time and forgets their cache entries.
+func (p *employerDataProvider) AnonymizeDeleted(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	pgConn, err := p.pg.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer pgConn.Release()
+
+	dbRepo := repo.NewEmployerRepository(pgConn)
+	ids, err := dbRepo.Anonymize(ctx, deletedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) > 0 {
+		cacheRepo := cache.NewEmployerCacheRepository(p.redis)
+		for _, id := range ids {
+			cacheRepo.DeleteEmployer(ctx, id)
+		}
+		cacheRepo.InvalidateEmployerList(ctx)
+	}
+
+	return ids, nil
+}
+
 func (p *employerDataProvider) QueryList(ctx context.Context, query *dal.QueryEmployersDal) ([]*employer.Employer, error) {
 	cacheRepo := cache.NewEmployerCacheRepository(p.redis)
 	list, err := cacheRepo.GetEmployerList(ctx, query)
@@ -2,14 +2,19 @@ package employerservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
 	"github.com/ZaiiiRan/job_search_service/common/pkg/errors/validationerror"
 	pb "github.com/ZaiiiRan/job_search_service/user-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/config/settings"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/domain/user/employer"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/impl"
 	dal "github.com/ZaiiiRan/job_search_service/user-service/internal/repositories/models"
+	authservice "github.com/ZaiiiRan/job_search_service/user-service/internal/services/auth_service"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/transport/postgres"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/transport/redis"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/utils"
@@ -25,15 +30,36 @@ type EmployerService interface {
 	GetEmployerByEmail(ctx context.Context, req *pb.GetEmployerByEmailRequest) (*pb.GetEmployerByEmailResponse, error)
 	QueryEmployers(ctx context.Context, req *pb.QueryEmployersRequest) (*pb.QueryEmployersResponse, error)
 	ActivateEmployer(ctx context.Context, req *pb.ActivateEmployerRequest) (*pb.ActivateEmployerResponse, error)
+	UpdateEmployer(ctx context.Context, req *pb.UpdateEmployerRequest) (*pb.UpdateEmployerResponse, error)
+	ChangeEmployerEmail(ctx context.Context, req *pb.ChangeEmployerEmailRequest) (*pb.ChangeEmployerEmailResponse, error)
+	DeleteEmployer(ctx context.Context, req *pb.DeleteEmployerRequest) (*pb.DeleteEmployerResponse, error)
+	RestoreEmployer(ctx context.Context, req *pb.RestoreEmployerRequest) (*pb.RestoreEmployerResponse, error)
+	GetRestorableEmployersByEmail(ctx context.Context, req *pb.GetRestorableEmployersByEmailRequest) (*pb.GetRestorableEmployersByEmailResponse, error)
+	AnonymizeDeleted(ctx context.Context)
 }
 
 type service struct {
 	log          *zap.SugaredLogger
 	dataProvider *employerDataProvider
+	authService  authservice.AuthService
+	gracePeriod  time.Duration
+	batchSize    int
 }
 
-func New(pgClient *postgres.PostgresClient, redisClient *redis.RedisClient, log *zap.SugaredLogger) EmployerService {
-	return &service{log: log, dataProvider: newEmployerDataProvider(pgClient, redisClient)}
+func New(
+	pgClient *postgres.PostgresClient,
+	redisClient *redis.RedisClient,
+	authService authservice.AuthService,
+	cfg settings.RetentionSettings,
+	log *zap.SugaredLogger,
+) EmployerService {
+	return &service{
+		dataProvider: newEmployerDataProvider(pgClient, redisClient),
+		authService:  authService,
+		gracePeriod:  time.Duration(cfg.GracePeriod) * time.Second,
+		batchSize:    int(cfg.AnonymizeBatchSize),
+		log:          log,
+	}
 }
 
 func (s *service) CreateEmployer(ctx context.Context, req *pb.CreateEmployerRequest) (*pb.CreateEmployerResponse, error) {
@@ -57,12 +83,11 @@ func (s *service) CreateEmployer(ctx context.Context, req *pb.CreateEmployerRequ
 			return nil, status.Errorf(codes.AlreadyExists, "employer with this email already exists")
 		}
 		l.Infow("employer.create_employer.restoring_inactive_employer", "id", existed.Id())
-		e.SetId(existed.Id())
+		e.Replace(existed)
 	}
 
 	if err := s.dataProvider.Save(ctx, e); err != nil {
-		l.Errorw("employer.create_employer_failed.save_failed", "err", err)
-		return nil, status.Errorf(codes.Internal, "internal server error")
+		return nil, saveFailed(l, "employer.create_employer_failed.save_failed", err)
 	}
 
 	l.Infow("employer.create_employer.created")
@@ -94,14 +119,260 @@ func (s *service) ActivateEmployer(ctx context.Context, req *pb.ActivateEmployer
 
 	e.SetIsActive(true)
 	if err := s.dataProvider.Save(ctx, e); err != nil {
-		l.Errorw("applicant.activate_employer_failed", "err", err)
-		return nil, status.Errorf(codes.Internal, "internal server error")
+		return nil, saveFailed(l, "applicant.activate_employer_failed", err)
 	}
 
 	l.Infow("applicant.activate_employer.success")
 	return &pb.ActivateEmployerResponse{Employer: toPbEmployer(e)}, nil
 }
 
+// UpdateEmployer changes the fields listed in the update mask, only they are validated.
+// The company name is part of the access token claims, so a changed one bumps the
+// security version of the employer and the authorization microservice issues fresh tokens.
+func (s *service) UpdateEmployer(ctx context.Context, req *pb.UpdateEmployerRequest) (*pb.UpdateEmployerResponse, error) {
+	l := s.log.With("op", "update_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if req.Employer == nil {
+		l.Errorw("employer.update_employer_failed", "err", "employer is required")
+		return nil, status.Errorf(codes.InvalidArgument, "employer is required")
+	}
+	l = l.With("id", req.Employer.Id)
+
+	if req.Employer.Id < 1 {
+		l.Errorw("employer.update_employer_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+	if err := authorize(ctx, req.Employer.Id); err != nil {
+		l.Warnw("employer.update_employer_denied", "err", err)
+		return nil, err
+	}
+
+	paths, verr := utils.UpdateMaskPaths(req.UpdateMask, updatableFields)
+	if len(verr) > 0 {
+		l.Errorw("employer.update_employer_failed.validation_error", "err", verr)
+		return nil, verr.ToStatus()
+	}
+
+	e, err := s.dataProvider.GetById(ctx, req.Employer.Id)
+	if err != nil {
+		l.Errorw("employer.update_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if e == nil {
+		return nil, status.Errorf(codes.NotFound, "employer not found")
+	}
+	if e.IsDeleted() {
+		return nil, status.Errorf(codes.FailedPrecondition, "employer is deleted")
+	}
+	if err := checkEtag(req.Employer.Etag, e); err != nil {
+		l.Warnw("employer.update_employer_failed", "err", err)
+		return nil, err
+	}
+
+	claimsChanged, verr := applyUpdate(e, req.Employer, paths)
+	if len(verr) > 0 {
+		l.Errorw("employer.update_employer_failed.validation_error", "err", verr)
+		return nil, verr.ToStatus()
+	}
+
+	e.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.Save(ctx, e); err != nil {
+		return nil, saveFailed(l, "employer.update_employer_failed", err)
+	}
+
+	if claimsChanged {
+		// the update is stored, stale claims only live until the access tokens expire
+		if err := s.authService.BumpEmployerSecurityVersion(ctx, e.Id()); err != nil {
+			l.Warnw("employer.update_employer.bump_security_version_failed", "err", err)
+		}
+	}
+
+	l.Infow("employer.update_employer.success", "fields", paths)
+	return &pb.UpdateEmployerResponse{Employer: toPbEmployer(e)}, nil
+}
+
+func (s *service) ChangeEmployerEmail(ctx context.Context, req *pb.ChangeEmployerEmailRequest) (*pb.ChangeEmployerEmailResponse, error) {
+	l := s.log.With("op", "change_employer_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
+
+	if req.Id < 1 {
+		l.Errorw("employer.change_employer_email_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+
+	e, err := s.dataProvider.GetById(ctx, req.Id)
+	if err != nil {
+		l.Errorw("employer.change_employer_email_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if e == nil {
+		return nil, status.Errorf(codes.NotFound, "employer not found")
+	}
+	if e.IsDeleted() {
+		return nil, status.Errorf(codes.FailedPrecondition, "employer is deleted")
+	}
+
+	oldEmail := e.Email()
+	if err := e.SetEmail(req.Email); err != nil {
+		l.Errorw("employer.change_employer_email_failed.validation_error", "err", err)
+		return nil, validationerror.ValidationError{"email": err.Error()}.ToStatus()
+	}
+	if e.Email() == oldEmail {
+		return &pb.ChangeEmployerEmailResponse{Employer: toPbEmployer(e)}, nil
+	}
+
+	existed, err := s.dataProvider.GetByEmail(ctx, e.Email())
+	if err != nil {
+		l.Errorw("employer.change_employer_email_failed.check_existing_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if existed != nil && existed.Id() != e.Id() {
+		l.Warnw("employer.change_employer_email_failed", "err", "email already exists")
+		return nil, status.Errorf(codes.AlreadyExists, "employer with this email already exists")
+	}
+
+	e.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.ChangeEmail(ctx, e, oldEmail); err != nil {
+		// the partial unique index still guards against a concurrent change
+		if errors.Is(err, impl.ErrEmailAlreadyExists) {
+			l.Warnw("employer.change_employer_email_failed", "err", err)
+			return nil, status.Errorf(codes.AlreadyExists, "employer with this email already exists")
+		}
+		return nil, saveFailed(l, "employer.change_employer_email_failed", err)
+	}
+
+	l.Infow("employer.change_employer_email.success")
+	return &pb.ChangeEmployerEmailResponse{Employer: toPbEmployer(e)}, nil
+}
+
+// DeleteEmployer soft deletes the employer. Deleting a deleted employer succeeds, so the
+// authorization microservice can retry a failed account deletion.
+func (s *service) DeleteEmployer(ctx context.Context, req *pb.DeleteEmployerRequest) (*pb.DeleteEmployerResponse, error) {
+	l := s.log.With("op", "delete_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
+
+	if req.Id < 1 {
+		l.Errorw("employer.delete_employer_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+
+	e, err := s.dataProvider.GetById(ctx, req.Id)
+	if err != nil {
+		l.Errorw("employer.delete_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if e == nil {
+		return nil, status.Errorf(codes.NotFound, "employer not found")
+	}
+	if e.IsDeleted() {
+		return &pb.DeleteEmployerResponse{Employer: toPbEmployer(e)}, nil
+	}
+
+	e.SetIsDeleted(true)
+	e.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.Delete(ctx, e); err != nil {
+		return nil, saveFailed(l, "employer.delete_employer_failed", err)
+	}
+
+	l.Infow("employer.delete_employer.success")
+	return &pb.DeleteEmployerResponse{Employer: toPbEmployer(e)}, nil
+}
+
+func (s *service) RestoreEmployer(ctx context.Context, req *pb.RestoreEmployerRequest) (*pb.RestoreEmployerResponse, error) {
+	l := s.log.With("op", "restore_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
+
+	if req.Id < 1 {
+		l.Errorw("employer.restore_employer_failed", "err", "id must be positive")
+		return nil, status.Errorf(codes.InvalidArgument, "id must be positive")
+	}
+
+	e, err := s.dataProvider.GetById(ctx, req.Id)
+	if err != nil {
+		l.Errorw("employer.restore_employer_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if e == nil {
+		return nil, status.Errorf(codes.NotFound, "employer not found")
+	}
+	if !e.IsDeleted() {
+		return &pb.RestoreEmployerResponse{Employer: toPbEmployer(e)}, nil
+	}
+	if !s.canRestore(e) {
+		l.Warnw("employer.restore_employer_failed", "err", "grace period is over")
+		return nil, status.Errorf(codes.FailedPrecondition, "grace period is over")
+	}
+
+	existed, err := s.dataProvider.GetByEmail(ctx, e.Email())
+	if err != nil {
+		l.Errorw("employer.restore_employer_failed.check_existing_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+	if existed != nil {
+		l.Warnw("employer.restore_employer_failed", "err", "email already exists")
+		return nil, status.Errorf(codes.AlreadyExists, "employer with this email already exists")
+	}
+
+	e.SetIsDeleted(false)
+	e.SetUpdatedAt(time.Now())
+	if err := s.dataProvider.Save(ctx, e); err != nil {
+		if errors.Is(err, impl.ErrEmailAlreadyExists) {
+			l.Warnw("employer.restore_employer_failed", "err", err)
+			return nil, status.Errorf(codes.AlreadyExists, "employer with this email already exists")
+		}
+		return nil, saveFailed(l, "employer.restore_employer_failed", err)
+	}
+
+	l.Infow("employer.restore_employer.success")
+	return &pb.RestoreEmployerResponse{Employer: toPbEmployer(e)}, nil
+}
+
+func (s *service) GetRestorableEmployersByEmail(ctx context.Context, req *pb.GetRestorableEmployersByEmailRequest) (*pb.GetRestorableEmployersByEmailResponse, error) {
+	l := s.log.With("op", "get_restorable_employers_by_email", "req_id", ctxmetadata.GetReqIdFromContext(ctx))
+
+	if req.Email == "" {
+		l.Errorw("employer.get_restorable_employers_by_email_failed", "err", "email cannot be empty")
+		return nil, status.Errorf(codes.InvalidArgument, "email cannot be empty")
+	}
+
+	list, err := s.dataProvider.GetDeletedByEmail(ctx, req.Email)
+	if err != nil {
+		l.Errorw("employer.get_restorable_employers_by_email_failed", "err", err)
+		return nil, status.Errorf(codes.Internal, "internal server error")
+	}
+
+	list = slices.DeleteFunc(list, func(e *employer.Employer) bool { return !s.canRestore(e) })
+	slices.SortFunc(list, func(x, y *employer.Employer) int {
+		return compareDeletedAt(y, x)
+	})
+
+	res := make([]*pb.Employer, 0, len(list))
+	for _, e := range list {
+		res = append(res, toPbEmployer(e))
+	}
+
+	l.Infow("employer.get_restorable_employers_by_email.success", "count", len(res))
+	return &pb.GetRestorableEmployersByEmailResponse{Employers: res}, nil
+}
+
+// AnonymizeDeleted erases the personal data of the employers deleted longer
+// than the grace period ago. Only the id of such employer stays.
+func (s *service) AnonymizeDeleted(ctx context.Context) {
+	l := s.log.With("op", "anonymize_deleted_employers")
+
+	deletedBefore := time.Now().Add(-s.gracePeriod)
+	for {
+		ids, err := s.dataProvider.AnonymizeDeleted(ctx, deletedBefore, s.batchSize)
+		if err != nil {
+			l.Errorw("employer.anonymize_deleted_failed", "err", err)
+			return
+		}
+		if len(ids) > 0 {
+			l.Infow("employer.anonymize_deleted.success", "ids", ids)
+		}
+		if len(ids) < s.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
 func (s *service) GetEmployer(ctx context.Context, req *pb.GetEmployerRequest) (*pb.GetEmployerResponse, error) {
 	l := s.log.With("op", "get_employer", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "id", req.Id)
 
@@ -147,6 +418,12 @@ func (s *service) GetEmployerByEmail(ctx context.Context, req *pb.GetEmployerByE
 func (s *service) QueryEmployers(ctx context.Context, req *pb.QueryEmployersRequest) (*pb.QueryEmployersResponse, error) {
 	l := s.log.With("op", "query_employers", "req_id", ctxmetadata.GetReqIdFromContext(ctx), "query", req)
 
+	// an email filter would tell end users which addresses have accounts
+	if _, ok := ctxmetadata.GetServiceClaimsFromContext(ctx); !ok && (len(req.FullEmails) > 0 || len(req.SubstrEmails) > 0) {
+		l.Warnw("employer.query_employers_denied", "err", "email filters are internal")
+		return nil, status.Errorf(codes.PermissionDenied, "filtering by email is not allowed")
+	}
+
 	query, verr := s.createQuery(req)
 	if len(verr) > 0 {
 		l.Errorw("employer.query_employers.validation_error", "err", verr)
@@ -216,8 +493,93 @@ func (s *service) createQuery(req *pb.QueryEmployersRequest) (*dal.QueryEmployer
 	), nil
 }
 
+// updatableFields maps the update mask paths to whether the field is part of
+// the access token claims. The email is changed through the authorization microservice.
+var updatableFields = map[string]bool{
+	"company_name":          true,
+	"city":                  false,
+	"contacts.phone_number": false,
+	"contacts.telegram":     false,
+}
+
+// applyUpdate sets the fields of paths from r. A field in the mask without a value
+// clears the optional fields.
+func applyUpdate(e *employer.Employer, r *pb.Employer, paths []string) (bool, validationerror.ValidationError) {
+	verr := make(validationerror.ValidationError)
+	contacts := r.Contacts
+	if contacts == nil {
+		contacts = &pb.Contacts{}
+	}
+
+	claimsChanged := false
+	for _, path := range paths {
+		var err error
+		switch path {
+		case "company_name":
+			claimsChanged = claimsChanged || e.CompanyName() != r.CompanyName
+			err = e.SetCompanyName(r.CompanyName)
+		case "city":
+			err = e.SetCity(r.City)
+		case "contacts.phone_number":
+			err = e.SetPhoneNumber(utils.StringPtrOrEmpty(contacts.PhoneNumber))
+		case "contacts.telegram":
+			err = e.SetTelegram(utils.StringPtrOrEmpty(contacts.Telegram))
+		}
+		if err != nil {
+			verr[path] = err.Error()
+		}
+	}
+
+	return claimsChanged, verr
+}
+
+// compareDeletedAt orders the employers by the deletion time, unknown times first.
+func compareDeletedAt(x, y *employer.Employer) int {
+	switch {
+	case x.DeletedAt() == nil && y.DeletedAt() == nil:
+		return 0
+	case x.DeletedAt() == nil:
+		return -1
+	case y.DeletedAt() == nil:
+		return 1
+	default:
+		return x.DeletedAt().Compare(*y.DeletedAt())
+	}
+}
+
+// canRestore reports whether the deleted employer is still within the grace period.
+func (s *service) canRestore(e *employer.Employer) bool {
+	if e.IsAnonymized() {
+		return false
+	}
+	return e.DeletedAt() == nil || time.Since(*e.DeletedAt()) < s.gracePeriod
+}
+
+// checkEtag compares the etag the client read the employer with to the stored version,
+// so an update cannot overwrite changes the client has not seen.
+func checkEtag(etag string, e *employer.Employer) error {
+	if etag == "" {
+		return status.Errorf(codes.FailedPrecondition, "etag is required")
+	}
+	if etag != utils.Etag(e.Version()) {
+		return status.Errorf(codes.Aborted, "employer was changed since it was read")
+	}
+	return nil
+}
+
+// saveFailed logs a failed write and returns the status for the client. A version
+// conflict means a concurrent request changed the employer first, the client may retry.
+func saveFailed(l *zap.SugaredLogger, msg string, err error) error {
+	if errors.Is(err, impl.ErrVersionConflict) {
+		l.Warnw(msg, "err", err)
+		return status.Errorf(codes.Aborted, "employer was changed concurrently")
+	}
+	l.Errorw(msg, "err", err)
+	return status.Errorf(codes.Internal, "internal server error")
+}
+
 func toPbEmployer(e *employer.Employer) *pb.Employer {
-	return &pb.Employer{
+	res := &pb.Employer{
 		Id:          e.Id(),
 		CompanyName: e.CompanyName(),
 		City:        e.City(),
@@ -230,7 +592,12 @@ func toPbEmployer(e *employer.Employer) *pb.Employer {
 		IsDeleted: e.IsDeleted(),
 		CreatedAt: timestamppb.New(e.CreatedAt()),
 		UpdatedAt: timestamppb.New(e.UpdatedAt()),
+		Etag:      utils.Etag(e.Version()),
 	}
+	if e.DeletedAt() != nil {
+		res.DeletedAt = timestamppb.New(*e.DeletedAt())
+	}
+	return res
 }
 
 func validateQuery(req *pb.QueryEmployersRequest) validationerror.ValidationError {
@@ -271,3 +638,14 @@ func validateQuery(req *pb.QueryEmployersRequest) validationerror.ValidationErro
 
 	return verr
 }
+
+// authorize lets internal callers act on any employer, end users only on their own profile.
+func authorize(ctx context.Context, id int64) error {
+	if _, ok := ctxmetadata.GetServiceClaimsFromContext(ctx); ok {
+		return nil
+	}
+	if claims, ok := ctxmetadata.GetEmployerClaimsFromContext(ctx); ok && claims.Id == id {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "permission denied")
+}
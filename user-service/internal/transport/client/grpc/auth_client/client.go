@@ -0,0 +1,59 @@
+package authgrpcclient
+
+import (
+	"context"
+
+	middleware "github.com/ZaiiiRan/job_search_service/common/pkg/middleware/grpc/client"
+	pb "github.com/ZaiiiRan/job_search_service/user-service/gen/go/auth_service/v1"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/config/settings"
+	grpcclient "github.com/ZaiiiRan/job_search_service/user-service/internal/transport/client/grpc"
+	"google.golang.org/grpc"
+)
+
+type Client struct {
+	client     *grpcclient.GRPCClient
+	authClient pb.AuthServiceClient
+}
+
+func New(
+	ctx context.Context,
+	cfg settings.GRPCClientSettings,
+	unaryExtra []grpc.UnaryClientInterceptor,
+	streamExtra []grpc.StreamClientInterceptor,
+	extra ...grpc.DialOption,
+) (*Client, error) {
+	unaryExtra = append(
+		[]grpc.UnaryClientInterceptor{
+			middleware.PropagateClientMetaUnary(),
+		},
+		unaryExtra...,
+	)
+
+	cl, err := grpcclient.New(ctx, cfg, unaryExtra, streamExtra, extra...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		client:     cl,
+		authClient: pb.NewAuthServiceClient(cl.Conn()),
+	}, nil
+}
+
+func (c *Client) AuthClient() pb.AuthServiceClient {
+	return c.authClient
+}
+
+func (c *Client) Connect(ctx context.Context) error {
+	if c.client != nil {
+		return c.client.Connect(ctx)
+	}
+	return nil
+}
+
+func (c *Client) Close() error {
+	if c.client != nil {
+		return c.client.Close()
+	}
+	return nil
+}
@@ -33,11 +33,11 @@ func (h *userHandler) ActivateApplicant(ctx context.Context, req *pb.ActivateApp
 
 func (h *userHandler) UpdateApplicant(ctx context.Context, req *pb.UpdateApplicantRequest) (*pb.UpdateApplicantResponse, error) {
 	utils.SanitizeUpdateApplicantRequest(req)
-	return &pb.UpdateApplicantResponse{}, nil
+	return h.applicantService.UpdateApplicant(ctx, req)
 }
 
 func (h *userHandler) DeleteApplicant(ctx context.Context, req *pb.DeleteApplicantRequest) (*pb.DeleteApplicantResponse, error) {
-	return &pb.DeleteApplicantResponse{}, nil
+	return h.applicantService.DeleteApplicant(ctx, req)
 }
 
 func (h *userHandler) QueryApplicants(ctx context.Context, req *pb.QueryApplicantsRequest) (*pb.QueryApplicantsResponse, error) {
@@ -54,6 +54,20 @@ func (h *userHandler) GetApplicantByEmail(ctx context.Context, req *pb.GetApplic
 	return h.applicantService.GetApplicantByEmail(ctx, req)
 }
 
+func (h *userHandler) ChangeApplicantEmail(ctx context.Context, req *pb.ChangeApplicantEmailRequest) (*pb.ChangeApplicantEmailResponse, error) {
+	utils.SanitizeChangeApplicantEmailRequest(req)
+	return h.applicantService.ChangeApplicantEmail(ctx, req)
+}
+
+func (h *userHandler) RestoreApplicant(ctx context.Context, req *pb.RestoreApplicantRequest) (*pb.RestoreApplicantResponse, error) {
+	return h.applicantService.RestoreApplicant(ctx, req)
+}
+
+func (h *userHandler) GetRestorableApplicantsByEmail(ctx context.Context, req *pb.GetRestorableApplicantsByEmailRequest) (*pb.GetRestorableApplicantsByEmailResponse, error) {
+	utils.SanitizeGetRestorableApplicantsByEmailRequest(req)
+	return h.applicantService.GetRestorableApplicantsByEmail(ctx, req)
+}
+
 func (h *userHandler) CreateEmployer(ctx context.Context, req *pb.CreateEmployerRequest) (*pb.CreateEmployerResponse, error) {
 	utils.SanitizeCreateEmployerRequest(req)
 	return h.employerService.CreateEmployer(ctx, req)
@@ -65,11 +79,11 @@ func (h *userHandler) ActivateEmployer(ctx context.Context, req *pb.ActivateEmpl
 
 func (h *userHandler) UpdateEmployer(ctx context.Context, req *pb.UpdateEmployerRequest) (*pb.UpdateEmployerResponse, error) {
 	utils.SanitizeUpdateEmployerRequest(req)
-	return &pb.UpdateEmployerResponse{}, nil
+	return h.employerService.UpdateEmployer(ctx, req)
 }
 
 func (h *userHandler) DeleteEmployer(ctx context.Context, req *pb.DeleteEmployerRequest) (*pb.DeleteEmployerResponse, error) {
-	return &pb.DeleteEmployerResponse{}, nil
+	return h.employerService.DeleteEmployer(ctx, req)
 }
 
 func (h *userHandler) QueryEmployers(ctx context.Context, req *pb.QueryEmployersRequest) (*pb.QueryEmployersResponse, error) {
@@ -85,3 +99,17 @@ func (h *userHandler) GetEmployerByEmail(ctx context.Context, req *pb.GetEmploye
 	utils.SanitizeGetEmployerByEmailRequest(req)
 	return h.employerService.GetEmployerByEmail(ctx, req)
 }
+
+func (h *userHandler) ChangeEmployerEmail(ctx context.Context, req *pb.ChangeEmployerEmailRequest) (*pb.ChangeEmployerEmailResponse, error) {
+	utils.SanitizeChangeEmployerEmailRequest(req)
+	return h.employerService.ChangeEmployerEmail(ctx, req)
+}
+
+func (h *userHandler) RestoreEmployer(ctx context.Context, req *pb.RestoreEmployerRequest) (*pb.RestoreEmployerResponse, error) {
+	return h.employerService.RestoreEmployer(ctx, req)
+}
+
+func (h *userHandler) GetRestorableEmployersByEmail(ctx context.Context, req *pb.GetRestorableEmployersByEmailRequest) (*pb.GetRestorableEmployersByEmailResponse, error) {
+	utils.SanitizeGetRestorableEmployersByEmailRequest(req)
+	return h.employerService.GetRestorableEmployersByEmail(ctx, req)
+}
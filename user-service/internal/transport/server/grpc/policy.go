@@ -0,0 +1,36 @@
+package grpcserver
+
+import (
+	"fmt"
+
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	middleware "github.com/ZaiiiRan/job_search_service/common/pkg/middleware/grpc/server"
+	pb "github.com/ZaiiiRan/job_search_service/user-service/gen/go/user_service/v1"
+	"github.com/ZaiiiRan/job_search_service/user-service/internal/config/settings"
+)
+
+// methodPolicy lists the rpcs end users may call. Creating, activating, deleting
+// and restoring users, and lookups by email, belong to the auth service.
+var methodPolicy = middleware.MethodPolicy{
+	pb.UserService_UpdateApplicant_FullMethodName: middleware.AccessEndUser,
+	pb.UserService_QueryApplicants_FullMethodName: middleware.AccessEndUser,
+	pb.UserService_GetApplicant_FullMethodName:    middleware.AccessEndUser,
+	pb.UserService_UpdateEmployer_FullMethodName:  middleware.AccessEndUser,
+	pb.UserService_QueryEmployers_FullMethodName:  middleware.AccessEndUser,
+	pb.UserService_GetEmployer_FullMethodName:     middleware.AccessEndUser,
+}
+
+func newServiceKeySet(cfg settings.ServiceAuthSettings) (*jwt.KeySet, error) {
+	keys := make([]*jwt.Key, 0, len(cfg.Services))
+	for name, secret := range cfg.Services {
+		if secret == "" {
+			return nil, fmt.Errorf("service %q: secret is empty", name)
+		}
+		keys = append(keys, &jwt.Key{
+			Id:        name,
+			Algorithm: jwt.AlgorithmHS256,
+			Key:       []byte(secret),
+		})
+	}
+	return jwt.NewKeySet(keys...)
+}
@@ -6,6 +6,9 @@ import (
 	"net"
 	"time"
 
+	"github.com/ZaiiiRan/job_search_service/common/pkg/ctxmetadata"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt"
+	"github.com/ZaiiiRan/job_search_service/common/pkg/jwt/denylist"
 	middleware "github.com/ZaiiiRan/job_search_service/common/pkg/middleware/grpc/server"
 	pb "github.com/ZaiiiRan/job_search_service/user-service/gen/go/user_service/v1"
 	"github.com/ZaiiiRan/job_search_service/user-service/internal/config/settings"
@@ -23,12 +26,26 @@ type Server struct {
 
 func New(
 	srvSettings settings.GRPCServerSettings,
+	serviceAuth settings.ServiceAuthSettings,
+	endUserAuth settings.EndUserAuthSettings,
+	denylist denylist.Denylist,
 	applicantService applicantservice.ApplicantService,
 	employerService employerservice.EmployerService,
 	log *zap.SugaredLogger,
 ) (*Server, error) {
+	serviceKeys, err := newServiceKeySet(serviceAuth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service auth settings: %w", err)
+	}
+
+	accessKeys := jwt.NewRemoteKeySet(
+		endUserAuth.JwksURL,
+		time.Duration(endUserAuth.JwksCacheTTL)*time.Second,
+		time.Duration(endUserAuth.JwksTimeout)*time.Millisecond,
+	)
+
 	s := grpc.NewServer(
-		newChainUnaryInterceptor(log),
+		newChainUnaryInterceptor(serviceKeys, serviceAuth.Audience, accessKeys, denylist, log),
 		grpc.KeepaliveParams(getGRPCKeepAliveServerParams(&srvSettings)),
 		grpc.KeepaliveEnforcementPolicy(getGRPCKeepAliveEnforcement(&srvSettings)),
 	)
@@ -72,14 +89,62 @@ func (s *Server) Addr() string {
 	return ""
 }
 
-func newChainUnaryInterceptor(log *zap.SugaredLogger) grpc.ServerOption {
+// newChainUnaryInterceptor verifies the end users only on calls without a service
+// token. The revoked tokens are rejected through the denylist shared with the auth service.
+func newChainUnaryInterceptor(
+	serviceKeys *jwt.KeySet, audience string,
+	accessKeys jwt.KeyProvider, denylist denylist.Denylist,
+	log *zap.SugaredLogger,
+) grpc.ServerOption {
 	return grpc.ChainUnaryInterceptor(
 		middleware.RequestIdMiddleware(),
 		middleware.LogMiddleware(log),
 		middleware.RecoveryInterceptor(log),
+		middleware.ServiceAuthMiddleware(serviceKeys, audience, methodPolicy),
+
+		endUserOnly(middleware.ApplicantAuthMiddleware(
+			accessKeys,
+			denylist,
+			middleware.MiddlewareOnly(
+				"/user_service.v1.UserService/UpdateApplicant",
+			),
+		)),
+		endUserOnly(middleware.EmployerAuthMiddleware(
+			accessKeys,
+			denylist,
+			middleware.MiddlewareOnly(
+				"/user_service.v1.UserService/UpdateEmployer",
+			),
+		)),
+		endUserOnly(middleware.UserAuthMiddleware(
+			accessKeys,
+			denylist,
+			middleware.MiddlewareOnly(
+				"/user_service.v1.UserService/QueryApplicants",
+				"/user_service.v1.UserService/GetApplicant",
+				"/user_service.v1.UserService/QueryEmployers",
+				"/user_service.v1.UserService/GetEmployer",
+			),
+		)),
 	)
 }
 
+// endUserOnly skips the interceptor for internal callers, they are trusted to
+// act on behalf of the users.
+func endUserOnly(interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if _, ok := ctxmetadata.GetServiceClaimsFromContext(ctx); ok {
+			return handler(ctx, req)
+		}
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
 func getGRPCKeepAliveServerParams(c *settings.GRPCServerSettings) keepalive.ServerParameters {
 	if c == nil {
 		return keepalive.ServerParameters{}
@@ -0,0 +1,8 @@
+package utils
+
+import "strconv"
+
+// Etag formats the version of a user as the etag returned to the clients.
+func Etag(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZaiiiRan/job_search_service/common/pkg/errors/validationerror"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// UpdateMaskPaths checks the paths of mask against the updatable fields. A parent
+// path, such as contacts, stands for every updatable field under it.
+func UpdateMaskPaths(mask *fieldmaskpb.FieldMask, updatable map[string]bool) ([]string, validationerror.ValidationError) {
+	verr := make(validationerror.ValidationError)
+	if len(mask.GetPaths()) == 0 {
+		verr["update_mask"] = "update_mask cannot be empty"
+		return nil, verr
+	}
+
+	seen := make(map[string]struct{})
+	var paths []string
+	add := func(path string) {
+		if _, ok := seen[path]; !ok {
+			seen[path] = struct{}{}
+			paths = append(paths, path)
+		}
+	}
+
+	for _, path := range mask.GetPaths() {
+		if _, ok := updatable[path]; ok {
+			add(path)
+			continue
+		}
+
+		matched := false
+		for field := range updatable {
+			if strings.HasPrefix(field, path+".") {
+				add(field)
+				matched = true
+			}
+		}
+		if !matched {
+			verr["update_mask"] = fmt.Sprintf("field %q cannot be updated", path)
+		}
+	}
+
+	if len(verr) > 0 {
+		return nil, verr
+	}
+	return paths, nil
+}
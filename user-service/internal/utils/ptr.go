@@ -9,3 +9,13 @@ func BoolPtr(b bool) *bool {
 func TimePtr(t time.Time) *time.Time {
 	return &t
 }
+
+// StringPtrOrEmpty turns a missing value into an empty one, which the domain
+// setters treat as a cleared optional field.
+func StringPtrOrEmpty(s *string) *string {
+	if s == nil {
+		empty := ""
+		return &empty
+	}
+	return s
+}
@@ -55,6 +55,14 @@ func SanitizeGetApplicantByEmailRequest(req *pb.GetApplicantByEmailRequest) {
 	req.Email = strings.TrimSpace(req.Email)
 }
 
+func SanitizeGetRestorableApplicantsByEmailRequest(req *pb.GetRestorableApplicantsByEmailRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+}
+
+func SanitizeChangeApplicantEmailRequest(req *pb.ChangeApplicantEmailRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+}
+
 func SanitizeEmployer(employer *pb.Employer) {
 	if employer != nil {
 		employer.CompanyName = strings.TrimSpace(employer.CompanyName)
@@ -90,3 +98,11 @@ func SanitizeQueryEmployersRequest(req *pb.QueryEmployersRequest) {
 func SanitizeGetEmployerByEmailRequest(req *pb.GetEmployerByEmailRequest) {
 	req.Email = strings.TrimSpace(req.Email)
 }
+
+func SanitizeGetRestorableEmployersByEmailRequest(req *pb.GetRestorableEmployersByEmailRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+}
+
+func SanitizeChangeEmployerEmailRequest(req *pb.ChangeEmployerEmailRequest) {
+	req.Email = strings.TrimSpace(req.Email)
+}
@@ -0,0 +1,54 @@
+-- +goose Up
+ALTER TABLE applicants
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN is_anonymized BOOLEAN NOT NULL DEFAULT FALSE,
+    ALTER COLUMN birth_date DROP NOT NULL;
+
+UPDATE applicants SET deleted_at = updated_at WHERE is_deleted = TRUE;
+
+CREATE INDEX idx_applicants_deleted_at_not_anonymized
+    ON applicants (deleted_at)
+    WHERE is_deleted = TRUE AND is_anonymized = FALSE;
+
+ALTER TYPE v1_applicant
+    ADD ATTRIBUTE deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD ATTRIBUTE is_anonymized BOOLEAN;
+
+ALTER TABLE employers
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN is_anonymized BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE employers SET deleted_at = updated_at WHERE is_deleted = TRUE;
+
+CREATE INDEX idx_employers_deleted_at_not_anonymized
+    ON employers (deleted_at)
+    WHERE is_deleted = TRUE AND is_anonymized = FALSE;
+
+ALTER TYPE v1_employer
+    ADD ATTRIBUTE deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD ATTRIBUTE is_anonymized BOOLEAN;
+
+-- +goose Down
+ALTER TYPE v1_employer
+    DROP ATTRIBUTE IF EXISTS is_anonymized,
+    DROP ATTRIBUTE IF EXISTS deleted_at;
+
+DROP INDEX IF EXISTS idx_employers_deleted_at_not_anonymized;
+
+ALTER TABLE employers
+    DROP COLUMN IF EXISTS is_anonymized,
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TYPE v1_applicant
+    DROP ATTRIBUTE IF EXISTS is_anonymized,
+    DROP ATTRIBUTE IF EXISTS deleted_at;
+
+DROP INDEX IF EXISTS idx_applicants_deleted_at_not_anonymized;
+
+-- anonymized applicants have no birth date left
+DELETE FROM applicants WHERE birth_date IS NULL;
+
+ALTER TABLE applicants
+    ALTER COLUMN birth_date SET NOT NULL,
+    DROP COLUMN IF EXISTS is_anonymized,
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,25 @@
+-- +goose Up
+ALTER TABLE applicants
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TYPE v1_applicant
+    ADD ATTRIBUTE version BIGINT;
+
+ALTER TABLE employers
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TYPE v1_employer
+    ADD ATTRIBUTE version BIGINT;
+
+-- +goose Down
+ALTER TYPE v1_employer
+    DROP ATTRIBUTE IF EXISTS version;
+
+ALTER TABLE employers
+    DROP COLUMN IF EXISTS version;
+
+ALTER TYPE v1_applicant
+    DROP ATTRIBUTE IF EXISTS version;
+
+ALTER TABLE applicants
+    DROP COLUMN IF EXISTS version;